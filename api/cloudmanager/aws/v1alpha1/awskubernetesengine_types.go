@@ -35,11 +35,27 @@ type AWSKubernetesEngineSpec struct {
 	// Dependencies defines the dependency configurations for the AWS Kubernetes Engine.
 	// +optional
 	Dependencies common.Dependencies `json:"dependencies,omitempty"`
+
+	// ImageMirrors configures the registry mirrors the images of the dependencies are pulled from.
+	// +optional
+	ImageMirrors *common.ImageMirrors `json:"imageMirrors,omitempty"`
 }
 
 // AWSKubernetesEngineStatus defines the observed state of AWSKubernetesEngine.
 type AWSKubernetesEngineStatus struct {
 	apicommon.Status `json:",inline"`
+
+	// DependencyVersions reports the chart versions of the managed dependencies.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DependencyVersions []common.DependencyVersionStatus `json:"dependencyVersions,omitempty"`
+
+	// Inventory summarizes the resources applied for each managed dependency.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Inventory []common.DependencyInventory `json:"inventory,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -64,6 +80,26 @@ func (e *AWSKubernetesEngine) GetDependencies() common.Dependencies {
 	return e.Spec.Dependencies
 }
 
+func (e *AWSKubernetesEngine) GetDependencyVersions() []common.DependencyVersionStatus {
+	return e.Status.DependencyVersions
+}
+
+func (e *AWSKubernetesEngine) SetDependencyVersions(versions []common.DependencyVersionStatus) {
+	e.Status.DependencyVersions = versions
+}
+
+func (e *AWSKubernetesEngine) GetImageMirrors() *common.ImageMirrors {
+	return e.Spec.ImageMirrors
+}
+
+func (e *AWSKubernetesEngine) GetInventory() []common.DependencyInventory {
+	return e.Status.Inventory
+}
+
+func (e *AWSKubernetesEngine) SetInventory(inventory []common.DependencyInventory) {
+	e.Status.Inventory = inventory
+}
+
 // +kubebuilder:object:root=true
 
 // AWSKubernetesEngineList contains a list of AWSKubernetesEngine.
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,7 +30,7 @@ func (in *AzureKubernetesEngine) DeepCopyInto(out *AzureKubernetesEngine) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -86,7 +87,12 @@ func (in *AzureKubernetesEngineList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureKubernetesEngineSpec) DeepCopyInto(out *AzureKubernetesEngineSpec) {
 	*out = *in
-	out.Dependencies = in.Dependencies
+	in.Dependencies.DeepCopyInto(&out.Dependencies)
+	if in.ImageMirrors != nil {
+		in, out := &in.ImageMirrors, &out.ImageMirrors
+		*out = new(common.ImageMirrors)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureKubernetesEngineSpec.
@@ -103,6 +109,18 @@ func (in *AzureKubernetesEngineSpec) DeepCopy() *AzureKubernetesEngineSpec {
 func (in *AzureKubernetesEngineStatus) DeepCopyInto(out *AzureKubernetesEngineStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.DependencyVersions != nil {
+		in, out := &in.DependencyVersions, &out.DependencyVersions
+		*out = make([]common.DependencyVersionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]common.DependencyInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureKubernetesEngineStatus.
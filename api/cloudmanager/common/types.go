@@ -1,6 +1,9 @@
 package common
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	apicommon "github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 )
 
@@ -16,12 +19,24 @@ const (
 	Unmanaged ManagementPolicy = "Unmanaged"
 )
 
+// UpgradePolicy defines how a new chart version of a cloud manager dependency is rolled out.
+// +kubebuilder:validation:Enum=Automatic;Manual
+type UpgradePolicy string
+
+const (
+	// Automatic deploys the bundled chart version as soon as the operator ships it.
+	Automatic UpgradePolicy = "Automatic"
+	// Manual keeps the deployed chart version until the bundled version is approved.
+	Manual UpgradePolicy = "Manual"
+)
+
 // Default namespaces for cloud manager dependencies.
 const (
 	DefaultNamespaceCertManagerOperator = "cert-manager-operator"
 	DefaultNamespaceCertManagerOperand  = "cert-manager"
 	DefaultNamespaceLWSOperator         = "openshift-lws-operator"
 	DefaultNamespaceSailOperator        = "istio-system"
+	DefaultNamespaceKEDAOperator        = "openshift-keda"
 )
 
 // Namespace represents a Kubernetes namespace name (RFC 1123 DNS label).
@@ -30,9 +45,128 @@ const (
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespace is immutable"
 type Namespace string
 
+// CertManagerIssuerType selects how the platform certificate issuer chain is built.
+// +kubebuilder:validation:Enum=SelfSigned;CASecret;IssuerRef
+type CertManagerIssuerType string
+
+const (
+	// SelfSignedIssuerType bootstraps a self-signed root CA and a ClusterIssuer backed by it.
+	SelfSignedIssuerType CertManagerIssuerType = "SelfSigned"
+	// CASecretIssuerType imports an existing CA key pair and backs the platform ClusterIssuer with it.
+	CASecretIssuerType CertManagerIssuerType = "CASecret"
+	// IssuerRefIssuerType uses an existing issuer (e.g. ACME or Vault) as the platform issuer.
+	IssuerRefIssuerType CertManagerIssuerType = "IssuerRef"
+)
+
+// RotateRootCAAnnotation requests a rotation of the self-signed root CA when set on the
+// KubernetesEngine instance. Any new value (e.g. a timestamp) triggers one rotation.
+const RotateRootCAAnnotation = "infrastructure.opendatahub.io/rotate-root-ca"
+
+// SelfSignedCAConfiguration defines the root CA certificate of the self-signed issuer chain.
+//
+// The root CA is rotated when the RotateRootCAAnnotation changes or once it is older than MaxAge.
+// A new root CA is issued, the CA bundle publishes both the previous and the new CA during the
+// rotation overlap window, leaf certificates are re-issued from the new CA and the previous CA is
+// then retired from the bundle.
+// +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="!has(self.duration) || !has(self.renewBefore) || duration(self.renewBefore) < duration(self.duration)",message="renewBefore must be shorter than duration"
+// +kubebuilder:validation:XValidation:rule="!has(self.duration) || !has(self.maxAge) || duration(self.maxAge) < duration(self.duration)",message="maxAge must be shorter than duration"
+type SelfSignedCAConfiguration struct {
+	// Duration is the validity period of the root CA certificate.
+	// Defaults to 876000h (~100 years).
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before expiry cert-manager renews the root CA certificate.
+	// When unset, cert-manager renews it after two thirds of its duration.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// MaxAge is the age after which the root CA is rotated.
+	// When unset, the root CA is only rotated on request.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// RotationOverlap is how long the previous root CA stays in the CA bundle after a rotation,
+	// so that consumers reload the bundle before certificates issued from the new CA are served.
+	// Leaf certificates are re-issued from the new CA halfway through the overlap.
+	// Defaults to 168h (7 days).
+	// +optional
+	RotationOverlap *metav1.Duration `json:"rotationOverlap,omitempty"`
+}
+
+// CASecretReference references a Secret holding a CA key pair to import.
+// +kubebuilder:object:generate=true
+type CASecretReference struct {
+	// Name is the name of the Secret. It must contain the CA certificate in tls.crt
+	// and its private key in tls.key.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret. Defaults to the cert-manager namespace.
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// IssuerReference references an existing cert-manager issuer.
+// +kubebuilder:object:generate=true
+type IssuerReference struct {
+	// Name is the name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer. It must be cluster-scoped, since the platform
+	// issuer signs certificates in several namespaces.
+	// +kubebuilder:default=ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group is the API group of the issuer, for external issuers such as AWS Private CA.
+	// +kubebuilder:default=cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
 // CertManagerConfiguration defines the configuration for the cert-manager operator dependency.
 // +kubebuilder:object:generate=true
-type CertManagerConfiguration struct{}
+// +kubebuilder:validation:XValidation:rule="!has(self.selfSigned) || !has(self.issuerType) || self.issuerType == 'SelfSigned'",message="selfSigned can only be set when issuerType is SelfSigned"
+// +kubebuilder:validation:XValidation:rule="(has(self.issuerType) && self.issuerType == 'CASecret') == has(self.caSecret)",message="caSecret must be set if and only if issuerType is CASecret"
+// +kubebuilder:validation:XValidation:rule="(has(self.issuerType) && self.issuerType == 'IssuerRef') == has(self.issuerRef)",message="issuerRef must be set if and only if issuerType is IssuerRef"
+type CertManagerConfiguration struct {
+	// IssuerType selects how the platform issuer chain is built:
+	// SelfSigned bootstraps a self-signed root CA, CASecret imports an existing CA key pair,
+	// and IssuerRef uses an existing issuer as the platform issuer.
+	// +kubebuilder:default=SelfSigned
+	// +optional
+	IssuerType CertManagerIssuerType `json:"issuerType,omitempty"`
+
+	// SelfSigned configures the root CA certificate of the SelfSigned issuer chain.
+	// +optional
+	SelfSigned *SelfSignedCAConfiguration `json:"selfSigned,omitempty"`
+
+	// CASecret references the CA key pair imported when issuerType is CASecret.
+	// +optional
+	CASecret *CASecretReference `json:"caSecret,omitempty"`
+
+	// IssuerRef references the issuer used as the platform issuer when issuerType is IssuerRef.
+	// Components outside of the cloud manager must be pointed at the same issuer
+	// (RHAI_ISSUER_REF_NAME and RHAI_ISSUER_REF_KIND).
+	// +optional
+	IssuerRef *IssuerReference `json:"issuerRef,omitempty"`
+}
+
+// GetIssuerType returns the configured issuer type, falling back to SelfSignedIssuerType if empty.
+func (c *CertManagerConfiguration) GetIssuerType() CertManagerIssuerType {
+	if c.IssuerType != "" {
+		return c.IssuerType
+	}
+
+	return SelfSignedIssuerType
+}
 
 // LWSConfiguration defines the configuration for the LeaderWorkerSet (LWS) operator dependency.
 // +kubebuilder:object:generate=true
@@ -50,11 +184,145 @@ type SailOperatorConfiguration struct {
 	Namespace Namespace `json:"namespace,omitempty"`
 }
 
+// KEDAConfiguration defines the configuration for the KEDA (event-driven autoscaler) operator dependency.
+// +kubebuilder:object:generate=true
+type KEDAConfiguration struct {
+	// Namespace is the namespace where the KEDA operator and its KedaController are deployed.
+	// +kubebuilder:default=openshift-keda
+	Namespace Namespace `json:"namespace,omitempty"`
+}
+
+// DependencyVersionPolicy pins the chart version of a cloud manager dependency.
+//
+// The bundled chart version is the one shipped with the operator. It is held back, keeping the
+// resources deployed from the previous version in place, when it does not satisfy Version or,
+// with the Manual upgrade policy, while it differs from the deployed version and has not been
+// approved through ApprovedVersion. The first installation of a dependency is never held back
+// by the Manual upgrade policy.
+// +kubebuilder:object:generate=true
+type DependencyVersionPolicy struct {
+	// Version is a semver range the bundled chart version must satisfy to be deployed,
+	// e.g. ">=1.27.0 <1.28.0" to stay on the 1.27 minor.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// UpgradePolicy determines how a new bundled chart version is rolled out.
+	// Automatic: the new version is deployed as soon as it is bundled.
+	// Manual: the deployed version is kept until approvedVersion is set to the new version.
+	// +kubebuilder:default=Automatic
+	// +optional
+	UpgradePolicy UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// ApprovedVersion approves the upgrade to the given bundled chart version
+	// when upgradePolicy is Manual. The available version is reported in status.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	ApprovedVersion string `json:"approvedVersion,omitempty"`
+}
+
+// GetUpgradePolicy returns the configured upgrade policy, falling back to Automatic if empty.
+func (p *DependencyVersionPolicy) GetUpgradePolicy() UpgradePolicy {
+	if p.UpgradePolicy != "" {
+		return p.UpgradePolicy
+	}
+
+	return Automatic
+}
+
+// DependencyVersionStatus reports the chart version of a cloud manager dependency.
+// +kubebuilder:object:generate=true
+type DependencyVersionStatus struct {
+	// Name is the release name of the dependency chart.
+	Name string `json:"name"`
+
+	// DeployedVersion is the chart version the deployed resources were rendered from.
+	// +optional
+	DeployedVersion string `json:"deployedVersion,omitempty"`
+
+	// AvailableVersion is the bundled chart version held back by the version policy.
+	// It is only set while an upgrade is held.
+	// +optional
+	AvailableVersion string `json:"availableVersion,omitempty"`
+}
+
+// ImageMirrors configures the registry mirrors the container images of the
+// cloud manager dependencies are pulled from, e.g. on disconnected clusters.
+// +kubebuilder:object:generate=true
+type ImageMirrors struct {
+	// Rules rewrite the images whose reference starts with a source prefix.
+	// When several rules match an image, the one with the longest source prefix is applied.
+	// Image references in container env values, such as the RELATED_IMAGE_* variables
+	// operators deploy their operands from, are rewritten as well.
+	// +listType=map
+	// +listMapKey=source
+	// +kubebuilder:validation:MinItems=1
+	Rules []ImageMirrorRule `json:"rules"`
+
+	// PullSecretRef references the pull secret for the mirrors. It is added to the
+	// ServiceAccounts of the dependencies, so it must exist in the namespace of each dependency.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+
+	// Strict fails the deployment of the dependencies when an image matches no rule,
+	// instead of pulling it from its original registry.
+	// +optional
+	Strict bool `json:"strict,omitempty"`
+}
+
+// ImageMirrorRule rewrites the image references starting with Source to start with Mirror.
+// +kubebuilder:object:generate=true
+type ImageMirrorRule struct {
+	// Source is the image reference prefix to rewrite, e.g. registry.k8s.io or quay.io/jetstack.
+	// +kubebuilder:validation:MinLength=1
+	Source string `json:"source"`
+
+	// Mirror is the prefix that replaces Source, e.g. mirror.example.com/k8s.
+	// +kubebuilder:validation:MinLength=1
+	Mirror string `json:"mirror"`
+}
+
+// DependencyInventory summarizes the resources applied for a cloud manager dependency.
+// The full list of resources is stored in the inventory ConfigMap of the instance.
+// +kubebuilder:object:generate=true
+type DependencyInventory struct {
+	// Name is the release name of the dependency chart.
+	Name string `json:"name"`
+
+	// ChartVersion is the version of the chart the resources were rendered from.
+	// +optional
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// Objects is the number of applied resources by kind.
+	// +listType=map
+	// +listMapKey=kind
+	// +optional
+	Objects []ObjectKindCount `json:"objects,omitempty"`
+
+	// Hash is the SHA-256 content hash of the applied manifests.
+	// +optional
+	Hash string `json:"hash,omitempty"`
+}
+
+// ObjectKindCount is the number of resources of a kind.
+// +kubebuilder:object:generate=true
+type ObjectKindCount struct {
+	// Kind is the group-qualified kind of the resources, e.g. Deployment.apps.
+	Kind string `json:"kind"`
+
+	// Count is the number of resources of the kind.
+	Count int32 `json:"count"`
+}
+
 // GatewayAPIConfiguration defines the configuration for the Gateway API dependency.
 // +kubebuilder:object:generate=true
 type GatewayAPIConfiguration struct{}
 
 // CertManagerDependency defines the cert-manager operator dependency.
+//
+// Unlike the other dependencies it does not inline DependencyVersionPolicy: cert-manager is a
+// prerequisite installed outside of the cloud manager, which never deploys a cert-manager chart
+// whose version could be pinned.
 // +kubebuilder:object:generate=true
 type CertManagerDependency struct {
 	// ManagementPolicy determines whether the operator manages this dependency.
@@ -69,6 +337,8 @@ type CertManagerDependency struct {
 // LWSDependency defines the LeaderWorkerSet operator dependency.
 // +kubebuilder:object:generate=true
 type LWSDependency struct {
+	DependencyVersionPolicy `json:",inline"`
+
 	// ManagementPolicy determines whether the operator manages this dependency.
 	// Managed: the operator installs and reconciles the dependency.
 	// Unmanaged: the operator does not manage the dependency; the user is responsible.
@@ -94,6 +364,8 @@ func (d *LWSDependency) GetNamespace() string {
 // SailOperatorDependency defines the Sail operator (Istio) dependency.
 // +kubebuilder:object:generate=true
 type SailOperatorDependency struct {
+	DependencyVersionPolicy `json:",inline"`
+
 	// ManagementPolicy determines whether the operator manages this dependency.
 	// Managed: the operator installs and reconciles the dependency.
 	// Unmanaged: the operator does not manage the dependency; the user is responsible.
@@ -116,9 +388,40 @@ func (d *SailOperatorDependency) GetNamespace() string {
 	return DefaultNamespaceSailOperator
 }
 
+// KEDADependency defines the KEDA (event-driven autoscaler) operator dependency.
+// +kubebuilder:object:generate=true
+type KEDADependency struct {
+	DependencyVersionPolicy `json:",inline"`
+
+	// ManagementPolicy determines whether the operator manages this dependency.
+	// Managed: the operator installs and reconciles the dependency.
+	// Unmanaged: the operator does not manage the dependency; the user is responsible.
+	// KEDA is opt-in: it defaults to Unmanaged, so that it is not installed on clusters
+	// that do not use event-driven autoscaling or already run their own KEDA.
+	// +kubebuilder:default=Unmanaged
+	ManagementPolicy ManagementPolicy `json:"managementPolicy,omitempty"`
+
+	// Configuration for the KEDA operator.
+	// +optional
+	// +kubebuilder:default={}
+	Configuration KEDAConfiguration `json:"configuration,omitempty"`
+}
+
+// GetNamespace returns the namespace where the KEDA operator is deployed,
+// falling back to DefaultNamespaceKEDAOperator if empty.
+func (d *KEDADependency) GetNamespace() string {
+	if d.Configuration.Namespace != "" {
+		return string(d.Configuration.Namespace)
+	}
+
+	return DefaultNamespaceKEDAOperator
+}
+
 // GatewayAPIDependency defines the Gateway API dependency.
 // +kubebuilder:object:generate=true
 type GatewayAPIDependency struct {
+	DependencyVersionPolicy `json:",inline"`
+
 	// ManagementPolicy determines whether the operator manages this dependency.
 	// Managed: the operator installs and reconciles the dependency.
 	// Unmanaged: the operator does not manage the dependency; the user is responsible.
@@ -130,10 +433,17 @@ type GatewayAPIDependency struct {
 	Configuration GatewayAPIConfiguration `json:"configuration,omitempty"`
 }
 
-// KubernetesEngineInstance is implemented by CCM CR types that expose their Dependencies.
+// KubernetesEngineInstance is implemented by CCM CR types that expose their Dependencies,
+// the chart versions deployed for them, the inventory of the applied resources and
+// the image mirrors the dependencies are pulled from.
 type KubernetesEngineInstance interface {
 	apicommon.PlatformObject
 	GetDependencies() Dependencies
+	GetDependencyVersions() []DependencyVersionStatus
+	SetDependencyVersions(versions []DependencyVersionStatus)
+	GetInventory() []DependencyInventory
+	SetInventory(inventory []DependencyInventory)
+	GetImageMirrors() *ImageMirrors
 }
 
 // Dependencies defines the dependency configurations for cloud manager operators.
@@ -154,4 +464,8 @@ type Dependencies struct {
 	// GatewayAPI defines the Gateway API dependency.
 	// +optional
 	GatewayAPI GatewayAPIDependency `json:"gatewayAPI,omitempty"`
+
+	// KEDA defines the KEDA (event-driven autoscaler) operator dependency.
+	// +optional
+	KEDA KEDADependency `json:"keda,omitempty"`
 }
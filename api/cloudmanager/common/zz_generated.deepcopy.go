@@ -20,11 +20,44 @@ limitations under the License.
 
 package common
 
-import ()
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CASecretReference) DeepCopyInto(out *CASecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CASecretReference.
+func (in *CASecretReference) DeepCopy() *CASecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CASecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerConfiguration) DeepCopyInto(out *CertManagerConfiguration) {
 	*out = *in
+	if in.SelfSigned != nil {
+		in, out := &in.SelfSigned, &out.SelfSigned
+		*out = new(SelfSignedCAConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(CASecretReference)
+		**out = **in
+	}
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(IssuerReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfiguration.
@@ -40,7 +73,7 @@ func (in *CertManagerConfiguration) DeepCopy() *CertManagerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerDependency) DeepCopyInto(out *CertManagerDependency) {
 	*out = *in
-	out.Configuration = in.Configuration
+	in.Configuration.DeepCopyInto(&out.Configuration)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerDependency.
@@ -56,10 +89,11 @@ func (in *CertManagerDependency) DeepCopy() *CertManagerDependency {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dependencies) DeepCopyInto(out *Dependencies) {
 	*out = *in
-	out.CertManager = in.CertManager
+	in.CertManager.DeepCopyInto(&out.CertManager)
 	out.LWS = in.LWS
 	out.SailOperator = in.SailOperator
 	out.GatewayAPI = in.GatewayAPI
+	out.KEDA = in.KEDA
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependencies.
@@ -72,6 +106,56 @@ func (in *Dependencies) DeepCopy() *Dependencies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyInventory) DeepCopyInto(out *DependencyInventory) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectKindCount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyInventory.
+func (in *DependencyInventory) DeepCopy() *DependencyInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyVersionPolicy) DeepCopyInto(out *DependencyVersionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyVersionPolicy.
+func (in *DependencyVersionPolicy) DeepCopy() *DependencyVersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyVersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyVersionStatus) DeepCopyInto(out *DependencyVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyVersionStatus.
+func (in *DependencyVersionStatus) DeepCopy() *DependencyVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayAPIConfiguration) DeepCopyInto(out *GatewayAPIConfiguration) {
 	*out = *in
@@ -90,6 +174,7 @@ func (in *GatewayAPIConfiguration) DeepCopy() *GatewayAPIConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayAPIDependency) DeepCopyInto(out *GatewayAPIDependency) {
 	*out = *in
+	out.DependencyVersionPolicy = in.DependencyVersionPolicy
 	out.Configuration = in.Configuration
 }
 
@@ -103,6 +188,93 @@ func (in *GatewayAPIDependency) DeepCopy() *GatewayAPIDependency {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageMirrorRule) DeepCopyInto(out *ImageMirrorRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageMirrorRule.
+func (in *ImageMirrorRule) DeepCopy() *ImageMirrorRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageMirrorRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageMirrors) DeepCopyInto(out *ImageMirrors) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ImageMirrorRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageMirrors.
+func (in *ImageMirrors) DeepCopy() *ImageMirrors {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageMirrors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerReference) DeepCopyInto(out *IssuerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerReference.
+func (in *IssuerReference) DeepCopy() *IssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAConfiguration) DeepCopyInto(out *KEDAConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAConfiguration.
+func (in *KEDAConfiguration) DeepCopy() *KEDAConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDADependency) DeepCopyInto(out *KEDADependency) {
+	*out = *in
+	out.DependencyVersionPolicy = in.DependencyVersionPolicy
+	out.Configuration = in.Configuration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDADependency.
+func (in *KEDADependency) DeepCopy() *KEDADependency {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDADependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LWSConfiguration) DeepCopyInto(out *LWSConfiguration) {
 	*out = *in
@@ -121,6 +293,7 @@ func (in *LWSConfiguration) DeepCopy() *LWSConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LWSDependency) DeepCopyInto(out *LWSDependency) {
 	*out = *in
+	out.DependencyVersionPolicy = in.DependencyVersionPolicy
 	out.Configuration = in.Configuration
 }
 
@@ -134,6 +307,21 @@ func (in *LWSDependency) DeepCopy() *LWSDependency {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectKindCount) DeepCopyInto(out *ObjectKindCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectKindCount.
+func (in *ObjectKindCount) DeepCopy() *ObjectKindCount {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectKindCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SailOperatorConfiguration) DeepCopyInto(out *SailOperatorConfiguration) {
 	*out = *in
@@ -152,6 +340,7 @@ func (in *SailOperatorConfiguration) DeepCopy() *SailOperatorConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SailOperatorDependency) DeepCopyInto(out *SailOperatorDependency) {
 	*out = *in
+	out.DependencyVersionPolicy = in.DependencyVersionPolicy
 	out.Configuration = in.Configuration
 }
 
@@ -164,3 +353,38 @@ func (in *SailOperatorDependency) DeepCopy() *SailOperatorDependency {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfSignedCAConfiguration) DeepCopyInto(out *SelfSignedCAConfiguration) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RotationOverlap != nil {
+		in, out := &in.RotationOverlap, &out.RotationOverlap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfSignedCAConfiguration.
+func (in *SelfSignedCAConfiguration) DeepCopy() *SelfSignedCAConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfSignedCAConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
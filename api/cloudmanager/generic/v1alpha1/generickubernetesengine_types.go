@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	apicommon "github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	GenericKubernetesEngineKind         = "GenericKubernetesEngine"
+	GenericKubernetesEngineInstanceName = "default-generickubernetesengine"
+
+	// DefaultGatewayClassName is the GatewayClass used by the platform gateway.
+	DefaultGatewayClassName = "data-science-gateway-class"
+)
+
+// GatewayServiceType defines how the Gateway Service is exposed outside the cluster.
+// +kubebuilder:validation:Enum=NodePort;LoadBalancer;ClusterIP
+type GatewayServiceType string
+
+const (
+	// GatewayServiceTypeNodePort exposes the Gateway on a port of every node.
+	// It works on clusters without a cloud load balancer (kind, k3s, bare metal without MetalLB).
+	GatewayServiceTypeNodePort GatewayServiceType = "NodePort"
+	// GatewayServiceTypeLoadBalancer exposes the Gateway through a LoadBalancer Service.
+	// Use it when the cluster provides a load balancer implementation (e.g. MetalLB or k3s ServiceLB).
+	GatewayServiceTypeLoadBalancer GatewayServiceType = "LoadBalancer"
+	// GatewayServiceTypeClusterIP keeps the Gateway internal to the cluster.
+	// Exposure is left to the user (e.g. an existing ingress controller or a port-forward).
+	GatewayServiceTypeClusterIP GatewayServiceType = "ClusterIP"
+)
+
+// Check that the component implements common.KubernetesEngineInstance.
+var _ common.KubernetesEngineInstance = (*GenericKubernetesEngine)(nil)
+
+// GenericGatewayConfiguration defines how the platform Gateway is exposed on a self-managed cluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.httpsNodePort) || self.serviceType == 'NodePort'",message="httpsNodePort can only be set when serviceType is NodePort"
+type GenericGatewayConfiguration struct {
+	// ServiceType is the type of the Service created for Gateways of the platform GatewayClass.
+	// Defaults to NodePort, which needs no cloud load balancer.
+	// +kubebuilder:default=NodePort
+	// +optional
+	ServiceType GatewayServiceType `json:"serviceType,omitempty"`
+
+	// HTTPSNodePort pins the node port of the Gateway HTTPS listener.
+	// When unset, Kubernetes allocates a port from the node port range.
+	// +kubebuilder:validation:Minimum=30000
+	// +kubebuilder:validation:Maximum=32767
+	// +optional
+	HTTPSNodePort *int32 `json:"httpsNodePort,omitempty"`
+
+	// GatewayClassName is the GatewayClass whose Gateways get the Service defaults above.
+	// +kubebuilder:default=data-science-gateway-class
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
+// GetGatewayClassName returns the configured GatewayClass name,
+// falling back to DefaultGatewayClassName if empty.
+func (c *GenericGatewayConfiguration) GetGatewayClassName() string {
+	if c.GatewayClassName != "" {
+		return c.GatewayClassName
+	}
+
+	return DefaultGatewayClassName
+}
+
+// GetServiceType returns the configured Gateway Service type,
+// falling back to GatewayServiceTypeNodePort if empty.
+func (c *GenericGatewayConfiguration) GetServiceType() GatewayServiceType {
+	if c.ServiceType != "" {
+		return c.ServiceType
+	}
+
+	return GatewayServiceTypeNodePort
+}
+
+// GenericStorageConfiguration defines the storage assumptions made for a self-managed cluster.
+type GenericStorageConfiguration struct {
+	// StorageClassName is the StorageClass that platform workloads are expected to use.
+	// When unset, the cluster must have a default StorageClass
+	// (annotated with storageclass.kubernetes.io/is-default-class=true).
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+// GenericKubernetesEngineSpec defines the desired state of GenericKubernetesEngine.
+type GenericKubernetesEngineSpec struct {
+	// Dependencies defines the dependency configurations for the self-managed Kubernetes cluster.
+	// +optional
+	Dependencies common.Dependencies `json:"dependencies,omitempty"`
+
+	// Gateway defines how the platform Gateway is exposed.
+	// +optional
+	// +kubebuilder:default={}
+	Gateway GenericGatewayConfiguration `json:"gateway,omitempty"`
+
+	// Storage defines the storage class assumptions for the cluster.
+	// +optional
+	Storage GenericStorageConfiguration `json:"storage,omitempty"`
+
+	// ImageMirrors configures the registry mirrors the images of the dependencies are pulled from.
+	// +optional
+	ImageMirrors *common.ImageMirrors `json:"imageMirrors,omitempty"`
+}
+
+// GenericKubernetesEngineStatus defines the observed state of GenericKubernetesEngine.
+type GenericKubernetesEngineStatus struct {
+	apicommon.Status `json:",inline"`
+
+	// DependencyVersions reports the chart versions of the managed dependencies.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DependencyVersions []common.DependencyVersionStatus `json:"dependencyVersions,omitempty"`
+
+	// Inventory summarizes the resources applied for each managed dependency.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Inventory []common.DependencyInventory `json:"inventory,omitempty"`
+
+	// StorageClassName is the StorageClass resolved for platform workloads,
+	// either the configured one or the cluster default.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-generickubernetesengine'",message="GenericKubernetesEngine name must be default-generickubernetesengine"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Deps Available",type=string,JSONPath=`.status.conditions[?(@.type=="DependenciesAvailable")].status`,description="DependenciesAvailable"
+// +kubebuilder:printcolumn:name="Storage Class",type=string,JSONPath=`.status.storageClassName`,description="StorageClassName"
+
+// GenericKubernetesEngine is the Schema for the GenericKubernetesEngines API.
+// It represents the configuration for a self-managed Kubernetes cluster such as kind, k3s or bare metal.
+type GenericKubernetesEngine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GenericKubernetesEngineSpec   `json:"spec,omitempty"`
+	Status GenericKubernetesEngineStatus `json:"status,omitempty"`
+}
+
+func (e *GenericKubernetesEngine) GetDependencies() common.Dependencies {
+	return e.Spec.Dependencies
+}
+
+func (e *GenericKubernetesEngine) GetDependencyVersions() []common.DependencyVersionStatus {
+	return e.Status.DependencyVersions
+}
+
+func (e *GenericKubernetesEngine) SetDependencyVersions(versions []common.DependencyVersionStatus) {
+	e.Status.DependencyVersions = versions
+}
+
+func (e *GenericKubernetesEngine) GetImageMirrors() *common.ImageMirrors {
+	return e.Spec.ImageMirrors
+}
+
+func (e *GenericKubernetesEngine) GetInventory() []common.DependencyInventory {
+	return e.Status.Inventory
+}
+
+func (e *GenericKubernetesEngine) SetInventory(inventory []common.DependencyInventory) {
+	e.Status.Inventory = inventory
+}
+
+// +kubebuilder:object:root=true
+
+// GenericKubernetesEngineList contains a list of GenericKubernetesEngine.
+type GenericKubernetesEngineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GenericKubernetesEngine `json:"items"`
+}
+
+func (s *GenericKubernetesEngine) GetConditions() []apicommon.Condition {
+	return s.Status.GetConditions()
+}
+
+func (s *GenericKubernetesEngine) GetStatus() *apicommon.Status {
+	return &s.Status.Status
+}
+
+func (c *GenericKubernetesEngine) SetConditions(conditions []apicommon.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&GenericKubernetesEngine{}, &GenericKubernetesEngineList{})
+}
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -57,11 +59,107 @@ type KueueSpec struct {
 	KueueDefaultQueueSpec `json:",inline"`
 }
 
-type KueueCommonSpec struct{}
+type KueueCommonSpec struct {
+	// Queues declares the cohorts and the per-team ClusterQueues managed by the operator,
+	// along with the LocalQueues created in the namespaces of each team.
+	// +optional
+	Queues *KueueQueuesSpec `json:"queues,omitempty"`
+}
+
+// KueueQueuesSpec defines the team queues to share the cluster quota across teams.
+// +kubebuilder:validation:XValidation:rule="!has(self.teams) || self.teams.all(t, !has(t.cohort) || (has(self.cohorts) && self.cohorts.exists(c, c.name == t.cohort)))",message="team cohort must be declared in cohorts"
+type KueueQueuesSpec struct {
+	// Cohorts lists the cohorts the team ClusterQueues can join. ClusterQueues in the
+	// same cohort can borrow the unused quota of each other.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Cohorts []KueueCohort `json:"cohorts,omitempty"`
+	// Teams lists the ClusterQueues to create, one per team.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Teams []KueueTeamQueue `json:"teams,omitempty"`
+}
+
+// KueueCohort defines a group of ClusterQueues sharing their unused quota.
+type KueueCohort struct {
+	// Name of the cohort.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+}
+
+// KueueTeamQueue defines the ClusterQueue of a team and the namespaces that get a LocalQueue
+// pointing to it.
+type KueueTeamQueue struct {
+	// Name of the team ClusterQueue.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Cohort the ClusterQueue belongs to, it must be one of the declared cohorts.
+	// +optional
+	Cohort string `json:"cohort,omitempty"`
+	// NamespaceSelector selects, among the Kueue managed namespaces, the namespaces of the team.
+	// A LocalQueue pointing to the team ClusterQueue is created in each of them.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// LocalQueueName is the name of the LocalQueues created in the team namespaces.
+	// Defaults to the name of the team.
+	// +optional
+	LocalQueueName string `json:"localQueueName,omitempty"`
+	// Flavors defines the quotas of the team for each ResourceFlavor. Flavors covering the
+	// same resources are grouped in the same resource group of the ClusterQueue.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	Flavors []KueueFlavorQuota `json:"flavors"`
+}
+
+// KueueFlavorQuota defines the quotas of a team for a ResourceFlavor.
+type KueueFlavorQuota struct {
+	// Name of the ResourceFlavor.
+	Name string `json:"name"`
+	// Resources defines the quota of each resource provided by the flavor.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	Resources []KueueResourceQuota `json:"resources"`
+}
+
+// KueueResourceQuota defines the quota of a team for a resource.
+type KueueResourceQuota struct {
+	// Name of the resource, e.g. cpu, memory or nvidia.com/gpu.
+	Name corev1.ResourceName `json:"name"`
+	// NominalQuota is the quantity of the resource guaranteed to the team.
+	NominalQuota resource.Quantity `json:"nominalQuota"`
+	// BorrowingLimit is the maximum quantity of the resource the team can borrow from the
+	// cohort on top of its nominal quota. Unlimited when not set.
+	// +optional
+	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
+	// LendingLimit is the maximum quantity of the nominal quota of the team that can be lent
+	// to the cohort. Unlimited when not set.
+	// +optional
+	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+}
 
 // KueueCommonStatus defines the shared observed state of Kueue
 type KueueCommonStatus struct {
 	common.ComponentReleaseStatus `json:",inline"`
+	// HardwareProfileFlavors reports the ResourceFlavor generated for each HardwareProfile,
+	// when hardwareProfileFlavors is enabled.
+	// +listType=atomic
+	// +optional
+	HardwareProfileFlavors []KueueHardwareProfileFlavor `json:"hardwareProfileFlavors,omitempty"`
+}
+
+// KueueHardwareProfileFlavor reports the ResourceFlavor generated from a HardwareProfile.
+type KueueHardwareProfileFlavor struct {
+	// Namespace of the HardwareProfile.
+	Namespace string `json:"namespace"`
+	// Name of the HardwareProfile.
+	Name string `json:"name"`
+	// Flavor is the name of the ResourceFlavor generated from the HardwareProfile.
+	Flavor string `json:"flavor"`
 }
 
 // KueueStatus defines the observed state of Kueue
@@ -136,6 +234,18 @@ type KueueDefaultQueueSpec struct {
 	// Only used when autoCreateQueues is true.
 	// +kubebuilder:default=default
 	DefaultClusterQueueName string `json:"defaultClusterQueueName,omitempty"`
+	// HardwareProfileFlavors controls whether a ResourceFlavor is generated for each enabled
+	// HardwareProfile using Node scheduling with a node selector, carrying its node selector, its
+	// tolerations and, as node taints, the taints they tolerate. The flavors are added to the
+	// default ClusterQueue after the default flavor, so that workloads without the node selector
+	// of a profile are admitted to the default flavor, with the allocatable resources of the nodes
+	// assigned to each profile as nominal quota. A node is assigned to the first flavor, by name,
+	// whose profile selects it, and to the default flavor when no profile does. They replace the GPU
+	// flavors generated from the cluster nodes, and the default ClusterQueue is then kept in sync
+	// by the operator.
+	// Only used when autoCreateQueues is true.
+	// +kubebuilder:default=false
+	HardwareProfileFlavors *bool `json:"hardwareProfileFlavors,omitempty"`
 }
 
 // DSCKueue contains all the configuration exposed in DSC instance for Kueue component
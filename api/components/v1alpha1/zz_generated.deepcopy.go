@@ -353,7 +353,7 @@ func (in *DSCKserveStatus) DeepCopy() *DSCKserveStatus {
 func (in *DSCKueue) DeepCopyInto(out *DSCKueue) {
 	*out = *in
 	out.KueueManagementSpec = in.KueueManagementSpec
-	out.KueueCommonSpec = in.KueueCommonSpec
+	in.KueueCommonSpec.DeepCopyInto(&out.KueueCommonSpec)
 	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
@@ -1160,9 +1160,29 @@ func (in *Kueue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueCohort) DeepCopyInto(out *KueueCohort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCohort.
+func (in *KueueCohort) DeepCopy() *KueueCohort {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueCohort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueCommonSpec) DeepCopyInto(out *KueueCommonSpec) {
 	*out = *in
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(KueueQueuesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCommonSpec.
@@ -1179,6 +1199,11 @@ func (in *KueueCommonSpec) DeepCopy() *KueueCommonSpec {
 func (in *KueueCommonStatus) DeepCopyInto(out *KueueCommonStatus) {
 	*out = *in
 	in.ComponentReleaseStatus.DeepCopyInto(&out.ComponentReleaseStatus)
+	if in.HardwareProfileFlavors != nil {
+		in, out := &in.HardwareProfileFlavors, &out.HardwareProfileFlavors
+		*out = make([]KueueHardwareProfileFlavor, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCommonStatus.
@@ -1199,6 +1224,11 @@ func (in *KueueDefaultQueueSpec) DeepCopyInto(out *KueueDefaultQueueSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HardwareProfileFlavors != nil {
+		in, out := &in.HardwareProfileFlavors, &out.HardwareProfileFlavors
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueDefaultQueueSpec.
@@ -1211,6 +1241,43 @@ func (in *KueueDefaultQueueSpec) DeepCopy() *KueueDefaultQueueSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueFlavorQuota) DeepCopyInto(out *KueueFlavorQuota) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]KueueResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueFlavorQuota.
+func (in *KueueFlavorQuota) DeepCopy() *KueueFlavorQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueFlavorQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueHardwareProfileFlavor) DeepCopyInto(out *KueueHardwareProfileFlavor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueHardwareProfileFlavor.
+func (in *KueueHardwareProfileFlavor) DeepCopy() *KueueHardwareProfileFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueHardwareProfileFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueList) DeepCopyInto(out *KueueList) {
 	*out = *in
@@ -1258,11 +1325,64 @@ func (in *KueueManagementSpec) DeepCopy() *KueueManagementSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueQueuesSpec) DeepCopyInto(out *KueueQueuesSpec) {
+	*out = *in
+	if in.Cohorts != nil {
+		in, out := &in.Cohorts, &out.Cohorts
+		*out = make([]KueueCohort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]KueueTeamQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueQueuesSpec.
+func (in *KueueQueuesSpec) DeepCopy() *KueueQueuesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueQueuesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueResourceQuota) DeepCopyInto(out *KueueResourceQuota) {
+	*out = *in
+	out.NominalQuota = in.NominalQuota.DeepCopy()
+	if in.BorrowingLimit != nil {
+		in, out := &in.BorrowingLimit, &out.BorrowingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LendingLimit != nil {
+		in, out := &in.LendingLimit, &out.LendingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueResourceQuota.
+func (in *KueueResourceQuota) DeepCopy() *KueueResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueSpec) DeepCopyInto(out *KueueSpec) {
 	*out = *in
 	out.KueueManagementSpec = in.KueueManagementSpec
-	out.KueueCommonSpec = in.KueueCommonSpec
+	in.KueueCommonSpec.DeepCopyInto(&out.KueueCommonSpec)
 	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
@@ -1293,6 +1413,29 @@ func (in *KueueStatus) DeepCopy() *KueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueTeamQueue) DeepCopyInto(out *KueueTeamQueue) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]KueueFlavorQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueTeamQueue.
+func (in *KueueTeamQueue) DeepCopy() *KueueTeamQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueTeamQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LlamaStackOperatorCommonSpec) DeepCopyInto(out *LlamaStackOperatorCommonSpec) {
 	*out = *in
@@ -85,7 +85,7 @@ func (in *ComponentsStatus) DeepCopy() *ComponentsStatus {
 func (in *DSCKueueV1) DeepCopyInto(out *DSCKueueV1) {
 	*out = *in
 	out.KueueManagementSpecV1 = in.KueueManagementSpecV1
-	out.KueueCommonSpec = in.KueueCommonSpec
+	in.KueueCommonSpec.DeepCopyInto(&out.KueueCommonSpec)
 	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
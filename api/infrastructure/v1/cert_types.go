@@ -6,10 +6,12 @@ const (
 	SelfSigned              CertType = "SelfSigned"
 	Provided                CertType = "Provided"
 	OpenshiftDefaultIngress CertType = "OpenshiftDefaultIngress"
+	CertManager             CertType = "CertManager"
 )
 
 // CertificateSpec represents the specification of the certificate securing communications of
 // an Istio Gateway.
+// +kubebuilder:validation:XValidation:rule="!has(self.issuerKind) || has(self.issuerName)",message="issuerName must be set when issuerKind is set"
 type CertificateSpec struct {
 	// SecretName specifies the name of the Kubernetes Secret resource that contains a
 	// TLS certificate secure HTTP communications for the KNative network.
@@ -19,7 +21,18 @@ type CertificateSpec struct {
 	// * SelfSigned: A certificate is going to be generated using an own private key.
 	// * Provided: Pre-existence of the TLS Secret (see SecretName) with a valid certificate is assumed.
 	// * OpenshiftDefaultIngress: Default ingress certificate configured for OpenShift
-	// +kubebuilder:validation:Enum=SelfSigned;Provided;OpenshiftDefaultIngress
+	// * CertManager: A cert-manager Certificate is requested from the issuer referenced by IssuerName and IssuerKind.
+	// +kubebuilder:validation:Enum=SelfSigned;Provided;OpenshiftDefaultIngress;CertManager
 	// +kubebuilder:default=OpenshiftDefaultIngress
 	Type CertType `json:"type,omitempty"`
+	// IssuerName is the name of the cert-manager issuer signing the certificate when Type is
+	// CertManager. Defaults to the platform issuer, the CA ClusterIssuer bootstrapped by the operator
+	// unless RHAI_ISSUER_REF_NAME and RHAI_ISSUER_REF_KIND reference another one.
+	// +optional
+	IssuerName string `json:"issuerName,omitempty"`
+	// IssuerKind is the kind of the cert-manager issuer referenced by IssuerName, which must be set.
+	// Defaults to ClusterIssuer. An Issuer must live in the namespace of the certificate.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	IssuerKind string `json:"issuerKind,omitempty"`
 }
@@ -19,7 +19,10 @@ package v1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 // SchedulingType defines the scheduling method for the hardware profile.
@@ -42,6 +45,73 @@ type HardwareProfileSpec struct {
 	// SchedulingSpec specifies how workloads using this hardware profile should be scheduled.
 	// +optional
 	SchedulingSpec *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// Propagation specifies how changes to this hardware profile are applied to the existing
+	// workloads referencing it. By default, they are applied at the next update of the workloads.
+	// +optional
+	Propagation *PropagationSpec `json:"propagation,omitempty"`
+
+	// Access restricts the workloads allowed to use this hardware profile. By default, the
+	// workloads of any namespace can use it.
+	// +optional
+	Access *AccessSpec `json:"access,omitempty"`
+}
+
+// AccessSpec restricts the workloads allowed to use a hardware profile. A workload can use the
+// profile when every configured restriction allows it: its namespace must be listed in Namespaces
+// or match NamespaceSelector, and the user creating it, or switching it to the profile, must be a
+// member of one of Groups. Restrictions are checked at admission, when a workload starts
+// referencing the profile.
+type AccessSpec struct {
+	// Namespaces lists the namespaces whose workloads can use the profile.
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects, by label, the namespaces whose workloads can use the profile.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Groups lists the groups whose members can use the profile, in the namespaces allowed by
+	// Namespaces and NamespaceSelector, or in any namespace when neither is set.
+	// +optional
+	// +listType=set
+	Groups []string `json:"groups,omitempty"`
+}
+
+// PropagationStrategy defines how changes to a hardware profile are applied to existing workloads.
+type PropagationStrategy string
+
+const (
+	// NonePropagation applies the changes to workloads at their next update only.
+	NonePropagation PropagationStrategy = "None"
+
+	// ImmediatePropagation updates all the existing workloads at once.
+	ImmediatePropagation PropagationStrategy = "Immediate"
+
+	// BatchedPropagation updates the existing workloads in batches of BatchSize workloads.
+	BatchedPropagation PropagationStrategy = "Batched"
+)
+
+// PropagationSpec defines how changes to a hardware profile are applied to the existing workloads
+// referencing it. The settings of the previous version of the profile are removed from the workloads,
+// and those of the new version applied, as when switching profiles. Resource requests and limits equal
+// to the previous default counts are replaced by the new ones.
+// +kubebuilder:validation:XValidation:rule="self.strategy == 'Batched' || !has(self.batchSize)",message="batchSize can only be set when strategy is 'Batched'"
+type PropagationSpec struct {
+	// Strategy is the propagation strategy. Allowed values are:
+	// * None: Changes are applied to workloads at their next update only.
+	// * Immediate: All the existing workloads are updated at once.
+	// * Batched: The existing workloads are updated in batches of BatchSize workloads.
+	// +kubebuilder:validation:Enum=None;Immediate;Batched
+	// +kubebuilder:default=None
+	// +optional
+	Strategy PropagationStrategy `json:"strategy,omitempty"`
+
+	// BatchSize is the number of workloads updated at once with the Batched strategy. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
 }
 
 type HardwareIdentifier struct {
@@ -118,12 +188,121 @@ type NodeSchedulingSpec struct {
 	// These tolerations allow workloads to be scheduled on nodes with matching taints.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeAffinity specifies the node affinity to apply to workloads for direct node scheduling.
+	// Required terms are combined with the workload ones, so that workloads are only scheduled
+	// on nodes matching both. Preferred terms are added to the workload ones.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// TopologySpreadConstraints specifies how workloads should be spread across topology domains,
+	// e.g. to place the pods of a multi-GPU training job in the same zone.
+	// They take precedence over workload constraints with the same topologyKey and whenUnsatisfiable.
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PriorityClassName specifies the name of the PriorityClass to apply to workload pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
+const (
+	// HardwareProfileConditionSchedulable reports whether any node matches the node scheduling
+	// configuration of the profile and provides its accelerator identifiers.
+	HardwareProfileConditionSchedulable = "Schedulable"
+
+	// HardwareProfileConditionQueueExists reports whether the Kueue LocalQueue referenced by
+	// the profile exists in the profile namespace.
+	HardwareProfileConditionQueueExists = "QueueExists"
+
+	// HardwareProfileConditionPropagated reports whether the current generation of the profile
+	// has been applied to all the existing workloads referencing it.
+	HardwareProfileConditionPropagated = "Propagated"
+)
+
 // HardwareProfileStatus defines the observed state of HardwareProfile.
 type HardwareProfileStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ObservedGeneration is the generation of the profile last evaluated by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe whether workloads using the profile can be scheduled.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadCount is the total number of workloads referencing the profile.
+	// +optional
+	WorkloadCount int32 `json:"workloadCount,omitempty"`
+
+	// Workloads counts the workloads referencing the profile, by kind and namespace.
+	// +optional
+	// +listType=atomic
+	Workloads []HardwareProfileWorkloads `json:"workloads,omitempty"`
+
+	// Propagation reports the propagation of the profile changes to the existing workloads.
+	// +optional
+	Propagation *HardwareProfilePropagationStatus `json:"propagation,omitempty"`
+}
+
+// HardwareProfilePropagationStatus reports the propagation of the profile changes to the existing workloads.
+type HardwareProfilePropagationStatus struct {
+	// PropagatedGeneration is the generation of the profile last applied to all the existing workloads.
+	// +optional
+	PropagatedGeneration int64 `json:"propagatedGeneration,omitempty"`
+
+	// PropagatedSpec is the spec of the profile at PropagatedGeneration, whose settings are
+	// removed from the workloads when propagating the next generation.
+	// +optional
+	PropagatedSpec *HardwareProfileSpec `json:"propagatedSpec,omitempty"`
+
+	// Generation is the generation of the profile the Updated and Failed workloads refer to.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Updated lists the workloads updated with the settings of Generation.
+	// +optional
+	// +listType=atomic
+	Updated []HardwareProfileWorkloadReference `json:"updated,omitempty"`
+
+	// Failed lists the workloads the settings of Generation could not be applied to.
+	// +optional
+	// +listType=atomic
+	Failed []HardwareProfileWorkloadFailure `json:"failed,omitempty"`
+}
+
+// HardwareProfileWorkloadReference identifies a workload referencing a profile.
+type HardwareProfileWorkloadReference struct {
+	// Kind of the workload, e.g. InferenceService.
+	Kind string `json:"kind"`
+
+	// Namespace of the workload.
+	Namespace string `json:"namespace"`
+
+	// Name of the workload.
+	Name string `json:"name"`
+}
+
+// HardwareProfileWorkloadFailure is a workload the profile could not be applied to.
+type HardwareProfileWorkloadFailure struct {
+	HardwareProfileWorkloadReference `json:",inline"`
+
+	// Message describes the failure.
+	Message string `json:"message"`
+}
+
+// HardwareProfileWorkloads is the number of workloads of a kind, in a namespace, referencing a profile.
+type HardwareProfileWorkloads struct {
+	// Kind of the workloads, e.g. InferenceService.
+	Kind string `json:"kind"`
+
+	// Namespace of the workloads.
+	Namespace string `json:"namespace"`
+
+	// Count of the workloads.
+	Count int32 `json:"count"`
 }
 
 // +kubebuilder:object:root=true
@@ -148,6 +327,23 @@ type HardwareProfileList struct {
 	Items           []HardwareProfile `json:"items"`
 }
 
+// HardwareProfileReference returns the HardwareProfile referenced by the hardware profile
+// annotations of obj, and false if obj references none. The profile namespace defaults to
+// the namespace of obj.
+func HardwareProfileReference(obj metav1.Object) (types.NamespacedName, bool) {
+	name := obj.GetAnnotations()[annotations.HardwareProfileName]
+	if name == "" {
+		return types.NamespacedName{}, false
+	}
+
+	namespace := obj.GetAnnotations()[annotations.HardwareProfileNamespace]
+	if namespace == "" {
+		namespace = obj.GetNamespace()
+	}
+
+	return types.NamespacedName{Name: name, Namespace: namespace}, true
+}
+
 func init() {
 	SchemeBuilder.Register(&HardwareProfile{}, &HardwareProfileList{})
 }
@@ -7,7 +7,10 @@ import (
 	infrav1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 // TestHardwareProfileSpecStructure validates basic struct construction and field access
@@ -187,3 +190,52 @@ func TestV1alpha1HardwareProfile(t *testing.T) {
 	g.Expect(orig.Spec.Identifiers[0].DisplayName).To(Equal("CPU"))
 	g.Expect(orig.Spec.SchedulingSpec.Kueue.PriorityClass).To(Equal(""))
 }
+
+func TestHardwareProfileReference(t *testing.T) {
+	workload := func(namespace string, profileAnnotations map[string]string) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{Name: "w", Namespace: namespace, Annotations: profileAnnotations},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		obj        *metav1.PartialObjectMetadata
+		expected   types.NamespacedName
+		references bool
+	}{
+		{
+			name: "explicit profile namespace",
+			obj: workload("user", map[string]string{
+				annotations.HardwareProfileName:      "gpu",
+				annotations.HardwareProfileNamespace: "profiles",
+			}),
+			expected:   types.NamespacedName{Name: "gpu", Namespace: "profiles"},
+			references: true,
+		},
+		{
+			name:       "profile namespace defaults to the workload namespace",
+			obj:        workload("user", map[string]string{annotations.HardwareProfileName: "gpu"}),
+			expected:   types.NamespacedName{Name: "gpu", Namespace: "user"},
+			references: true,
+		},
+		{
+			name: "namespace annotation without a profile name",
+			obj:  workload("user", map[string]string{annotations.HardwareProfileNamespace: "profiles"}),
+		},
+		{
+			name: "no annotation",
+			obj:  workload("user", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref, ok := HardwareProfileReference(tt.obj)
+			g.Expect(ok).To(Equal(tt.references))
+			g.Expect(ref).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// HardwareProfileTargetConditionReady reports whether the workload kind of the target
+	// is served by the cluster and registered with the hardware profile webhook.
+	HardwareProfileTargetConditionReady = "Ready"
+)
+
+// HardwareProfileTargetSpec declares a workload kind hardware profiles are injected into.
+type HardwareProfileTargetSpec struct {
+	// Group of the workload kind, empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version of the workload kind.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Kind of the workload, e.g. RayCluster or Deployment.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// PodTemplates locates the pod specs of the workload the hardware profile is applied to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	PodTemplates []HardwareProfilePodTemplate `json:"podTemplates"`
+}
+
+// GroupVersionKind returns the workload kind declared by the target.
+func (s *HardwareProfileTargetSpec) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: s.Group, Version: s.Version, Kind: s.Kind}
+}
+
+// HardwareProfilePodTemplate locates pod specs within a workload. Paths are dot-separated
+// field names.
+type HardwareProfilePodTemplate struct {
+	// Name identifies the pod template, e.g. head or workers.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// PodSpecPath is the path of the pod spec within the workload, e.g. spec.template.spec.
+	// A "*" segment matches every element of a list or every value of a map, so that
+	// spec.workerGroupSpecs.*.template.spec addresses the pod spec of each Ray worker group.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([A-Za-z0-9_-]+|\*)(\.([A-Za-z0-9_-]+|\*))*$`
+	PodSpecPath string `json:"podSpecPath"`
+
+	// ContainersPath is the path of the containers receiving the profile identifiers, relative to the pod spec.
+	// +kubebuilder:default=containers
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`
+	// +optional
+	ContainersPath string `json:"containersPath,omitempty"`
+
+	// NodeSelectorPath is the path of the node selector, relative to the pod spec.
+	// +kubebuilder:default=nodeSelector
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`
+	// +optional
+	NodeSelectorPath string `json:"nodeSelectorPath,omitempty"`
+
+	// TolerationsPath is the path of the tolerations, relative to the pod spec.
+	// +kubebuilder:default=tolerations
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`
+	// +optional
+	TolerationsPath string `json:"tolerationsPath,omitempty"`
+}
+
+// HardwareProfileTargetStatus defines the observed state of HardwareProfileTarget.
+type HardwareProfileTargetStatus struct {
+	// ObservedGeneration is the generation of the target last evaluated by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resource is the API resource of the workload kind, e.g. rayclusters.
+	// +optional
+	Resource string `json:"resource,omitempty"`
+
+	// Conditions describe whether the target is registered with the hardware profile webhook.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.kind`,description="Kind of the workloads"
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.group`,description="Group of the workloads"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+
+// HardwareProfileTarget declares a workload kind, and where its pod specs are, so that
+// hardware profiles referenced by the workloads of that kind are injected into them.
+// The operator propagates HardwareProfile changes to the existing workloads of the kind
+// with the permissions aggregated from the ClusterRoles labeled
+// infrastructure.opendatahub.io/aggregate-to-hardwareprofile-targets: "true", which must
+// grant get, list and update on its resource.
+type HardwareProfileTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HardwareProfileTargetSpec   `json:"spec"`
+	Status HardwareProfileTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HardwareProfileTargetList contains a list of HardwareProfileTarget.
+type HardwareProfileTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HardwareProfileTarget `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HardwareProfileTarget{}, &HardwareProfileTargetList{})
+}
@@ -22,10 +22,41 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessSpec) DeepCopyInto(out *AccessSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessSpec.
+func (in *AccessSpec) DeepCopy() *AccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 	*out = *in
@@ -120,7 +151,7 @@ func (in *HardwareProfile) DeepCopyInto(out *HardwareProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfile.
@@ -173,6 +204,51 @@ func (in *HardwareProfileList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfilePodTemplate) DeepCopyInto(out *HardwareProfilePodTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfilePodTemplate.
+func (in *HardwareProfilePodTemplate) DeepCopy() *HardwareProfilePodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfilePodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfilePropagationStatus) DeepCopyInto(out *HardwareProfilePropagationStatus) {
+	*out = *in
+	if in.PropagatedSpec != nil {
+		in, out := &in.PropagatedSpec, &out.PropagatedSpec
+		*out = new(HardwareProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Updated != nil {
+		in, out := &in.Updated, &out.Updated
+		*out = make([]HardwareProfileWorkloadReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]HardwareProfileWorkloadFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfilePropagationStatus.
+func (in *HardwareProfilePropagationStatus) DeepCopy() *HardwareProfilePropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfilePropagationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HardwareProfileSpec) DeepCopyInto(out *HardwareProfileSpec) {
 	*out = *in
@@ -188,6 +264,16 @@ func (in *HardwareProfileSpec) DeepCopyInto(out *HardwareProfileSpec) {
 		*out = new(SchedulingSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Propagation != nil {
+		in, out := &in.Propagation, &out.Propagation
+		*out = new(PropagationSpec)
+		**out = **in
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(AccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileSpec.
@@ -203,6 +289,23 @@ func (in *HardwareProfileSpec) DeepCopy() *HardwareProfileSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HardwareProfileStatus) DeepCopyInto(out *HardwareProfileStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]HardwareProfileWorkloads, len(*in))
+		copy(*out, *in)
+	}
+	if in.Propagation != nil {
+		in, out := &in.Propagation, &out.Propagation
+		*out = new(HardwareProfilePropagationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileStatus.
@@ -215,6 +318,153 @@ func (in *HardwareProfileStatus) DeepCopy() *HardwareProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileTarget) DeepCopyInto(out *HardwareProfileTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileTarget.
+func (in *HardwareProfileTarget) DeepCopy() *HardwareProfileTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HardwareProfileTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileTargetList) DeepCopyInto(out *HardwareProfileTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HardwareProfileTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileTargetList.
+func (in *HardwareProfileTargetList) DeepCopy() *HardwareProfileTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HardwareProfileTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileTargetSpec) DeepCopyInto(out *HardwareProfileTargetSpec) {
+	*out = *in
+	if in.PodTemplates != nil {
+		in, out := &in.PodTemplates, &out.PodTemplates
+		*out = make([]HardwareProfilePodTemplate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileTargetSpec.
+func (in *HardwareProfileTargetSpec) DeepCopy() *HardwareProfileTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileTargetStatus) DeepCopyInto(out *HardwareProfileTargetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileTargetStatus.
+func (in *HardwareProfileTargetStatus) DeepCopy() *HardwareProfileTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileWorkloadFailure) DeepCopyInto(out *HardwareProfileWorkloadFailure) {
+	*out = *in
+	out.HardwareProfileWorkloadReference = in.HardwareProfileWorkloadReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileWorkloadFailure.
+func (in *HardwareProfileWorkloadFailure) DeepCopy() *HardwareProfileWorkloadFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileWorkloadFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileWorkloadReference) DeepCopyInto(out *HardwareProfileWorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileWorkloadReference.
+func (in *HardwareProfileWorkloadReference) DeepCopy() *HardwareProfileWorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileWorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareProfileWorkloads) DeepCopyInto(out *HardwareProfileWorkloads) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareProfileWorkloads.
+func (in *HardwareProfileWorkloads) DeepCopy() *HardwareProfileWorkloads {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareProfileWorkloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueSchedulingSpec) DeepCopyInto(out *KueueSchedulingSpec) {
 	*out = *in
@@ -247,6 +497,18 @@ func (in *NodeSchedulingSpec) DeepCopyInto(out *NodeSchedulingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(corev1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSchedulingSpec.
@@ -259,6 +521,21 @@ func (in *NodeSchedulingSpec) DeepCopy() *NodeSchedulingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropagationSpec) DeepCopyInto(out *PropagationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropagationSpec.
+func (in *PropagationSpec) DeepCopy() *PropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
 	*out = *in
@@ -30,6 +30,10 @@ const (
 	// value should match what's set in the XValidation below
 	GatewayConfigName = "default-gateway"
 	GatewayConfigKind = "GatewayConfig"
+
+	// GatewayShardLabel assigns a PlatformRoute to the gateway shard with the label value as name.
+	// PlatformRoutes without the label are attached to the main platform Gateway.
+	GatewayShardLabel = "platform.opendatahub.io/gateway-shard"
 )
 
 // IngressMode defines how the Gateway exposes its endpoints externally.
@@ -49,6 +53,7 @@ const (
 var _ common.PlatformObject = (*GatewayConfig)(nil)
 
 // GatewayConfigSpec defines the desired state of GatewayConfig
+// +kubebuilder:validation:XValidation:rule="!has(self.clientCertAuth) || (has(self.ingressMode) && self.ingressMode == 'LoadBalancer')",message="clientCertAuth requires ingressMode LoadBalancer"
 type GatewayConfigSpec struct {
 	// IngressMode specifies how the Gateway is exposed externally.
 	// "OcpRoute" uses ClusterIP with standard OpenShift Routes (default for new deployments).
@@ -121,6 +126,108 @@ type GatewayConfigSpec struct {
 	// +optional
 	// +kubebuilder:default=true
 	EnableK8sTokenValidation *bool `json:"enableK8sTokenValidation,omitempty"`
+
+	// ClientCertAuth enables mTLS client-certificate authentication for machine clients on a dedicated hostname.
+	// Requests presenting a certificate that matches an identity mapping bypass kube-auth-proxy and are forwarded
+	// with the mapped user and groups. Requires ingressMode LoadBalancer.
+	// +optional
+	ClientCertAuth *ClientCertAuthConfig `json:"clientCertAuth,omitempty"`
+
+	// Shards are additional Gateways isolating the traffic of a set of tenants on their own hostname, certificate
+	// and network policy. PlatformRoutes are assigned to a shard with the platform.opendatahub.io/gateway-shard label.
+	// All shards authenticate requests through the same kube-auth-proxy as the main Gateway. In OIDC mode the
+	// https://<shard-hostname>/oauth2/callback URL of each shard must be allowed as a redirect URI by the provider.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.subdomain == x.subdomain))",message="shard subdomains must be unique"
+	Shards []GatewayShard `json:"shards,omitempty"`
+}
+
+// GatewayShard defines an additional Gateway serving a dedicated hostname.
+// +kubebuilder:validation:XValidation:rule="!has(self.ingressMode) || !has(self.certificate) || self.ingressMode == 'LoadBalancer'",message="certificate is only used with ingressMode LoadBalancer"
+type GatewayShard struct {
+	// Name of the shard. The shard Gateway is named data-science-gateway-<name>.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=30
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Subdomain of the shard hostname, combined with the GatewayConfig domain.
+	// Must differ from the subdomain of the main Gateway and of the other shards.
+	// Example: team-a
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)$`
+	Subdomain string `json:"subdomain"`
+
+	// IngressMode specifies how the shard Gateway is exposed externally. Defaults to the GatewayConfig ingressMode.
+	// +optional
+	IngressMode IngressMode `json:"ingressMode,omitempty"`
+
+	// Certificate of the shard hostname in LoadBalancer mode. Defaults to the GatewayConfig certificate type,
+	// stored in the <gatewayconfig-name>-<shard-name>-tls secret.
+	// +optional
+	Certificate *infrav1.CertificateSpec `json:"certificate,omitempty"`
+
+	// NetworkPolicy configuration for the shard Gateway pods. When ingress rules are enabled (default), only HTTPS
+	// traffic from the OpenShift router (OcpRoute mode) or from any source (LoadBalancer mode) and metrics
+	// collection from monitoring namespaces reach the shard Gateway.
+	// +optional
+	NetworkPolicy *NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// ClientCertAuthConfig defines mTLS client-certificate authentication at the gateway.
+type ClientCertAuthConfig struct {
+	// ClientCAConfigMapName is the name of the ConfigMap containing the CA bundle used to verify client certificates.
+	// ConfigMap must exist in the openshift-ingress namespace and contain a 'ca.crt' key with the PEM-encoded CA certificates.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ClientCAConfigMapName string `json:"clientCAConfigMapName"`
+
+	// Subdomain of the hostname accepting client certificates. Defaults to the gateway subdomain suffixed with "-mtls".
+	// The gateway certificate must be valid for this hostname.
+	// Example: rh-ai-mtls
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)$`
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// IdentityMappings map client certificates to Kubernetes identities. Mappings are evaluated in order and the
+	// first match wins. Requests with a certificate matching no mapping are rejected.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=atomic
+	IdentityMappings []ClientCertIdentityMapping `json:"identityMappings"`
+}
+
+// ClientCertIdentityMapping maps a client certificate subject or SAN to a Kubernetes user and groups.
+// +kubebuilder:validation:XValidation:rule="has(self.subject) != has(self.san)",message="exactly one of subject or san must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.user) || has(self.groups)",message="at least one of user or groups must be set"
+type ClientCertIdentityMapping struct {
+	// Subject matches the certificate subject distinguished name exactly, in RFC 2253 format (e.g., "CN=ci-bot,O=Example").
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Subject string `json:"subject,omitempty"`
+
+	// SAN matches a DNS or URI subject alternative name of the certificate exactly.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	SAN string `json:"san,omitempty"`
+
+	// User is the Kubernetes user name forwarded for matching certificates.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	User string `json:"user,omitempty"`
+
+	// Groups are the Kubernetes groups forwarded for matching certificates.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
 }
 
 // NetworkPolicyConfig defines network policy configuration for kube-auth-proxy.
@@ -183,6 +290,15 @@ type CookieConfig struct {
 	// +optional
 	// +kubebuilder:default="1h"
 	Refresh metav1.Duration `json:"refresh,omitempty"`
+
+	// RotationPeriod is how often the OAuth2 proxy cookie secret is rotated (e.g., "2160h" for 90 days).
+	// In OpenShift OAuth mode the OAuth client secret is rotated on the same schedule.
+	// Rotating the cookie secret ends the proxy sessions: browsers go through the login flow again,
+	// which completes without user interaction while the identity provider session is still valid.
+	// There is no grace period for the previous cookie secret, as the proxy accepts a single one.
+	// Rotation is disabled when unset; once enabled, the first rotation happens one period later.
+	// +optional
+	RotationPeriod metav1.Duration `json:"rotationPeriod,omitempty"`
 }
 
 // GatewayConfigStatus defines the observed state of GatewayConfig
@@ -191,6 +307,48 @@ type GatewayConfigStatus struct {
 	// Domain is the computed gateway domain (subdomain + cluster domain or default)
 	// This is the single source of truth for the gateway domain used by all components
 	Domain string `json:"domain,omitempty"`
+
+	// CertificateExpiryTime is the expiry time of the gateway certificate when it is issued by cert-manager.
+	// +optional
+	CertificateExpiryTime *metav1.Time `json:"certificateExpiryTime,omitempty"`
+
+	// LastRotationTime is when the OAuth2 proxy session secrets were last rotated. Unset while rotation is disabled.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// Routes lists the PlatformRoutes attached to the Gateway and the URLs they are served on.
+	// +optional
+	// +listType=atomic
+	Routes []GatewayRouteStatus `json:"routes,omitempty"`
+
+	// Shards reports the readiness and domain of each gateway shard.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Shards []GatewayShardStatus `json:"shards,omitempty"`
+}
+
+// GatewayShardStatus reports the observed state of a gateway shard.
+type GatewayShardStatus struct {
+	// Name of the shard.
+	Name string `json:"name"`
+	// Domain is the hostname served by the shard Gateway.
+	Domain string `json:"domain,omitempty"`
+	// Ready is true when the shard Gateway is accepted and its certificate is issued.
+	Ready bool `json:"ready"`
+	// Message explains why the shard is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GatewayRouteStatus reports a PlatformRoute accepted by the gateway controller.
+type GatewayRouteStatus struct {
+	// Name of the PlatformRoute.
+	Name string `json:"name"`
+	// Namespace of the PlatformRoute.
+	Namespace string `json:"namespace"`
+	// URL the route is served on.
+	URL string `json:"url"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	PlatformRouteKind = "PlatformRoute"
+
+	// PlatformRouteAcceptedCondition reports whether the route was accepted by the
+	// gateway controller and its HTTPRoute accepted by the Gateway it is attached to.
+	PlatformRouteAcceptedCondition = "Accepted"
+)
+
+// PlatformRouteSpec defines a route published by a module behind the platform Gateway.
+// Requests matching the route are authenticated by kube-auth-proxy before being forwarded
+// to the backend Service, like every other request entering the platform Gateway.
+type PlatformRouteSpec struct {
+	// Hostname the route is served on. Defaults to the hostname of the Gateway the route is attached to.
+	// Only that hostname is covered by the Gateway listener and certificate, so any other hostname is rejected.
+	// Routes are not served on the client certificate hostname of the main Gateway.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)*[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Hostname string `json:"hostname,omitempty"`
+
+	// PathPrefix is the URL path prefix routed to the backend, for example /mlflow.
+	// Two routes may not claim the same hostname and path prefix, nor a path prefix of another HTTPRoute of the Gateway.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9/_.~%-]*$`
+	PathPrefix string `json:"pathPrefix"`
+
+	// Backend is the Service, in the namespace of the PlatformRoute, that receives the traffic.
+	// +kubebuilder:validation:Required
+	Backend PlatformRouteBackend `json:"backend"`
+}
+
+// PlatformRouteBackend references the Service backing a PlatformRoute.
+type PlatformRouteBackend struct {
+	// ServiceName is the name of the backend Service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	ServiceName string `json:"serviceName"`
+
+	// Port is the Service port receiving the traffic.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// PlatformRouteStatus defines the observed state of PlatformRoute
+type PlatformRouteStatus struct {
+	common.Status `json:",inline"`
+
+	// URL is the externally reachable URL of the route, set once the Gateway accepts the route.
+	URL string `json:"url,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 239",message="PlatformRoute name must be at most 239 characters, as it is prefixed in the name of its HTTPRoute"
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`,description="URL"
+// +kubebuilder:printcolumn:name="Accepted",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].status`,description="Accepted"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`,description="Reason"
+
+// PlatformRoute is the Schema for the platformroutes API
+type PlatformRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlatformRouteSpec   `json:"spec,omitempty"`
+	Status PlatformRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PlatformRouteList contains a list of PlatformRoute
+type PlatformRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformRoute `json:"items"`
+}
+
+func (c *PlatformRoute) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *PlatformRoute) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&PlatformRoute{}, &PlatformRouteList{})
+}
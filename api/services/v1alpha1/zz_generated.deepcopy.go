@@ -140,11 +140,54 @@ func (in *AuthStatus) DeepCopy() *AuthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertAuthConfig) DeepCopyInto(out *ClientCertAuthConfig) {
+	*out = *in
+	if in.IdentityMappings != nil {
+		in, out := &in.IdentityMappings, &out.IdentityMappings
+		*out = make([]ClientCertIdentityMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertAuthConfig.
+func (in *ClientCertAuthConfig) DeepCopy() *ClientCertAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertIdentityMapping) DeepCopyInto(out *ClientCertIdentityMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertIdentityMapping.
+func (in *ClientCertIdentityMapping) DeepCopy() *ClientCertIdentityMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertIdentityMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CookieConfig) DeepCopyInto(out *CookieConfig) {
 	*out = *in
 	out.Expire = in.Expire
 	out.Refresh = in.Refresh
+	out.RotationPeriod = in.RotationPeriod
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieConfig.
@@ -263,6 +306,18 @@ func (in *GatewayConfigSpec) DeepCopyInto(out *GatewayConfigSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClientCertAuth != nil {
+		in, out := &in.ClientCertAuth, &out.ClientCertAuth
+		*out = new(ClientCertAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]GatewayShard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigSpec.
@@ -279,6 +334,24 @@ func (in *GatewayConfigSpec) DeepCopy() *GatewayConfigSpec {
 func (in *GatewayConfigStatus) DeepCopyInto(out *GatewayConfigStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.CertificateExpiryTime != nil {
+		in, out := &in.CertificateExpiryTime, &out.CertificateExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]GatewayRouteStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]GatewayShardStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigStatus.
@@ -291,6 +364,61 @@ func (in *GatewayConfigStatus) DeepCopy() *GatewayConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRouteStatus) DeepCopyInto(out *GatewayRouteStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRouteStatus.
+func (in *GatewayRouteStatus) DeepCopy() *GatewayRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayShard) DeepCopyInto(out *GatewayShard) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(v1.CertificateSpec)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayShard.
+func (in *GatewayShard) DeepCopy() *GatewayShard {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayShard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayShardStatus) DeepCopyInto(out *GatewayShardStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayShardStatus.
+func (in *GatewayShardStatus) DeepCopy() *GatewayShardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayShardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressPolicyConfig) DeepCopyInto(out *IngressPolicyConfig) {
 	*out = *in
@@ -506,6 +634,112 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRoute) DeepCopyInto(out *PlatformRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRoute.
+func (in *PlatformRoute) DeepCopy() *PlatformRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRouteBackend) DeepCopyInto(out *PlatformRouteBackend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRouteBackend.
+func (in *PlatformRouteBackend) DeepCopy() *PlatformRouteBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRouteBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRouteList) DeepCopyInto(out *PlatformRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRouteList.
+func (in *PlatformRouteList) DeepCopy() *PlatformRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRouteSpec) DeepCopyInto(out *PlatformRouteSpec) {
+	*out = *in
+	out.Backend = in.Backend
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRouteSpec.
+func (in *PlatformRouteSpec) DeepCopy() *PlatformRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRouteStatus) DeepCopyInto(out *PlatformRouteStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRouteStatus.
+func (in *PlatformRouteStatus) DeepCopy() *PlatformRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Traces) DeepCopyInto(out *Traces) {
 	*out = *in
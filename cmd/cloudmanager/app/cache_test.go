@@ -4,6 +4,7 @@ package app
 import (
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -112,6 +113,20 @@ func Test_defaultCacheOptions(t *testing.T) {
 		})).To(BeTrue(), "CRD should match resources with any labels")
 	})
 
+	t.Run("Secrets are filtered by the infrastructure label", func(t *testing.T) {
+		g := NewWithT(t)
+
+		opts, err := defaultCacheOptions(s)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		// The CA Secret imported by the cert-manager bootstrap is read through the APIReader,
+		// so Secrets must not bypass the label filter of DefaultNamespaces.
+		for obj := range opts.ByObject {
+			_, isSecret := obj.(*corev1.Secret)
+			g.Expect(isSecret).To(BeFalse(), "Secrets must not have a ByObject override")
+		}
+	})
+
 	t.Run("uses provided scheme", func(t *testing.T) {
 		g := NewWithT(t)
 
@@ -8,6 +8,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/cloudmanager/preflight"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/operatorconfig"
 )
 
@@ -24,6 +25,9 @@ type Provider struct {
 	// ClientOptions returns the provider-specific client configuration.
 	// It always sets the unstructured cache to true.
 	ClientOptions func() client.Options
+	// PreflightChecks are optional provider-specific cluster capability checks,
+	// run after the default pre-flight checks.
+	PreflightChecks []preflight.Check
 }
 
 // Validate checks that all required Provider fields are set.
@@ -38,6 +38,8 @@ func Run(_ *cobra.Command, provider Provider) error {
 		return fmt.Errorf("invalid provider configuration: %w", err)
 	}
 
+	cfg.PreflightChecks = provider.PreflightChecks
+
 	scheme := newScheme(provider.AddToScheme)
 
 	clientOptions := provider.ClientOptions()
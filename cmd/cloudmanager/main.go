@@ -5,11 +5,13 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/cloudmanager/aws"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/cloudmanager/azure"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/cloudmanager/coreweave"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/cloudmanager/generic"
 )
 
 func main() {
 	app.AddCommand(azure.NewCmd())
 	app.AddCommand(coreweave.NewCmd())
 	app.AddCommand(aws.NewCmd())
+	app.AddCommand(generic.NewCmd())
 	app.Execute()
 }
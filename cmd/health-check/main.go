@@ -36,7 +36,8 @@ func main() {
 	longFormat := flag.Bool("l", false, "Long format: list conditions and details per section (like ls -l)")
 	layerFlag := flag.String("layer", "",
 		"Run only these layers, comma-separated (e.g. infrastructure, workload, operator). "+
-			"infrastructure=nodes,quotas; workload=deployments,pods,events,operator,dsci,dsc; operator=operator,dsci,dsc")
+			"infrastructure=nodes,quotas,crds; workload=deployments,pods,events,operator,dsci,dsc,webhooks,certificates; "+
+			"operator=operator,dsci,dsc,webhooks,crds,certificates")
 	sectionsFlag := flag.String("sections", "", "Comma-separated list of sections to run (e.g. nodes,quotas or deployments,pods). Overrides -layer.")
 
 	// Configuration: flag default is env var (or static default).
@@ -104,6 +104,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/auth"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/hardwareprofile"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/monitoring"
 	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
@@ -161,6 +162,7 @@ var (
 		serviceApi.AuthServiceName:         auth.NewHandler(),
 		certconfigmapgenerator.ServiceName: certconfigmapgenerator.NewHandler(),
 		serviceApi.GatewayServiceName:      gateway.NewHandler(),
+		hardwareprofile.ServiceName:        hardwareprofile.NewHandler(),
 		serviceApi.MonitoringServiceName:   monitoring.NewHandler(),
 		setup.ServiceName:                  setup.NewHandler(),
 	}
@@ -531,7 +533,7 @@ func main() { //nolint:funlen,maintidx,gocyclo
 	mgr := frameworkmanager.New(ctrlMgr, frameworkmanager.WithManifestsBasePath(oconfig.ManifestsBasePath), frameworkmanager.WithChartsBasePath(oconfig.ChartsBasePath))
 
 	// Register all webhooks using the helper
-	if err := webhook.RegisterAllWebhooks(mgr); err != nil {
+	if err := webhook.RegisterAllWebhooks(mgr, oconfig.OperatorSettings); err != nil {
 		setupLog.Error(err, "unable to register webhooks")
 		os.Exit(1)
 	}
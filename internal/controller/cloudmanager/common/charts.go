@@ -2,6 +2,8 @@ package common
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"path/filepath"
 
 	helm "github.com/k8s-manifest-kit/renderer-helm/pkg"
@@ -9,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -31,6 +34,7 @@ type chartDef struct {
 	chart      types.HelmChartInfo
 	monitor    monitorConfig
 	operatorCR *types.OperatorCR
+	version    ccmcommon.DependencyVersionPolicy
 }
 
 // monitorConfig holds per-dependency monitoring metadata embedded in chartDef.
@@ -71,11 +75,14 @@ func makeStateFn(
 // allChartDefs is the single source of truth for all charts and their target
 // namespaces. BuildHelmCharts derives from this list.
 func allChartDefs(deps ccmcommon.Dependencies, chartsPath string) []chartDef {
+	kedaOperatorCR := KEDAOperatorCR(deps.KEDA.GetNamespace())
+
 	return []chartDef{
 		{
 			stateFn: makeStateFn(func(d ccmcommon.Dependencies) ccmcommon.ManagementPolicy {
 				return d.GatewayAPI.ManagementPolicy
 			}, nil),
+			version: deps.GatewayAPI.DependencyVersionPolicy,
 			chart: types.HelmChartInfo{
 				Source: helm.Source{
 					Chart:       filepath.Join(chartsPath, "gateway-api"),
@@ -92,6 +99,7 @@ func allChartDefs(deps ccmcommon.Dependencies, chartsPath string) []chartDef {
 			stateFn: makeStateFn(func(d ccmcommon.Dependencies) ccmcommon.ManagementPolicy {
 				return d.LWS.ManagementPolicy
 			}, &LWSOperatorCR),
+			version:    deps.LWS.DependencyVersionPolicy,
 			operatorCR: &LWSOperatorCR,
 			chart: types.HelmChartInfo{
 				Source: helm.Source{
@@ -113,6 +121,7 @@ func allChartDefs(deps ccmcommon.Dependencies, chartsPath string) []chartDef {
 			stateFn: makeStateFn(func(d ccmcommon.Dependencies) ccmcommon.ManagementPolicy {
 				return d.SailOperator.ManagementPolicy
 			}, &SailOperatorCR),
+			version: deps.SailOperator.DependencyVersionPolicy,
 			chart: types.HelmChartInfo{
 				Source: helm.Source{
 					Chart:       filepath.Join(chartsPath, "sail-operator"),
@@ -132,6 +141,27 @@ func allChartDefs(deps ccmcommon.Dependencies, chartsPath string) []chartDef {
 				Namespace:      deps.SailOperator.GetNamespace(),
 			},
 		},
+		{
+			stateFn: makeStateFn(func(d ccmcommon.Dependencies) ccmcommon.ManagementPolicy {
+				return d.KEDA.ManagementPolicy
+			}, &kedaOperatorCR),
+			version:    deps.KEDA.DependencyVersionPolicy,
+			operatorCR: &kedaOperatorCR,
+			chart: types.HelmChartInfo{
+				Source: helm.Source{
+					Chart:       filepath.Join(chartsPath, "keda"),
+					ReleaseName: "keda",
+					Values: helm.Values(map[string]any{
+						"namespace": deps.KEDA.GetNamespace(),
+					}),
+				},
+			},
+			monitor: monitorConfig{
+				ConditionType:  status.ConditionKEDAReady,
+				HasDeployments: true,
+				Namespace:      deps.KEDA.GetNamespace(),
+			},
+		},
 	}
 }
 
@@ -148,13 +178,15 @@ type DependencyMonitorConfig struct {
 
 // BuildResult holds the output of BuildHelmCharts: the charts to render,
 // any operator CRs to filter from deploy (Phase 1 cleanup), charts whose
-// resources should be deleted (Phase 2 cleanup), and monitoring configs
-// for all dependencies.
+// resources should be deleted (Phase 2 cleanup), monitoring configs
+// for all dependencies, and the version policies of managed charts keyed
+// by release name.
 type BuildResult struct {
-	Charts         []types.HelmChartInfo
-	FilterCRs      []types.OperatorCR
-	CleanupCharts  []types.HelmChartInfo
-	MonitorConfigs []DependencyMonitorConfig
+	Charts          []types.HelmChartInfo
+	FilterCRs       []types.OperatorCR
+	CleanupCharts   []types.HelmChartInfo
+	MonitorConfigs  []DependencyMonitorConfig
+	VersionPolicies map[string]ccmcommon.DependencyVersionPolicy
 }
 
 // BuildHelmCharts returns the charts to render, CRs to filter, and monitoring
@@ -174,6 +206,11 @@ func BuildHelmCharts(ctx context.Context, cli client.Client, deps ccmcommon.Depe
 		case chartManaged:
 			policy = ccmcommon.Managed
 			result.Charts = append(result.Charts, def.chart)
+
+			if result.VersionPolicies == nil {
+				result.VersionPolicies = make(map[string]ccmcommon.DependencyVersionPolicy)
+			}
+			result.VersionPolicies[def.chart.ReleaseName] = def.version
 		case chartCleaning:
 			result.Charts = append(result.Charts, def.chart)
 			if def.operatorCR != nil {
@@ -198,6 +235,27 @@ func BuildHelmCharts(ctx context.Context, cli client.Client, deps ccmcommon.Depe
 	return result, nil
 }
 
+// ChartVersion returns the version declared in the Chart.yaml of the chart at chartPath.
+func ChartVersion(chartPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read chart metadata: %w", err)
+	}
+
+	var metadata struct {
+		Version string `json:"version"`
+	}
+	if err := yaml.Unmarshal(data, &metadata); err != nil {
+		return "", fmt.Errorf("failed to parse chart metadata of %s: %w", chartPath, err)
+	}
+
+	if metadata.Version == "" {
+		return "", fmt.Errorf("chart %s has no version", chartPath)
+	}
+
+	return metadata.Version, nil
+}
+
 func operatorCRExists(ctx context.Context, cli client.Client, cr *types.OperatorCR) (bool, error) {
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(cr.GVK)
@@ -4,6 +4,7 @@ package common
 import (
 	"context"
 	"errors"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -26,6 +27,7 @@ func getAllUnmanagedDependencies() ccmcommon.Dependencies {
 		GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Unmanaged},
 		LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
 		SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+		KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 	}
 }
 
@@ -46,6 +48,7 @@ func TestBuildHelmCharts(t *testing.T) {
 		"gateway-api",
 		"lws-operator",
 		"sail-operator",
+		"keda",
 	}
 
 	t.Run("returns all charts in order when all managed", func(t *testing.T) {
@@ -60,6 +63,24 @@ func TestBuildHelmCharts(t *testing.T) {
 			g.Expect(result.Charts[i].Chart).To(Equal(filepath.Join(testChartsPath, name)))
 		}
 		g.Expect(result.FilterCRs).To(BeEmpty())
+		g.Expect(result.VersionPolicies).To(HaveLen(len(expectedReleaseNames)))
+	})
+
+	t.Run("passes version policies of managed charts", func(t *testing.T) {
+		g := NewWithT(t)
+
+		deps := getAllUnmanagedDependencies()
+		deps.SailOperator.ManagementPolicy = ccmcommon.Managed
+		deps.SailOperator.DependencyVersionPolicy = ccmcommon.DependencyVersionPolicy{
+			Version:       ">=1.27.0 <1.28.0",
+			UpgradePolicy: ccmcommon.Manual,
+		}
+
+		result, err := BuildHelmCharts(ctx, cli, deps, testChartsPath)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(result.VersionPolicies).To(HaveLen(1))
+		g.Expect(result.VersionPolicies).To(HaveKeyWithValue("sail-operator", deps.SailOperator.DependencyVersionPolicy))
 	})
 
 	t.Run("excludes unmanaged charts and preserves order", func(t *testing.T) {
@@ -74,7 +95,7 @@ func TestBuildHelmCharts(t *testing.T) {
 		g.Expect(result.Charts).To(HaveLen(1))
 		g.Expect(result.Charts[0].ReleaseName).To(Equal("lws-operator"))
 		g.Expect(result.FilterCRs).To(BeEmpty())
-		g.Expect(result.CleanupCharts).To(HaveLen(1))
+		g.Expect(result.CleanupCharts).To(HaveLen(2))
 	})
 
 	t.Run("returns empty slice when all unmanaged and no CRs on cluster", func(t *testing.T) {
@@ -87,9 +108,10 @@ func TestBuildHelmCharts(t *testing.T) {
 
 		g.Expect(result.Charts).To(BeEmpty())
 		g.Expect(result.FilterCRs).To(BeEmpty())
-		g.Expect(result.CleanupCharts).To(HaveLen(2))
+		g.Expect(result.CleanupCharts).To(HaveLen(3))
 		g.Expect(result.CleanupCharts[0].ReleaseName).To(Equal("lws-operator"))
 		g.Expect(result.CleanupCharts[1].ReleaseName).To(Equal("sail-operator"))
+		g.Expect(result.CleanupCharts[2].ReleaseName).To(Equal("keda"))
 	})
 
 	t.Run("monitor configs include policy derived from state", func(t *testing.T) {
@@ -101,10 +123,11 @@ func TestBuildHelmCharts(t *testing.T) {
 		result, err := BuildHelmCharts(ctx, cli, deps, testChartsPath)
 		g.Expect(err).NotTo(HaveOccurred())
 
-		g.Expect(result.MonitorConfigs).To(HaveLen(3))
+		g.Expect(result.MonitorConfigs).To(HaveLen(4))
 		g.Expect(result.MonitorConfigs[0].Policy).To(Equal(ccmcommon.Managed))
 		g.Expect(result.MonitorConfigs[1].Policy).To(Equal(ccmcommon.Unmanaged))
 		g.Expect(result.MonitorConfigs[2].Policy).To(Equal(ccmcommon.Unmanaged))
+		g.Expect(result.MonitorConfigs[3].Policy).To(Equal(ccmcommon.Unmanaged))
 	})
 
 	t.Run("uses custom namespaces in chart values", func(t *testing.T) {
@@ -121,12 +144,17 @@ func TestBuildHelmCharts(t *testing.T) {
 					Namespace: "custom-sail-ns",
 				},
 			},
+			KEDA: ccmcommon.KEDADependency{
+				Configuration: ccmcommon.KEDAConfiguration{
+					Namespace: "custom-keda-ns",
+				},
+			},
 		}
 
 		result, err := BuildHelmCharts(ctx, cli, deps, testChartsPath)
 		g.Expect(err).NotTo(HaveOccurred())
 
-		g.Expect(result.Charts).To(HaveLen(3))
+		g.Expect(result.Charts).To(HaveLen(4))
 
 		lwsChart := result.Charts[1]
 		g.Expect(lwsChart.ReleaseName).To(Equal("lws-operator"))
@@ -139,6 +167,16 @@ func TestBuildHelmCharts(t *testing.T) {
 		values, err = sailChart.Values(ctx)
 		g.Expect(err).NotTo(HaveOccurred())
 		g.Expect(values).To(HaveKeyWithValue("namespace", "custom-sail-ns"))
+
+		kedaChart := result.Charts[3]
+		g.Expect(kedaChart.ReleaseName).To(Equal("keda"))
+		values, err = kedaChart.Values(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(values).To(HaveKeyWithValue("namespace", "custom-keda-ns"))
+
+		g.Expect(result.MonitorConfigs[3].Namespace).To(Equal("custom-keda-ns"))
+		g.Expect(result.MonitorConfigs[3].OperatorCR).NotTo(BeNil())
+		g.Expect(result.MonitorConfigs[3].OperatorCR.Namespace).To(Equal("custom-keda-ns"))
 	})
 }
 
@@ -150,6 +188,7 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 			name        string
 			crGVK       schema.GroupVersionKind
 			crName      string
+			crNamespace string
 			releaseName string
 		}{
 			{
@@ -164,6 +203,13 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 				crName:      "cluster",
 				releaseName: "lws-operator",
 			},
+			{
+				name:        "KEDA",
+				crGVK:       gvk.KedaController,
+				crName:      "keda",
+				crNamespace: ccmcommon.DefaultNamespaceKEDAOperator,
+				releaseName: "keda",
+			},
 		}
 
 		for _, tc := range tests {
@@ -173,6 +219,7 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 				cr := &unstructured.Unstructured{}
 				cr.SetGroupVersionKind(tc.crGVK)
 				cr.SetName(tc.crName)
+				cr.SetNamespace(tc.crNamespace)
 
 				cli := newFakeClient(t, fakeclient.WithObjects(cr))
 
@@ -185,6 +232,7 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 				g.Expect(result.FilterCRs).To(HaveLen(1))
 				g.Expect(result.FilterCRs[0].GVK).To(Equal(tc.crGVK))
 				g.Expect(result.FilterCRs[0].Name).To(Equal(tc.crName))
+				g.Expect(result.FilterCRs[0].Namespace).To(Equal(tc.crNamespace))
 			})
 		}
 	})
@@ -200,9 +248,10 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 		result, err := BuildHelmCharts(ctx, cli, deps, testChartsPath)
 		g.Expect(err).NotTo(HaveOccurred())
 
-		g.Expect(result.Charts).To(HaveLen(2))
+		g.Expect(result.Charts).To(HaveLen(3))
 		g.Expect(result.Charts[0].ReleaseName).To(Equal("lws-operator"))
 		g.Expect(result.Charts[1].ReleaseName).To(Equal("sail-operator"))
+		g.Expect(result.Charts[2].ReleaseName).To(Equal("keda"))
 		g.Expect(result.FilterCRs).To(BeEmpty())
 		g.Expect(result.CleanupCharts).To(BeEmpty())
 	})
@@ -240,7 +289,7 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 
 		g.Expect(result.Charts).To(BeEmpty())
 		g.Expect(result.FilterCRs).To(BeEmpty())
-		g.Expect(result.CleanupCharts).To(HaveLen(2))
+		g.Expect(result.CleanupCharts).To(HaveLen(3))
 	})
 
 	t.Run("transient Get error propagates instead of forcing Phase 2", func(t *testing.T) {
@@ -275,17 +324,49 @@ func TestBuildHelmChartsPhase1(t *testing.T) {
 			GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Managed},
 			LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
 			SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+			KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Managed},
 		}
 
 		result, err := BuildHelmCharts(ctx, cli, deps, testChartsPath)
 		g.Expect(err).NotTo(HaveOccurred())
 
-		g.Expect(result.Charts).To(HaveLen(2))
+		g.Expect(result.Charts).To(HaveLen(3))
 		g.Expect(result.Charts[0].ReleaseName).To(Equal("gateway-api"))
 		g.Expect(result.Charts[1].ReleaseName).To(Equal("sail-operator"))
+		g.Expect(result.Charts[2].ReleaseName).To(Equal("keda"))
 		g.Expect(result.FilterCRs).To(HaveLen(1))
 		g.Expect(result.FilterCRs[0].GVK).To(Equal(gvk.Istio))
 		g.Expect(result.CleanupCharts).To(HaveLen(1))
 		g.Expect(result.CleanupCharts[0].ReleaseName).To(Equal("lws-operator"))
 	})
 }
+
+func TestChartVersion(t *testing.T) {
+	t.Run("returns the version from Chart.yaml", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte("apiVersion: v2\nname: test\nversion: 1.27.3\n"), 0o600)).To(Succeed())
+
+		version, err := ChartVersion(dir)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("1.27.3"))
+	})
+
+	t.Run("fails without a version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte("apiVersion: v2\nname: test\n"), 0o600)).To(Succeed())
+
+		_, err := ChartVersion(dir)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("fails without Chart.yaml", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := ChartVersion(t.TempDir())
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -3,14 +3,19 @@ package common
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"unicode"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
@@ -92,6 +97,219 @@ func SkipCRDIfPresent(crdName string) types.HookFn {
 	}
 }
 
+// podSpecPaths maps the workload kinds whose images are mirrored to the path of their pod spec.
+var podSpecPaths = map[schema.GroupKind][]string{
+	gvk.Pod.GroupKind():         {"spec"},
+	gvk.Deployment.GroupKind():  {"spec", "template", "spec"},
+	gvk.StatefulSet.GroupKind(): {"spec", "template", "spec"},
+	gvk.DaemonSet.GroupKind():   {"spec", "template", "spec"},
+	gvk.Job.GroupKind():         {"spec", "template", "spec"},
+	gvk.CronJob.GroupKind():     {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// MirrorImagesHook returns a PreApply hook that rewrites the container images of the
+// rendered workloads according to the rules of mirrors, and adds the mirror pull secret
+// to the rendered ServiceAccounts. Images that already start with a mirror prefix are
+// left unchanged. Operators deploy operand images passed through environment variables,
+// so env values that are image references matching a rule are rewritten as well, and the
+// RELATED_IMAGE_* values matching no rule count as unmatched images. In strict mode, the
+// hook fails if an image matches no rule.
+func MirrorImagesHook(mirrors ccmcommon.ImageMirrors) types.HookFn {
+	return func(ctx context.Context, rr *types.ReconciliationRequest) error {
+		var unmatched []string
+
+		for i := range rr.Resources {
+			gk := rr.Resources[i].GroupVersionKind().GroupKind()
+
+			if gk == gvk.ServiceAccount.GroupKind() {
+				if mirrors.PullSecretRef == nil {
+					continue
+				}
+
+				// Rendered resources may be shared with the render cache, so they are copied before being modified.
+				rr.Resources[i] = *rr.Resources[i].DeepCopy()
+				if err := addImagePullSecret(&rr.Resources[i], mirrors.PullSecretRef.Name); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			path, ok := podSpecPaths[gk]
+			if !ok {
+				continue
+			}
+
+			rr.Resources[i] = *rr.Resources[i].DeepCopy()
+			images, err := mirrorPodSpecImages(&rr.Resources[i], path, mirrors.Rules)
+			if err != nil {
+				return err
+			}
+
+			unmatched = append(unmatched, images...)
+		}
+
+		if len(unmatched) == 0 {
+			return nil
+		}
+
+		slices.Sort(unmatched)
+		unmatched = slices.Compact(unmatched)
+
+		if mirrors.Strict {
+			return fmt.Errorf("images matching no mirror rule: %s", strings.Join(unmatched, ", "))
+		}
+
+		logf.FromContext(ctx).V(1).Info("Images matching no mirror rule are pulled from their original registry", "images", unmatched)
+
+		return nil
+	}
+}
+
+// relatedImageEnvPrefix is the prefix of the environment variables operators read operand images from.
+const relatedImageEnvPrefix = "RELATED_IMAGE_"
+
+// mirrorPodSpecImages rewrites the images and image env values of the containers and init
+// containers of the pod spec of obj at path, and returns the images that match no rule.
+func mirrorPodSpecImages(obj *unstructured.Unstructured, path []string, rules []ccmcommon.ImageMirrorRule) ([]string, error) {
+	var unmatched []string
+
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := append(slices.Clone(path), field)
+
+		containers, found, err := unstructured.NestedSlice(obj.Object, fieldPath...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s of %s %s: %w", field, obj.GetKind(), obj.GetName(), err)
+		}
+		if !found {
+			continue
+		}
+
+		for _, c := range containers {
+			container, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			unmatched = append(unmatched, mirrorEnvImages(container, rules)...)
+
+			image, _ := container["image"].(string)
+			if image == "" {
+				continue
+			}
+
+			mirrored, ok := mirrorImage(image, rules)
+			if !ok {
+				unmatched = append(unmatched, image)
+				continue
+			}
+
+			container["image"] = mirrored
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fieldPath...); err != nil {
+			return nil, fmt.Errorf("failed to set %s of %s %s: %w", field, obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// mirrorEnvImages rewrites the env values of container that are image references matching a
+// rule, and returns the values of the RELATED_IMAGE_* variables that match no rule.
+func mirrorEnvImages(container map[string]any, rules []ccmcommon.ImageMirrorRule) []string {
+	var unmatched []string
+
+	env, _ := container["env"].([]any)
+	for _, e := range env {
+		variable, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		value, _ := variable["value"].(string)
+		if !isImageReference(value) {
+			continue
+		}
+
+		mirrored, ok := mirrorImage(value, rules)
+		if !ok {
+			if name, _ := variable["name"].(string); strings.HasPrefix(name, relatedImageEnvPrefix) {
+				unmatched = append(unmatched, value)
+			}
+			continue
+		}
+
+		variable["value"] = mirrored
+	}
+
+	return unmatched
+}
+
+// isImageReference reports whether value may be an image reference with a registry or
+// repository path, as opposed to a URL, a host name or free text.
+func isImageReference(value string) bool {
+	return strings.Contains(value, "/") && !strings.Contains(value, "://") && !strings.ContainsFunc(value, unicode.IsSpace)
+}
+
+// mirrorImage returns image rewritten by the rule with the longest matching source prefix.
+// It returns false if no rule matches and the image does not already start with a mirror prefix.
+// Prefixes only match on a boundary of the image reference, see hasImagePrefix.
+func mirrorImage(image string, rules []ccmcommon.ImageMirrorRule) (string, bool) {
+	var match *ccmcommon.ImageMirrorRule
+
+	for i, rule := range rules {
+		if hasImagePrefix(image, rule.Mirror) {
+			return image, true
+		}
+
+		if hasImagePrefix(image, rule.Source) && (match == nil || len(rule.Source) > len(match.Source)) {
+			match = &rules[i]
+		}
+	}
+
+	if match == nil {
+		return image, false
+	}
+
+	return match.Mirror + strings.TrimPrefix(image, match.Source), true
+}
+
+// hasImagePrefix reports whether image starts with prefix followed by a path
+// separator, or is the full repository prefix followed by a tag or a digest,
+// so that quay.io/jetstack does not match quay.io/jetstack-foo/image. A prefix
+// ending with a path separator carries its own boundary.
+func hasImagePrefix(image string, prefix string) bool {
+	rest, ok := strings.CutPrefix(image, prefix)
+	if !ok {
+		return false
+	}
+
+	return rest == "" || strings.HasSuffix(prefix, "/") || strings.HasPrefix(rest, "/") || strings.HasPrefix(rest, ":") || strings.HasPrefix(rest, "@")
+}
+
+// addImagePullSecret adds the pull secret name to the imagePullSecrets of the ServiceAccount obj.
+func addImagePullSecret(obj *unstructured.Unstructured, name string) error {
+	secrets, _, err := unstructured.NestedSlice(obj.Object, "imagePullSecrets")
+	if err != nil {
+		return fmt.Errorf("failed to read imagePullSecrets of ServiceAccount %s: %w", obj.GetName(), err)
+	}
+
+	for _, s := range secrets {
+		if ref, ok := s.(map[string]any); ok && ref["name"] == name {
+			return nil
+		}
+	}
+
+	secrets = append(secrets, map[string]any{"name": name})
+
+	if err := unstructured.SetNestedSlice(obj.Object, secrets, "imagePullSecrets"); err != nil {
+		return fmt.Errorf("failed to set imagePullSecrets of ServiceAccount %s: %w", obj.GetName(), err)
+	}
+
+	return nil
+}
+
 func ensureSailOperatorIgnoreAnnotation(ctx context.Context, c client.Client, name string, obj client.Object) error {
 	logger := logf.FromContext(ctx)
 
@@ -6,16 +6,19 @@ import (
 	"testing"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 
 	. "github.com/onsi/gomega"
@@ -229,3 +232,141 @@ func newUnstructuredResources(t *testing.T, cli client.Client) []unstructured.Un
 
 	return rr.Resources
 }
+
+func TestMirrorImagesHook(t *testing.T) {
+	mirrors := ccmcommon.ImageMirrors{
+		Rules: []ccmcommon.ImageMirrorRule{
+			{Source: "quay.io", Mirror: "mirror.example.com/quay"},
+			{Source: "quay.io/jetstack", Mirror: "mirror.example.com/jetstack"},
+		},
+		PullSecretRef: &corev1.LocalObjectReference{Name: "mirror-pull-secret"},
+	}
+
+	newRR := func(t *testing.T) *odhtypes.ReconciliationRequest {
+		t.Helper()
+
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := &odhtypes.ReconciliationRequest{Client: cli}
+
+		err = rr.AddResources(
+			&appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: gvk.Deployment.GroupVersion().String(), Kind: gvk.Deployment.Kind},
+				ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{{Name: "init", Image: "quay.io/jetstack/init:v1"}},
+							Containers: []corev1.Container{
+								{
+									Name:  "manager",
+									Image: "quay.io/org/manager:v1",
+									Env: []corev1.EnvVar{
+										{Name: "RELATED_IMAGE_WEBHOOK", Value: "quay.io/jetstack/webhook:v1"},
+										{Name: "RELATED_IMAGE_SIDECAR", Value: "registry.k8s.io/sidecar:v1"},
+										{Name: "OPERAND_IMAGE", Value: "quay.io/org/operand@sha256:abc"},
+										{Name: "REGISTRY_URL", Value: "https://quay.io/org"},
+										{Name: "LOG_LEVEL", Value: "info"},
+									},
+								},
+								{Name: "proxy", Image: "registry.k8s.io/proxy:v1"},
+								{Name: "mirrored", Image: "mirror.example.com/quay/org/mirrored:v1"},
+							},
+						},
+					},
+				},
+			},
+			&corev1.ServiceAccount{
+				TypeMeta:   metav1.TypeMeta{APIVersion: gvk.ServiceAccount.GroupVersion().String(), Kind: gvk.ServiceAccount.Kind},
+				ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "default"},
+			},
+		)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		return rr
+	}
+
+	t.Run("should rewrite images and add the pull secret", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t)
+
+		err := MirrorImagesHook(mirrors)(context.Background(), rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(rr.Resources[0]).Should(And(
+			jq.Match(`.spec.template.spec.initContainers[0].image == "mirror.example.com/jetstack/init:v1"`),
+			jq.Match(`.spec.template.spec.containers[0].image == "mirror.example.com/quay/org/manager:v1"`),
+			jq.Match(`.spec.template.spec.containers[1].image == "registry.k8s.io/proxy:v1"`),
+			jq.Match(`.spec.template.spec.containers[2].image == "mirror.example.com/quay/org/mirrored:v1"`),
+			jq.Match(`.spec.template.spec.containers[0].env[0].value == "mirror.example.com/jetstack/webhook:v1"`),
+			jq.Match(`.spec.template.spec.containers[0].env[1].value == "registry.k8s.io/sidecar:v1"`),
+			jq.Match(`.spec.template.spec.containers[0].env[2].value == "mirror.example.com/quay/org/operand@sha256:abc"`),
+			jq.Match(`.spec.template.spec.containers[0].env[3].value == "https://quay.io/org"`),
+			jq.Match(`.spec.template.spec.containers[0].env[4].value == "info"`),
+		))
+		g.Expect(rr.Resources[1]).Should(
+			jq.Match(`.imagePullSecrets == [{"name": "mirror-pull-secret"}]`),
+		)
+
+		// Running the hook again does not rewrite mirrored images nor duplicate the pull secret.
+		err = MirrorImagesHook(mirrors)(context.Background(), rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(rr.Resources[0]).Should(And(
+			jq.Match(`.spec.template.spec.containers[0].image == "mirror.example.com/quay/org/manager:v1"`),
+			jq.Match(`.spec.template.spec.containers[0].env[0].value == "mirror.example.com/jetstack/webhook:v1"`),
+		))
+		g.Expect(rr.Resources[1]).Should(
+			jq.Match(`.imagePullSecrets | length == 1`),
+		)
+	})
+
+	t.Run("should fail on unmatched images in strict mode", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t)
+
+		strict := mirrors
+		strict.Strict = true
+
+		err := MirrorImagesHook(strict)(context.Background(), rr)
+		g.Expect(err).Should(MatchError(And(
+			ContainSubstring("registry.k8s.io/proxy:v1"),
+			ContainSubstring("registry.k8s.io/sidecar:v1"),
+		)))
+	})
+	t.Run("should only match prefixes on a reference boundary", func(t *testing.T) {
+		rules := []ccmcommon.ImageMirrorRule{
+			{Source: "registry.k8s.io", Mirror: "mirror.example.com/k8s"},
+			{Source: "quay.io/jetstack", Mirror: "mirror.example.com/jetstack"},
+			{Source: "quay.io/org/app", Mirror: "mirror.example.com/app"},
+		}
+
+		tests := []struct {
+			image    string
+			expected string
+			matched  bool
+		}{
+			{image: "registry.k8s.io/proxy:v1", expected: "mirror.example.com/k8s/proxy:v1", matched: true},
+			{image: "registry.k8s.io.evil.com/proxy:v1", expected: "registry.k8s.io.evil.com/proxy:v1"},
+			{image: "quay.io/jetstack/cert-manager:v1", expected: "mirror.example.com/jetstack/cert-manager:v1", matched: true},
+			{image: "quay.io/jetstack-foo/cert-manager:v1", expected: "quay.io/jetstack-foo/cert-manager:v1"},
+			{image: "quay.io/org/app:v1", expected: "mirror.example.com/app:v1", matched: true},
+			{image: "quay.io/org/app@sha256:abc", expected: "mirror.example.com/app@sha256:abc", matched: true},
+			{image: "quay.io/org/application:v1", expected: "quay.io/org/application:v1"},
+			{image: "mirror.example.com/k8s/proxy:v1", expected: "mirror.example.com/k8s/proxy:v1", matched: true},
+			{image: "mirror.example.com/k8s-evil/proxy:v1", expected: "mirror.example.com/k8s-evil/proxy:v1"},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.image, func(t *testing.T) {
+				g := NewWithT(t)
+
+				mirrored, matched := mirrorImage(tc.image, rules)
+				g.Expect(mirrored).Should(Equal(tc.expected))
+				g.Expect(matched).Should(Equal(tc.matched))
+			})
+		}
+	})
+}
@@ -10,6 +10,10 @@ package common
 // +kubebuilder:rbac:groups="core",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 
+// Pre-flight checks
+// +kubebuilder:rbac:groups="core",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="storage.k8s.io",resources=storageclasses,verbs=get;list;watch
+
 // +kubebuilder:rbac:groups="coordination.k8s.io",resources=leases,verbs=get;list;watch;create;update;patch;delete
 
 // Events
@@ -40,6 +44,8 @@ package common
 // cert-manager PKI resources (ClusterIssuer and Certificate are created by the bootstrap action).
 // +kubebuilder:rbac:groups="cert-manager.io",resources=clusterissuers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="cert-manager.io",resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// Leaf Certificates are renewed through their Issuing status condition during a root CA rotation.
+// +kubebuilder:rbac:groups="cert-manager.io",resources=certificates/status,verbs=get;update;patch
 
 // sail-operator
 // +kubebuilder:rbac:groups="sailoperator.io",resources=istios,verbs=get;list;watch;create;patch;update;delete
@@ -47,6 +53,9 @@ package common
 // lws-operator
 // +kubebuilder:rbac:groups="operator.openshift.io",resources=leaderworkersetoperators,verbs=get;list;watch;create;patch;update;delete
 
+// keda
+// +kubebuilder:rbac:groups="keda.sh",resources=kedacontrollers,verbs=get;list;watch;create;patch;update;delete
+
 // Webhook annotations for sail-operator workaround (OSSM-12397)
 // TODO(OSSM-12397): Remove once the sail-operator ships a fix.
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,verbs=get;list;watch;patch
@@ -16,3 +16,14 @@ var (
 		Name: "default",
 	}
 )
+
+// KEDAOperatorCR returns the KedaController CR managed by the KEDA operator.
+// Unlike the cluster-scoped operator CRs above, the KedaController must live
+// in the operator namespace, so it is derived from the dependency configuration.
+func KEDAOperatorCR(namespace string) types.OperatorCR {
+	return types.OperatorCR{
+		GVK:       gvk.KedaController,
+		Name:      "keda",
+		Namespace: namespace,
+	}
+}
@@ -30,11 +30,16 @@ func NewReconciler(ctx context.Context, mgr ctrl.Manager, cfg *operatorconfig.Cl
 			reconciler.WithEventHandler(handlers.ToNamed(ccmv1alpha1.CoreWeaveKubernetesEngineInstanceName)),
 			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(common.ServiceMonitorCRDName)),
 		).
+		// Pre-flight checks run first so that nothing is applied on a cluster missing a required capability.
+		ComposeWith(cloudmanager.Preflight[*ccmv1alpha1.CoreWeaveKubernetesEngine](mgr, cfg.PreflightChecks...)).
 		ComposeWith(certmanager.Bootstrap[*ccmv1alpha1.CoreWeaveKubernetesEngine](
+			mgr,
 			ccmv1alpha1.CoreWeaveKubernetesEngineInstanceName,
 			bootstrapConfig,
 		)).
-		WithActionE(cloudmanager.NewReconcileAction(resourceID)).
+		WithActionE(cloudmanager.NewReconcileAction(resourceID,
+			cloudmanager.WithInventoryNamespace(cfg.RhaiOperatorNamespace),
+		)).
 		// GC must be last: evaluates every CCM resource and removes stale or orphaned ones.
 		WithActionE(cloudmanager.NewGCAction(resourceID, cfg.RhaiOperatorNamespace,
 			cloudmanager.BootstrapProtectedObjects(bootstrapConfig),
@@ -0,0 +1,156 @@
+package generic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/generic/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// gatewayClassDefaultsName is the name of the ConfigMap holding the Istio
+	// per-GatewayClass defaults for the platform gateway.
+	gatewayClassDefaultsName = "generic-gateway-class-defaults"
+
+	// gatewayClassDefaultsLabel marks a ConfigMap in the Istio root namespace as
+	// the defaults applied to every Gateway of the labelled GatewayClass.
+	gatewayClassDefaultsLabel = "gateway.istio.io/defaults-for-class"
+
+	// defaultStorageClassAnnotation marks the cluster default StorageClass.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+	// gatewayHTTPSPort is the port of the platform gateway HTTPS listener.
+	gatewayHTTPSPort = 443
+)
+
+var (
+	errStorageClassNotFound  = errors.New("storage class not found")
+	errNoDefaultStorageClass = errors.New("no default storage class")
+)
+
+// addGatewayClassDefaults adds the Istio GatewayClass defaults that set the type of the
+// Service created for platform Gateways. On clusters without a cloud load balancer the
+// default LoadBalancer Service would stay pending forever, so NodePort is used instead.
+//
+// The defaults are only rendered when the Sail operator is Managed: with an Unmanaged
+// Sail operator the user owns the Istio configuration, including Gateway Services.
+func addGatewayClassDefaults(_ context.Context, rr *types.ReconciliationRequest) error {
+	engine, ok := rr.Instance.(*ccmv1alpha1.GenericKubernetesEngine)
+	if !ok {
+		return fmt.Errorf("instance %T is not a GenericKubernetesEngine", rr.Instance)
+	}
+
+	deps := engine.GetDependencies()
+	if deps.SailOperator.ManagementPolicy == ccmcommon.Unmanaged {
+		return nil
+	}
+
+	return rr.AddResources(newGatewayClassDefaults(&engine.Spec.Gateway, deps.SailOperator.GetNamespace()))
+}
+
+// newGatewayClassDefaults returns the Istio GatewayClass defaults ConfigMap for the given configuration.
+func newGatewayClassDefaults(cfg *ccmv1alpha1.GenericGatewayConfiguration, namespace string) *corev1.ConfigMap {
+	var service strings.Builder
+
+	fmt.Fprintf(&service, "spec:\n  type: %s\n", cfg.GetServiceType())
+
+	if cfg.GetServiceType() == ccmv1alpha1.GatewayServiceTypeNodePort && cfg.HTTPSNodePort != nil {
+		fmt.Fprintf(&service, "  ports:\n  - port: %d\n    nodePort: %d\n", gatewayHTTPSPort, *cfg.HTTPSNodePort)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gatewayClassDefaultsName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				gatewayClassDefaultsLabel: cfg.GetGatewayClassName(),
+			},
+		},
+		Data: map[string]string{
+			"service": service.String(),
+		},
+	}
+}
+
+// checkStorageClass verifies the storage class assumptions of the cluster: the configured
+// StorageClass must exist or, when none is configured, the cluster must have a default one.
+// The resolved class is reported in the status so that users can see what workloads will get.
+func checkStorageClass(ctx context.Context, rr *types.ReconciliationRequest) error {
+	engine, ok := rr.Instance.(*ccmv1alpha1.GenericKubernetesEngine)
+	if !ok {
+		return fmt.Errorf("instance %T is not a GenericKubernetesEngine", rr.Instance)
+	}
+
+	engine.Status.StorageClassName = ""
+
+	name, err := resolveStorageClass(ctx, rr.Client, engine.Spec.Storage.StorageClassName)
+
+	switch {
+	case errors.Is(err, errStorageClassNotFound):
+		rr.Conditions.MarkFalse(status.ConditionStorageClassAvailable,
+			conditions.WithReason(status.StorageClassNotFoundReason),
+			conditions.WithMessage("StorageClass %s not found", engine.Spec.Storage.StorageClassName),
+		)
+		return nil
+	case errors.Is(err, errNoDefaultStorageClass):
+		rr.Conditions.MarkFalse(status.ConditionStorageClassAvailable,
+			conditions.WithReason(status.NoDefaultStorageClassReason),
+			conditions.WithMessage("no StorageClass configured and the cluster has no default StorageClass"),
+		)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	engine.Status.StorageClassName = name
+	rr.Conditions.MarkTrue(status.ConditionStorageClassAvailable,
+		conditions.WithMessage("StorageClass %s is available", name),
+	)
+
+	return nil
+}
+
+// resolveStorageClass returns the configured StorageClass if it exists, or the cluster default
+// StorageClass when name is empty.
+func resolveStorageClass(ctx context.Context, cli client.Client, name string) (string, error) {
+	if name != "" {
+		sc := &storagev1.StorageClass{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: name}, sc); err != nil {
+			if k8serr.IsNotFound(err) {
+				return "", errStorageClassNotFound
+			}
+			return "", fmt.Errorf("failed to get StorageClass %s: %w", name, err)
+		}
+
+		return name, nil
+	}
+
+	scList := &storagev1.StorageClassList{}
+	if err := cli.List(ctx, scList); err != nil {
+		return "", fmt.Errorf("failed to list StorageClasses: %w", err)
+	}
+
+	for i := range scList.Items {
+		if scList.Items[i].Annotations[defaultStorageClassAnnotation] == "true" {
+			return scList.Items[i].Name, nil
+		}
+	}
+
+	return "", errNoDefaultStorageClass
+}
@@ -0,0 +1,133 @@
+//go:build !integration
+
+//nolint:testpackage
+package generic
+
+import (
+	"context"
+	"testing"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/generic/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewGatewayClassDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		cfg             ccmv1alpha1.GenericGatewayConfiguration
+		expectedClass   string
+		expectedService string
+	}{
+		{
+			name:            "defaults to NodePort on the platform GatewayClass",
+			cfg:             ccmv1alpha1.GenericGatewayConfiguration{},
+			expectedClass:   ccmv1alpha1.DefaultGatewayClassName,
+			expectedService: "spec:\n  type: NodePort\n",
+		},
+		{
+			name: "pins the HTTPS node port",
+			cfg: ccmv1alpha1.GenericGatewayConfiguration{
+				ServiceType:   ccmv1alpha1.GatewayServiceTypeNodePort,
+				HTTPSNodePort: ptr.To[int32](30443),
+			},
+			expectedClass:   ccmv1alpha1.DefaultGatewayClassName,
+			expectedService: "spec:\n  type: NodePort\n  ports:\n  - port: 443\n    nodePort: 30443\n",
+		},
+		{
+			name: "uses the configured service type and class",
+			cfg: ccmv1alpha1.GenericGatewayConfiguration{
+				ServiceType:      ccmv1alpha1.GatewayServiceTypeLoadBalancer,
+				GatewayClassName: "custom-class",
+			},
+			expectedClass:   "custom-class",
+			expectedService: "spec:\n  type: LoadBalancer\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cm := newGatewayClassDefaults(&tc.cfg, "istio-system")
+
+			g.Expect(cm.Namespace).To(Equal("istio-system"))
+			g.Expect(cm.Labels).To(HaveKeyWithValue(gatewayClassDefaultsLabel, tc.expectedClass))
+			g.Expect(cm.Data).To(HaveKeyWithValue("service", tc.expectedService))
+		})
+	}
+}
+
+func TestResolveStorageClass(t *testing.T) {
+	t.Parallel()
+
+	newStorageClass := func(name string, isDefault bool) client.Object {
+		sc := &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: name},
+			Provisioner: "rancher.io/local-path",
+		}
+		if isDefault {
+			sc.Annotations = map[string]string{defaultStorageClassAnnotation: "true"}
+		}
+		return sc
+	}
+
+	testCases := []struct {
+		name        string
+		objects     []client.Object
+		configured  string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:       "returns the configured class",
+			objects:    []client.Object{newStorageClass("fast", false), newStorageClass("local-path", true)},
+			configured: "fast",
+			expected:   "fast",
+		},
+		{
+			name:        "fails when the configured class does not exist",
+			objects:     []client.Object{newStorageClass("local-path", true)},
+			configured:  "fast",
+			expectedErr: errStorageClassNotFound,
+		},
+		{
+			name:     "falls back to the cluster default",
+			objects:  []client.Object{newStorageClass("fast", false), newStorageClass("local-path", true)},
+			expected: "local-path",
+		},
+		{
+			name:        "fails without a default class",
+			objects:     []client.Object{newStorageClass("fast", false)},
+			expectedErr: errNoDefaultStorageClass,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(tc.objects...))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			name, err := resolveStorageClass(context.Background(), cli, tc.configured)
+			if tc.expectedErr != nil {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(name).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package generic
+
+import (
+	"context"
+	"slices"
+
+	storagev1 "k8s.io/api/storage/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/generic/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/cleanup"
+	certmanager "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/cloudmanager"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/operatorconfig"
+)
+
+// NewReconciler sets up the GenericKubernetesEngine controller and registers it with the manager.
+func NewReconciler(ctx context.Context, mgr ctrl.Manager, cfg *operatorconfig.CloudManagerConfig) error {
+	resourceID := labels.NormalizePartOfValue(ccmv1alpha1.GenericKubernetesEngineKind)
+	bootstrapConfig := certmanager.DefaultBootstrapConfig(certmanager.WithOperatorCert(cfg.RhaiOperatorNamespace))
+
+	_, err := reconciler.ReconcilerFor(mgr, &ccmv1alpha1.GenericKubernetesEngine{}).
+		WithDynamicOwnership(common.OperatorCRGVKPredicates()).
+		Watches(
+			&extv1.CustomResourceDefinition{},
+			reconciler.WithEventHandler(handlers.ToNamed(ccmv1alpha1.GenericKubernetesEngineInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(common.ServiceMonitorCRDName)),
+		).
+		// StorageClasses are not owned; watch them so that the storage class check
+		// follows classes being created, deleted or (un)marked as default.
+		Watches(
+			&storagev1.StorageClass{},
+			reconciler.WithEventHandler(handlers.ToNamed(ccmv1alpha1.GenericKubernetesEngineInstanceName)),
+		).
+		// Pre-flight checks run first so that nothing is applied on a cluster missing a required capability.
+		ComposeWith(cloudmanager.Preflight[*ccmv1alpha1.GenericKubernetesEngine](mgr, cfg.PreflightChecks...)).
+		ComposeWith(certmanager.Bootstrap[*ccmv1alpha1.GenericKubernetesEngine](
+			mgr,
+			ccmv1alpha1.GenericKubernetesEngineInstanceName,
+			bootstrapConfig,
+		)).
+		WithAction(checkStorageClass).
+		// Added before the reconcile action so that the defaults are deployed and tracked with the dependencies.
+		WithAction(addGatewayClassDefaults).
+		WithActionE(cloudmanager.NewReconcileAction(resourceID,
+			cloudmanager.WithInventoryNamespace(cfg.RhaiOperatorNamespace),
+		)).
+		// GC must be last: evaluates every CCM resource and removes stale or orphaned ones.
+		WithActionE(cloudmanager.NewGCAction(resourceID, cfg.RhaiOperatorNamespace,
+			cloudmanager.BootstrapProtectedObjects(bootstrapConfig),
+		)).
+		WithFinalizer(cleanup.NewFinalizer(
+			cloudmanager.FinalizerCleanupTargets()...,
+		)).
+		WithConditions(append(slices.Clone(cloudmanager.ConditionsTypes), status.ConditionStorageClassAvailable)...).
+		Build(ctx)
+	return err
+}
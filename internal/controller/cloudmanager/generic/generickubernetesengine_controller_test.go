@@ -0,0 +1,119 @@
+package generic_test
+
+import (
+	"testing"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/generic/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/cloudmanager/generic"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	ccmtest "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/cloudmanager"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+var genericCfg = ccmtest.ControllerTestConfig{
+	CRDSubdir:     "generic",
+	NewReconciler: generic.NewReconciler,
+	NewCR: func(deps ccmcommon.Dependencies) client.Object {
+		return &ccmv1alpha1.GenericKubernetesEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ccmv1alpha1.GenericKubernetesEngineInstanceName,
+			},
+			Spec: ccmv1alpha1.GenericKubernetesEngineSpec{
+				Dependencies: deps,
+			},
+		}
+	},
+	InstanceName: ccmv1alpha1.GenericKubernetesEngineInstanceName,
+	InfraLabel:   "generickubernetesengine",
+	GVK:          gvk.GenericKubernetesEngine,
+}
+
+func TestGenericKubernetesEngine(t *testing.T) {
+	ccmtest.RequireCharts(t)
+
+	nn := types.NamespacedName{Name: ccmv1alpha1.GenericKubernetesEngineInstanceName}
+
+	t.Run("deploys managed dependencies", func(t *testing.T) {
+		wt := tc.NewWithT(t)
+
+		ccmtest.CreateCR(t, wt, genericCfg, ccmcommon.Dependencies{
+			GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Managed},
+			LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Managed},
+			SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Managed},
+		})
+
+		wt.Get(gvk.Deployment, types.NamespacedName{
+			Name: "openshift-lws-operator", Namespace: "openshift-lws-operator",
+		}).Eventually().Should(Not(BeNil()))
+
+		wt.Get(gvk.Deployment, types.NamespacedName{
+			Name: "servicemesh-operator3", Namespace: "istio-system",
+		}).Eventually().Should(Not(BeNil()))
+	})
+
+	t.Run("exposes platform Gateways through NodePort by default", func(t *testing.T) {
+		wt := tc.NewWithT(t)
+
+		ccmtest.CreateCR(t, wt, genericCfg, ccmcommon.Dependencies{
+			SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Managed},
+		})
+
+		wt.Get(gvk.ConfigMap, types.NamespacedName{
+			Name: "generic-gateway-class-defaults", Namespace: "istio-system",
+		}).Eventually().Should(And(
+			jq.Match(`.metadata.labels."gateway.istio.io/defaults-for-class" == "%s"`, ccmv1alpha1.DefaultGatewayClassName),
+			jq.Match(`.metadata.labels."%s" == "generickubernetesengine"`, labels.InfrastructurePartOf),
+			jq.Match(`.data.service | contains("type: NodePort")`),
+		))
+	})
+
+	t.Run("reports the default StorageClass", func(t *testing.T) {
+		wt := tc.NewWithT(t)
+
+		sc := &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "local-path",
+				Annotations: map[string]string{
+					"storageclass.kubernetes.io/is-default-class": "true",
+				},
+			},
+			Provisioner: "rancher.io/local-path",
+		}
+		wt.Expect(wt.Client().Create(wt.Context(), sc)).To(Succeed())
+		t.Cleanup(func() {
+			_ = wt.Client().Delete(wt.Context(), sc)
+		})
+
+		ccmtest.CreateCR(t, wt, genericCfg, ccmcommon.Dependencies{})
+
+		wt.Get(gvk.GenericKubernetesEngine, nn).Eventually().Should(And(
+			jq.Match(`.status.conditions[] | select(.type == "StorageClassAvailable") | .status == "True"`),
+			jq.Match(`.status.storageClassName == "local-path"`),
+		))
+	})
+
+	t.Run("reports a missing configured StorageClass", func(t *testing.T) {
+		wt := tc.NewWithT(t)
+
+		ccmtest.CreateCR(t, wt, genericCfg, ccmcommon.Dependencies{})
+
+		wt.Update(gvk.GenericKubernetesEngine, nn, func(obj *unstructured.Unstructured) error {
+			return unstructured.SetNestedField(obj.Object, "does-not-exist", "spec", "storage", "storageClassName")
+		}).Eventually().Should(Not(BeNil()))
+
+		wt.Get(gvk.GenericKubernetesEngine, nn).Eventually().Should(And(
+			jq.Match(`.status.conditions[] | select(.type == "StorageClassAvailable") | .status == "False"`),
+			jq.Match(`.status.conditions[] | select(.type == "StorageClassAvailable") | .reason == "StorageClassNotFound"`),
+		))
+	})
+}
@@ -0,0 +1,6 @@
+package generic
+
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=generickubernetesengines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=generickubernetesengines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=generickubernetesengines/finalizers,verbs=update
+// +kubebuilder:rbac:groups="storage.k8s.io",resources=storageclasses,verbs=get;list;watch
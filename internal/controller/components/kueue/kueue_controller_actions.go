@@ -134,6 +134,8 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		return fmt.Errorf("resource instance %v is not a componentApi.Kueue)", rr.Instance)
 	}
 
+	kueueCRInstance.Status.HardwareProfileFlavors = nil
+
 	// Don't proceed if kueue is in Removed state.
 	if kueueCRInstance.Spec.ManagementState == operatorv1.Removed {
 		return nil
@@ -149,7 +151,8 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		rr.Resources = append(rr.Resources, *defaultKueueConfig)
 	}
 
-	if kueueCRInstance.Spec.AutoCreateQueues == nil || !*kueueCRInstance.Spec.AutoCreateQueues {
+	autoCreateQueues := kueueCRInstance.Spec.AutoCreateQueues != nil && *kueueCRInstance.Spec.AutoCreateQueues
+	if !autoCreateQueues && kueueCRInstance.Spec.Queues == nil {
 		return nil
 	}
 
@@ -161,19 +164,6 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		return nil
 	}
 
-	clusterInfo, err := getClusterResourceInfo(ctx, rr.Client)
-	if err != nil {
-		return fmt.Errorf("failed to get cluster resource info: %w", err)
-	}
-
-	// Generate default ResourceFlavor.
-	resourcesFlavors := createDefaultResourceFlavors(clusterInfo, resolvedGVKs)
-	rr.Resources = append(rr.Resources, resourcesFlavors...)
-
-	// Generate default ClusterQueue.
-	clusterQueue := createDefaultClusterQueue(kueueCRInstance.Spec.DefaultClusterQueueName, clusterInfo, resolvedGVKs)
-	rr.Resources = append(rr.Resources, *clusterQueue)
-
 	// Get all managed namespaces (i.e. the one opted in with the addition of the proper labels).
 	managedNamespaces, err := getManagedNamespaces(ctx, rr.Client)
 	if err != nil {
@@ -185,15 +175,55 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		return fmt.Errorf("failed to add missing labels to managed namespaces: %v with error: %w", managedNamespaces, err)
 	}
 
-	// Generate LocalQueues in each managed namespaces.
-	for _, ns := range managedNamespaces {
-		// Skip namespaces that are being terminated - Kubernetes rejects resource
-		// creation in terminating namespaces.
-		if !ns.GetDeletionTimestamp().IsZero() {
-			continue
+	if autoCreateQueues {
+		if kueueCRInstance.Spec.HardwareProfileFlavors != nil && *kueueCRInstance.Spec.HardwareProfileFlavors {
+			// Generate ResourceFlavors from the HardwareProfiles and the default ClusterQueue covering them.
+			queueResources, profileFlavors, err := createHardwareProfileQueueResources(ctx, rr.Client, kueueCRInstance.Spec.DefaultClusterQueueName, resolvedGVKs)
+			if err != nil {
+				return fmt.Errorf("failed to generate HardwareProfile flavors: %w", err)
+			}
+			rr.Resources = append(rr.Resources, queueResources...)
+			kueueCRInstance.Status.HardwareProfileFlavors = profileFlavors
+		} else {
+			clusterInfo, err := getClusterResourceInfo(ctx, rr.Client)
+			if err != nil {
+				return fmt.Errorf("failed to get cluster resource info: %w", err)
+			}
+
+			// Generate default ResourceFlavor.
+			resourcesFlavors := createDefaultResourceFlavors(clusterInfo, resolvedGVKs)
+			rr.Resources = append(rr.Resources, resourcesFlavors...)
+
+			// Generate default ClusterQueue.
+			clusterQueue := createDefaultClusterQueue(kueueCRInstance.Spec.DefaultClusterQueueName, clusterInfo, resolvedGVKs)
+			rr.Resources = append(rr.Resources, *clusterQueue)
+		}
+
+		// Generate LocalQueues in each managed namespaces.
+		for _, ns := range managedNamespaces {
+			// Skip namespaces that are being terminated - Kubernetes rejects resource
+			// creation in terminating namespaces.
+			if !ns.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			localQueue := createDefaultLocalQueue(kueueCRInstance.Spec.DefaultLocalQueueName, kueueCRInstance.Spec.DefaultClusterQueueName, ns.Name, resolvedGVKs)
+			rr.Resources = append(rr.Resources, *localQueue)
+		}
+	}
+
+	// Generate the team ClusterQueues and their LocalQueues.
+	if kueueCRInstance.Spec.Queues != nil {
+		if autoCreateQueues {
+			if err := validateTeamQueueNames(kueueCRInstance.Spec.Queues, kueueCRInstance.Spec.KueueDefaultQueueSpec); err != nil {
+				return err
+			}
+		}
+
+		teamQueues, err := createTeamQueues(kueueCRInstance.Spec.Queues, managedNamespaces, resolvedGVKs)
+		if err != nil {
+			return fmt.Errorf("failed to generate team queues: %w", err)
 		}
-		localQueue := createDefaultLocalQueue(kueueCRInstance.Spec.DefaultLocalQueueName, kueueCRInstance.Spec.DefaultClusterQueueName, ns.Name, resolvedGVKs)
-		rr.Resources = append(rr.Resources, *localQueue)
+		rr.Resources = append(rr.Resources, teamQueues...)
 	}
 
 	return nil
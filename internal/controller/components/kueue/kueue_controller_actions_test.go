@@ -4,8 +4,10 @@ package kueue
 import (
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 
+	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	"github.com/rs/xid"
@@ -16,10 +18,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -694,6 +700,462 @@ func TestDefaultKueueResourcesAction(t *testing.T) {
 	}
 }
 
+func TestManageDefaultKueueResourcesAction_TeamQueues(t *testing.T) {
+	g := NewWithT(t)
+
+	teamANamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-a-ns",
+			Labels: map[string]string{
+				cluster.KueueManagedLabelKey: "true",
+				"team":                       "a",
+			},
+		},
+	}
+	teamBNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-b-ns",
+			Labels: map[string]string{
+				cluster.KueueLegacyManagedLabelKey: "true",
+				"team":                             "b",
+			},
+		},
+	}
+	// Not managed by Kueue, so no LocalQueue is expected even though it matches team-a.
+	unmanagedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-a-unmanaged-ns",
+			Labels: map[string]string{
+				"team": "a",
+			},
+		},
+	}
+
+	borrowingLimit := resource.MustParse("4")
+	lendingLimit := resource.MustParse("1")
+
+	kueue := &componentApi.Kueue{
+		Spec: componentApi.KueueSpec{
+			KueueManagementSpec: componentApi.KueueManagementSpec{
+				ManagementState: operatorv1.Managed,
+			},
+			KueueCommonSpec: componentApi.KueueCommonSpec{
+				Queues: &componentApi.KueueQueuesSpec{
+					Cohorts: []componentApi.KueueCohort{{Name: "shared"}},
+					Teams: []componentApi.KueueTeamQueue{
+						{
+							Name:   "team-a",
+							Cohort: "shared",
+							NamespaceSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"team": "a"},
+							},
+							Flavors: []componentApi.KueueFlavorQuota{
+								{
+									Name: DefaultFlavorName,
+									Resources: []componentApi.KueueResourceQuota{
+										{Name: corev1.ResourceMemory, NominalQuota: resource.MustParse("64Gi")},
+										{Name: corev1.ResourceCPU, NominalQuota: resource.MustParse("16")},
+									},
+								},
+								{
+									Name: NvidiaFlavorName,
+									Resources: []componentApi.KueueResourceQuota{
+										{
+											Name:           NvidiaGPUResourceKey,
+											NominalQuota:   resource.MustParse("2"),
+											BorrowingLimit: &borrowingLimit,
+											LendingLimit:   &lendingLimit,
+										},
+									},
+								},
+							},
+						},
+						{
+							Name:           "team-b",
+							LocalQueueName: "team-b-queue",
+							NamespaceSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"team": "b"},
+							},
+							Flavors: []componentApi.KueueFlavorQuota{
+								{
+									Name: DefaultFlavorName,
+									Resources: []componentApi.KueueResourceQuota{
+										{Name: corev1.ResourceCPU, NominalQuota: resource.MustParse("8")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(
+			teamANamespace,
+			teamBNamespace,
+			unmanagedNamespace,
+			&apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "clusterqueues.kueue.x-k8s.io",
+				},
+			},
+		),
+		fakeclient.WithGVKs(
+			fakeclient.GVKMapping{GVK: gvk.ClusterQueue, Scope: meta.RESTScopeRoot},
+			fakeclient.GVKMapping{GVK: gvk.LocalQueue, Scope: meta.RESTScopeNamespace},
+			fakeclient.GVKMapping{GVK: gvk.ResourceFlavor, Scope: meta.RESTScopeRoot},
+		),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	rr := &types.ReconciliationRequest{
+		Instance:  kueue,
+		Client:    cli,
+		Resources: []unstructured.Unstructured{},
+	}
+
+	err = manageDefaultKueueResourcesAction(t.Context(), rr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Without autoCreateQueues, only the team queues are generated.
+	g.Expect(rr.Resources).To(HaveLen(4))
+
+	g.Expect(rr.Resources[0].GetKind()).To(Equal(gvk.ClusterQueue.Kind))
+	g.Expect(rr.Resources[0].GetName()).To(Equal("team-a"))
+	g.Expect(rr.Resources[0].GetAnnotations()).To(BeEmpty())
+	g.Expect(rr.Resources[0].Object).To(HaveKeyWithValue("spec", map[string]any{
+		"cohortName": "shared",
+		"namespaceSelector": map[string]any{
+			"matchLabels": map[string]any{"team": "a"},
+		},
+		"resourceGroups": []any{
+			map[string]any{
+				"coveredResources": []any{"cpu", "memory"},
+				"flavors": []any{
+					map[string]any{
+						"name": DefaultFlavorName,
+						"resources": []any{
+							map[string]any{"name": "cpu", "nominalQuota": "16"},
+							map[string]any{"name": "memory", "nominalQuota": "64Gi"},
+						},
+					},
+				},
+			},
+			map[string]any{
+				"coveredResources": []any{NvidiaGPUResourceKey},
+				"flavors": []any{
+					map[string]any{
+						"name": NvidiaFlavorName,
+						"resources": []any{
+							map[string]any{
+								"name":           NvidiaGPUResourceKey,
+								"nominalQuota":   "2",
+								"borrowingLimit": "4",
+								"lendingLimit":   "1",
+							},
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	g.Expect(rr.Resources[1].GetKind()).To(Equal(gvk.LocalQueue.Kind))
+	g.Expect(rr.Resources[1].GetName()).To(Equal("team-a"))
+	g.Expect(rr.Resources[1].GetNamespace()).To(Equal("team-a-ns"))
+	g.Expect(rr.Resources[1].Object).To(HaveKeyWithValue("spec", map[string]any{"clusterQueue": "team-a"}))
+
+	g.Expect(rr.Resources[2].GetKind()).To(Equal(gvk.ClusterQueue.Kind))
+	g.Expect(rr.Resources[2].GetName()).To(Equal("team-b"))
+	_, found, err := unstructured.NestedString(rr.Resources[2].Object, "spec", "cohortName")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+
+	g.Expect(rr.Resources[3].GetKind()).To(Equal(gvk.LocalQueue.Kind))
+	g.Expect(rr.Resources[3].GetName()).To(Equal("team-b-queue"))
+	g.Expect(rr.Resources[3].GetNamespace()).To(Equal("team-b-ns"))
+	g.Expect(rr.Resources[3].Object).To(HaveKeyWithValue("spec", map[string]any{"clusterQueue": "team-b"}))
+
+	// Team queues conflicting with the default queues are rejected.
+	autoCreateTrue := true
+	kueue.Spec.AutoCreateQueues = &autoCreateTrue
+	kueue.Spec.DefaultClusterQueueName = "default"
+	kueue.Spec.DefaultLocalQueueName = "team-b-queue"
+	rr.Resources = []unstructured.Unstructured{}
+
+	err = manageDefaultKueueResourcesAction(t.Context(), rr)
+	g.Expect(err).To(MatchError(ContainSubstring("team team-b: LocalQueue name conflicts with the default LocalQueue")))
+}
+
+func TestManageDefaultKueueResourcesAction_HardwareProfileFlavors(t *testing.T) {
+	g := NewWithT(t)
+
+	managedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-managed-ns",
+			Labels: map[string]string{
+				cluster.KueueManagedLabelKey: "true",
+			},
+		},
+	}
+
+	gpuNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gpu-node",
+			Labels: map[string]string{"gpu": "a100"},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("32Gi"),
+				NvidiaGPUResourceKey:  resource.MustParse("4"),
+			},
+		},
+	}
+	cpuNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cpu-node",
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		},
+	}
+
+	nodeProfile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a100",
+			Namespace: "profiles",
+		},
+		Spec: infrav1.HardwareProfileSpec{
+			Identifiers: []infrav1.HardwareIdentifier{
+				{
+					DisplayName:  "GPU",
+					Identifier:   NvidiaGPUResourceKey,
+					MinCount:     intstr.FromInt32(1),
+					DefaultCount: intstr.FromInt32(1),
+					ResourceType: "Accelerator",
+				},
+			},
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node: &infrav1.NodeSchedulingSpec{
+					NodeSelector: map[string]string{"gpu": "a100"},
+					Tolerations: []corev1.Toleration{
+						{Key: NvidiaGPUResourceKey, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+		},
+	}
+	// Selects the same nodes as nodeProfile, which is tried first and gets them.
+	overlappingProfile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu",
+			Namespace: "profiles",
+		},
+		Spec: infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node:           &infrav1.NodeSchedulingSpec{NodeSelector: map[string]string{"gpu": "a100"}},
+			},
+		},
+	}
+	// Selects every node, so it cannot be told apart from the default flavor and gets no flavor.
+	emptySelectorProfile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "any-node",
+			Namespace: "profiles",
+		},
+		Spec: infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node: &infrav1.NodeSchedulingSpec{
+					Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+				},
+			},
+		},
+	}
+	disabledProfile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "disabled",
+			Namespace:   "profiles",
+			Annotations: map[string]string{annotations.HardwareProfileDisabled: "true"},
+		},
+		Spec: infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node:           &infrav1.NodeSchedulingSpec{NodeSelector: map[string]string{"gpu": "a100"}},
+			},
+		},
+	}
+	queueProfile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "queue",
+			Namespace: "profiles",
+		},
+		Spec: infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.QueueScheduling,
+				Kueue:          &infrav1.KueueSchedulingSpec{LocalQueueName: "default"},
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(
+			managedNamespace,
+			gpuNode,
+			cpuNode,
+			nodeProfile,
+			overlappingProfile,
+			emptySelectorProfile,
+			disabledProfile,
+			queueProfile,
+			&apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "clusterqueues.kueue.x-k8s.io",
+				},
+			},
+		),
+		fakeclient.WithGVKs(
+			fakeclient.GVKMapping{GVK: gvk.ClusterQueue, Scope: meta.RESTScopeRoot},
+			fakeclient.GVKMapping{GVK: gvk.LocalQueue, Scope: meta.RESTScopeNamespace},
+			fakeclient.GVKMapping{GVK: gvk.ResourceFlavor, Scope: meta.RESTScopeRoot},
+		),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	enabled := true
+	kueue := &componentApi.Kueue{
+		Spec: componentApi.KueueSpec{
+			KueueManagementSpec: componentApi.KueueManagementSpec{
+				ManagementState: operatorv1.Managed,
+			},
+			KueueDefaultQueueSpec: componentApi.KueueDefaultQueueSpec{
+				AutoCreateQueues:        &enabled,
+				DefaultLocalQueueName:   "default",
+				DefaultClusterQueueName: "default",
+				HardwareProfileFlavors:  &enabled,
+			},
+		},
+	}
+
+	rr := &types.ReconciliationRequest{
+		Instance:  kueue,
+		Client:    cli,
+		Resources: []unstructured.Unstructured{},
+	}
+
+	err = manageDefaultKueueResourcesAction(t.Context(), rr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	flavorName := HardwareProfileFlavorPrefix + "profiles.a100"
+	overlappingFlavorName := HardwareProfileFlavorPrefix + "profiles.gpu"
+
+	g.Expect(kueue.Status.HardwareProfileFlavors).To(Equal([]componentApi.KueueHardwareProfileFlavor{
+		{Namespace: "profiles", Name: "a100", Flavor: flavorName},
+		{Namespace: "profiles", Name: "gpu", Flavor: overlappingFlavorName},
+	}))
+
+	// default-flavor, the profile flavors, the ClusterQueue and the LocalQueue.
+	g.Expect(rr.Resources).To(HaveLen(5))
+
+	g.Expect(rr.Resources[0].GetKind()).To(Equal(gvk.ResourceFlavor.Kind))
+	g.Expect(rr.Resources[0].GetName()).To(Equal(DefaultFlavorName))
+
+	g.Expect(rr.Resources[1].GetKind()).To(Equal(gvk.ResourceFlavor.Kind))
+	g.Expect(rr.Resources[1].GetName()).To(Equal(flavorName))
+	g.Expect(rr.Resources[1].GetAnnotations()).To(BeEmpty())
+	g.Expect(rr.Resources[1].Object).To(HaveKeyWithValue("spec", map[string]any{
+		"nodeLabels": map[string]any{"gpu": "a100"},
+		"tolerations": []any{
+			map[string]any{"key": NvidiaGPUResourceKey, "operator": "Exists", "effect": "NoSchedule"},
+		},
+		"nodeTaints": []any{
+			map[string]any{"key": NvidiaGPUResourceKey, "effect": "NoSchedule"},
+		},
+	}))
+
+	g.Expect(rr.Resources[2].GetName()).To(Equal(overlappingFlavorName))
+
+	g.Expect(rr.Resources[3].GetKind()).To(Equal(gvk.ClusterQueue.Kind))
+	g.Expect(rr.Resources[3].GetName()).To(Equal("default"))
+	g.Expect(rr.Resources[3].GetAnnotations()).To(BeEmpty())
+	resourceGroups, found, err := unstructured.NestedSlice(rr.Resources[3].Object, "spec", "resourceGroups")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(resourceGroups).To(Equal([]any{
+		map[string]any{
+			"coveredResources": []any{"cpu", "memory", NvidiaGPUResourceKey},
+			"flavors": []any{
+				map[string]any{
+					"name": DefaultFlavorName,
+					"resources": []any{
+						map[string]any{"name": "cpu", "nominalQuota": "4"},
+						map[string]any{"name": "memory", "nominalQuota": "16Gi"},
+						map[string]any{"name": NvidiaGPUResourceKey, "nominalQuota": "0"},
+					},
+				},
+				map[string]any{
+					"name": flavorName,
+					"resources": []any{
+						map[string]any{"name": "cpu", "nominalQuota": "8"},
+						map[string]any{"name": "memory", "nominalQuota": "32Gi"},
+						map[string]any{"name": NvidiaGPUResourceKey, "nominalQuota": "4"},
+					},
+				},
+				map[string]any{
+					"name": overlappingFlavorName,
+					"resources": []any{
+						map[string]any{"name": "cpu", "nominalQuota": "0"},
+						map[string]any{"name": "memory", "nominalQuota": "0"},
+						map[string]any{"name": NvidiaGPUResourceKey, "nominalQuota": "0"},
+					},
+				},
+			},
+		},
+	}))
+
+	// A workload without node selector is admitted to the default flavor, and a workload using
+	// the profile to the profile flavor.
+	g.Expect(firstCompatibleFlavor(g, rr.Resources[:3], resourceGroups, corev1.PodSpec{})).To(Equal(DefaultFlavorName))
+	g.Expect(firstCompatibleFlavor(g, rr.Resources[:3], resourceGroups, corev1.PodSpec{
+		NodeSelector: nodeProfile.Spec.SchedulingSpec.Node.NodeSelector,
+		Tolerations:  nodeProfile.Spec.SchedulingSpec.Node.Tolerations,
+	})).To(Equal(flavorName))
+
+	// Without room in the default flavor, a workload that does not tolerate the GPU taint fits
+	// no profile flavor that carries the taint.
+	g.Expect(firstCompatibleFlavor(g, rr.Resources[1:2], resourceGroups, corev1.PodSpec{})).To(BeEmpty())
+
+	g.Expect(rr.Resources[4].GetKind()).To(Equal(gvk.LocalQueue.Kind))
+	g.Expect(rr.Resources[4].GetNamespace()).To(Equal("test-managed-ns"))
+}
+
+func TestHardwareProfileFlavorName(t *testing.T) {
+	g := NewWithT(t)
+
+	profile := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      strings.Repeat("a", 250),
+			Namespace: "profiles",
+		},
+	}
+
+	name := hardwareProfileFlavorName(profile)
+	g.Expect(name).To(HaveLen(validation.DNS1123SubdomainMaxLength))
+	g.Expect(name).To(HavePrefix(HardwareProfileFlavorPrefix + "profiles."))
+	g.Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+
+	profile.Name = strings.Repeat("a", 249) + "b"
+	g.Expect(hardwareProfileFlavorName(profile)).ToNot(Equal(name))
+}
+
 func assertClusterQueueCorrectness(g *WithT, clusterQueue *unstructured.Unstructured, withGPU bool, expectedClusterQueueName string, expectedFlavorNames []string) {
 	g.Expect(clusterQueue).ToNot(BeNil())
 	g.Expect(clusterQueue.GetName()).To(Equal(expectedClusterQueueName))
@@ -913,3 +1375,58 @@ func TestKueueConditionFilter(t *testing.T) {
 		})
 	}
 }
+
+// firstCompatibleFlavor returns the first flavor of the resource group, among the given
+// ResourceFlavors, that is compatible with the pod the way Kueue checks it before quota: the pod
+// node selector must match the node labels of the flavor for the label keys used by the flavors
+// of the group, and the pod must tolerate the node taints of the flavor.
+func firstCompatibleFlavor(g Gomega, resourceFlavors []unstructured.Unstructured, resourceGroups []any, pod corev1.PodSpec) string {
+	specs := make(map[string]map[string]any, len(resourceFlavors))
+	allowedKeys := map[string]bool{}
+	for _, rf := range resourceFlavors {
+		spec, _, err := unstructured.NestedMap(rf.Object, "spec")
+		g.Expect(err).ToNot(HaveOccurred())
+		specs[rf.GetName()] = spec
+		nodeLabels, _, _ := unstructured.NestedStringMap(spec, "nodeLabels")
+		for key := range nodeLabels {
+			allowedKeys[key] = true
+		}
+	}
+
+	g.Expect(resourceGroups).To(HaveLen(1))
+	flavors, _, err := unstructured.NestedSlice(resourceGroups[0].(map[string]any), "flavors")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, f := range flavors {
+		name, _ := f.(map[string]any)["name"].(string)
+		spec, ok := specs[name]
+		if !ok {
+			continue
+		}
+
+		nodeLabels, _, _ := unstructured.NestedStringMap(spec, "nodeLabels")
+		compatible := true
+		for key, value := range pod.NodeSelector {
+			if allowedKeys[key] && nodeLabels[key] != value {
+				compatible = false
+			}
+		}
+
+		nodeTaints, _, _ := unstructured.NestedSlice(spec, "nodeTaints")
+		for _, t := range nodeTaints {
+			taint := corev1.Taint{}
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(t.(map[string]any), &taint)).To(Succeed())
+			if !slices.ContainsFunc(pod.Tolerations, func(toleration corev1.Toleration) bool {
+				return toleration.ToleratesTaint(logr.Discard(), &taint, false)
+			}) {
+				compatible = false
+			}
+		}
+
+		if compatible {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -2,20 +2,27 @@ package kueue
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -52,6 +59,8 @@ const (
 	DefaultFlavorName = "default-flavor"
 	NvidiaFlavorName  = "nvidia-gpu-flavor"
 	AMDFlavorName     = "amd-gpu-flavor"
+	// HardwareProfileFlavorPrefix prefixes the name of the flavors generated from HardwareProfiles.
+	HardwareProfileFlavorPrefix = "hwp-"
 )
 
 var (
@@ -134,6 +143,9 @@ func ensureKueueLabelsOnManagedNamespaces(ctx context.Context, c client.Client,
 type FlavorResource struct {
 	Name  string
 	Value string
+	// BorrowingLimit and LendingLimit are omitted from the resource group when empty.
+	BorrowingLimit string
+	LendingLimit   string
 }
 
 type Flavors struct {
@@ -149,10 +161,17 @@ func createResourceGroup(flavors []Flavors) map[string]any {
 		resources := make([]any, 0, len(flavor.Resources))
 
 		for _, resource := range flavor.Resources {
-			resources = append(resources, map[string]any{
+			flavorResource := map[string]any{
 				"name":         resource.Name,
 				"nominalQuota": resource.Value,
-			})
+			}
+			if resource.BorrowingLimit != "" {
+				flavorResource["borrowingLimit"] = resource.BorrowingLimit
+			}
+			if resource.LendingLimit != "" {
+				flavorResource["lendingLimit"] = resource.LendingLimit
+			}
+			resources = append(resources, flavorResource)
 
 			resourceMap[resource.Name] = true
 		}
@@ -177,8 +196,6 @@ func createResourceGroup(flavors []Flavors) map[string]any {
 }
 
 func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo, gvks *kueueResourceGVKs) *unstructured.Unstructured {
-	clusterQueue := &unstructured.Unstructured{}
-
 	clusterGPU := slices.Sorted(maps.Keys(clusterInfo.GPUInfo))
 	resourceGroups := make([]any, 0, 1+len(clusterGPU))
 	resourceGroups = append(resourceGroups, createResourceGroup([]Flavors{
@@ -202,14 +219,23 @@ func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo, gvk
 		}))
 	}
 
+	clusterQueue := createClusterQueue(name, resourceGroups, gvks)
+	clusterQueue.SetAnnotations(map[string]string{
+		annotations.ManagedByODHOperator: "false",
+	})
+
+	return clusterQueue
+}
+
+// createClusterQueue generates a ClusterQueue admitting the workloads of the managed namespaces.
+func createClusterQueue(name string, resourceGroups []any, gvks *kueueResourceGVKs) *unstructured.Unstructured {
+	clusterQueue := &unstructured.Unstructured{}
+
 	clusterQueue.Object = map[string]any{
 		"apiVersion": gvks.ClusterQueue.GroupVersion().String(),
 		"kind":       gvks.ClusterQueue.Kind,
 		"metadata": map[string]any{
 			"name": name,
-			"annotations": map[string]any{
-				annotations.ManagedByODHOperator: "false",
-			},
 		},
 		"spec": map[string]any{
 			"namespaceSelector": map[string]any{
@@ -225,6 +251,15 @@ func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo, gvk
 }
 
 func createDefaultLocalQueue(name string, clusterQueueName string, namespace string, gvks *kueueResourceGVKs) *unstructured.Unstructured {
+	localQueue := createLocalQueue(name, clusterQueueName, namespace, gvks)
+	localQueue.SetAnnotations(map[string]string{
+		annotations.ManagedByODHOperator: "false",
+	})
+
+	return localQueue
+}
+
+func createLocalQueue(name string, clusterQueueName string, namespace string, gvks *kueueResourceGVKs) *unstructured.Unstructured {
 	localQueue := &unstructured.Unstructured{}
 
 	localQueue.Object = map[string]any{
@@ -233,9 +268,6 @@ func createDefaultLocalQueue(name string, clusterQueueName string, namespace str
 		"metadata": map[string]any{
 			"name":      name,
 			"namespace": namespace,
-			"annotations": map[string]any{
-				annotations.ManagedByODHOperator: "false",
-			},
 		},
 		"spec": map[string]any{
 			"clusterQueue": clusterQueueName,
@@ -245,6 +277,144 @@ func createDefaultLocalQueue(name string, clusterQueueName string, namespace str
 	return localQueue
 }
 
+// validateTeamQueueNames ensures the team queues do not collide with the default ones, which
+// are created in all the managed namespaces.
+func validateTeamQueueNames(queues *componentApi.KueueQueuesSpec, defaults componentApi.KueueDefaultQueueSpec) error {
+	for _, team := range queues.Teams {
+		if team.Name == defaults.DefaultClusterQueueName {
+			return fmt.Errorf("team %s: ClusterQueue name conflicts with the default ClusterQueue", team.Name)
+		}
+		if teamLocalQueueName(team) == defaults.DefaultLocalQueueName {
+			return fmt.Errorf("team %s: LocalQueue name conflicts with the default LocalQueue", team.Name)
+		}
+	}
+
+	return nil
+}
+
+// createTeamQueues generates the ClusterQueue of each team and a LocalQueue pointing to it in
+// each managed namespace matching the team namespace selector. Unlike the default queues, team
+// queues are fully managed by the operator: quota changes are applied and the queues of removed
+// teams are garbage collected.
+func createTeamQueues(queues *componentApi.KueueQueuesSpec, managedNamespaces []corev1.Namespace, gvks *kueueResourceGVKs) ([]unstructured.Unstructured, error) {
+	result := make([]unstructured.Unstructured, 0, len(queues.Teams))
+	localQueues := make(map[string]string)
+
+	for _, team := range queues.Teams {
+		clusterQueue, err := createTeamClusterQueue(team, gvks)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *clusterQueue)
+
+		selector, err := metav1.LabelSelectorAsSelector(&team.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: invalid namespace selector: %w", team.Name, err)
+		}
+
+		localQueueName := teamLocalQueueName(team)
+		for _, ns := range managedNamespaces {
+			if !ns.GetDeletionTimestamp().IsZero() || !selector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+
+			key := ns.Name + "/" + localQueueName
+			if other, ok := localQueues[key]; ok {
+				return nil, fmt.Errorf("team %s: LocalQueue %s conflicts with the one of team %s", team.Name, key, other)
+			}
+			localQueues[key] = team.Name
+
+			result = append(result, *createLocalQueue(localQueueName, team.Name, ns.Name, gvks))
+		}
+	}
+
+	return result, nil
+}
+
+func createTeamClusterQueue(team componentApi.KueueTeamQueue, gvks *kueueResourceGVKs) (*unstructured.Unstructured, error) {
+	namespaceSelector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&team.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("team %s: failed to convert namespace selector: %w", team.Name, err)
+	}
+
+	spec := map[string]any{
+		"namespaceSelector": namespaceSelector,
+		"resourceGroups":    createTeamResourceGroups(team.Flavors),
+	}
+	if team.Cohort != "" {
+		spec[clusterQueueCohortField(gvks)] = team.Cohort
+	}
+
+	clusterQueue := &unstructured.Unstructured{}
+	clusterQueue.Object = map[string]any{
+		"apiVersion": gvks.ClusterQueue.GroupVersion().String(),
+		"kind":       gvks.ClusterQueue.Kind,
+		"metadata": map[string]any{
+			"name": team.Name,
+		},
+		"spec": spec,
+	}
+
+	return clusterQueue, nil
+}
+
+// createTeamResourceGroups groups the flavors covering the same resources, as Kueue requires each
+// resource to be covered by a single resource group.
+func createTeamResourceGroups(flavorQuotas []componentApi.KueueFlavorQuota) []any {
+	groups := make(map[string][]Flavors)
+	keys := make([]string, 0, len(flavorQuotas))
+
+	for _, flavorQuota := range flavorQuotas {
+		// Kueue requires the resources of a flavor to be listed in the coveredResources order.
+		quotas := slices.SortedFunc(slices.Values(flavorQuota.Resources), func(a, b componentApi.KueueResourceQuota) int {
+			return strings.Compare(string(a.Name), string(b.Name))
+		})
+
+		flavor := Flavors{Name: flavorQuota.Name, Resources: make([]FlavorResource, 0, len(quotas))}
+		names := make([]string, 0, len(quotas))
+		for _, quota := range quotas {
+			flavorResource := FlavorResource{Name: string(quota.Name), Value: quota.NominalQuota.String()}
+			if quota.BorrowingLimit != nil {
+				flavorResource.BorrowingLimit = quota.BorrowingLimit.String()
+			}
+			if quota.LendingLimit != nil {
+				flavorResource.LendingLimit = quota.LendingLimit.String()
+			}
+			flavor.Resources = append(flavor.Resources, flavorResource)
+			names = append(names, flavorResource.Name)
+		}
+
+		key := strings.Join(names, ",")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], flavor)
+	}
+
+	resourceGroups := make([]any, 0, len(keys))
+	for _, key := range keys {
+		resourceGroups = append(resourceGroups, createResourceGroup(groups[key]))
+	}
+
+	return resourceGroups
+}
+
+func teamLocalQueueName(team componentApi.KueueTeamQueue) string {
+	if team.LocalQueueName != "" {
+		return team.LocalQueueName
+	}
+	return team.Name
+}
+
+// clusterQueueCohortField returns the ClusterQueue spec field holding the cohort, which has been
+// renamed from cohort to cohortName in kueue.x-k8s.io/v1beta2.
+func clusterQueueCohortField(gvks *kueueResourceGVKs) string {
+	if gvks.ClusterQueue.Version == gvk.ClusterQueueV1Beta1.Version {
+		return "cohort"
+	}
+	return "cohortName"
+}
+
 func createDefaultResourceFlavors(clusterInfo ClusterResourceInfo, gvks *kueueResourceGVKs) []unstructured.Unstructured {
 	resourceFlavors := make([]unstructured.Unstructured, 0, 1+len(clusterInfo.GPUInfo))
 
@@ -282,6 +452,283 @@ func createDefaultResourceFlavors(clusterInfo ClusterResourceInfo, gvks *kueueRe
 	return resourceFlavors
 }
 
+// hardwareProfileFlavor is a ResourceFlavor generated from a HardwareProfile using Node scheduling.
+type hardwareProfileFlavor struct {
+	Name    string
+	Profile *infrav1.HardwareProfile
+}
+
+// getHardwareProfileFlavors returns a flavor for each enabled HardwareProfile using Node
+// scheduling, sorted by name. Profiles without node selector select every node, so they cannot
+// be told apart from the default flavor and get no flavor.
+func getHardwareProfileFlavors(ctx context.Context, c client.Client) ([]hardwareProfileFlavor, error) {
+	profiles := &infrav1.HardwareProfileList{}
+	if err := c.List(ctx, profiles); err != nil {
+		return nil, fmt.Errorf("failed to list HardwareProfiles: %w", err)
+	}
+
+	flavors := make([]hardwareProfileFlavor, 0, len(profiles.Items))
+	for i := range profiles.Items {
+		profile := &profiles.Items[i]
+
+		if !profile.GetDeletionTimestamp().IsZero() || resources.GetAnnotation(profile, annotations.HardwareProfileDisabled) == "true" {
+			continue
+		}
+		scheduling := profile.Spec.SchedulingSpec
+		if scheduling == nil || scheduling.SchedulingType != infrav1.NodeScheduling || scheduling.Node == nil {
+			continue
+		}
+		if len(scheduling.Node.NodeSelector) == 0 {
+			continue
+		}
+
+		flavors = append(flavors, hardwareProfileFlavor{
+			Name:    hardwareProfileFlavorName(profile),
+			Profile: profile,
+		})
+	}
+
+	slices.SortFunc(flavors, func(a, b hardwareProfileFlavor) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return flavors, nil
+}
+
+// hardwareProfileFlavorName returns the name of the flavor generated from the HardwareProfile.
+// Namespaces cannot contain dots, so the name is unique across namespaces. Names exceeding the
+// maximum length are truncated and suffixed with a hash to keep them unique.
+func hardwareProfileFlavorName(profile *infrav1.HardwareProfile) string {
+	name := HardwareProfileFlavorPrefix + profile.Namespace + "." + profile.Name
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+
+	hash := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(hash[:])[:8]
+
+	return strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-len(suffix)], "-.") + suffix
+}
+
+// createHardwareProfileQueueResources generates the default ResourceFlavor, a ResourceFlavor for
+// each HardwareProfile using Node scheduling and the default ClusterQueue covering them in a
+// single resource group, as Kueue requires each resource to be covered by one group only.
+// The default flavor comes first, so that workloads without the node selector of a profile are
+// admitted to it. Workloads selecting the nodes of a profile do not fit the default flavor, which
+// has no node labels, and are admitted to the profile flavor. Profile flavors also carry the
+// taints tolerated by their profile, so that only tolerating workloads are admitted to them when
+// the default flavor is full. Each node is assigned to one flavor only, so that its allocatable
+// resources are not counted twice: the quota of a profile flavor is the allocatable resources of
+// the nodes assigned to it, and the default flavor gets those of the nodes no profile selects.
+// Returns the generated resources and the flavor of each HardwareProfile, for the status.
+func createHardwareProfileQueueResources(
+	ctx context.Context,
+	c client.Client,
+	clusterQueueName string,
+	gvks *kueueResourceGVKs,
+) ([]unstructured.Unstructured, []componentApi.KueueHardwareProfileFlavor, error) {
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return nil, nil, fmt.Errorf("failed to list cluster nodes: %w", err)
+	}
+
+	profileFlavors, err := getHardwareProfileFlavors(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coveredResources := getHardwareProfileCoveredResources(nodeList.Items, profileFlavors)
+	flavorNodes, defaultNodes := assignNodesToFlavors(nodeList.Items, profileFlavors)
+
+	result := make([]unstructured.Unstructured, 0, len(profileFlavors)+2)
+	result = append(result, createDefaultResourceFlavors(ClusterResourceInfo{}, gvks)...)
+
+	mapping := make([]componentApi.KueueHardwareProfileFlavor, 0, len(profileFlavors))
+	groupFlavors := make([]Flavors, 0, len(profileFlavors)+1)
+	groupFlavors = append(groupFlavors, Flavors{
+		Name:      DefaultFlavorName,
+		Resources: getNodesFlavorResources(defaultNodes, coveredResources),
+	})
+
+	for _, flavor := range profileFlavors {
+		resourceFlavor, err := createHardwareProfileResourceFlavor(flavor, gvks)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = append(result, *resourceFlavor)
+
+		groupFlavors = append(groupFlavors, Flavors{
+			Name:      flavor.Name,
+			Resources: getNodesFlavorResources(flavorNodes[flavor.Name], coveredResources),
+		})
+
+		mapping = append(mapping, componentApi.KueueHardwareProfileFlavor{
+			Namespace: flavor.Profile.Namespace,
+			Name:      flavor.Profile.Name,
+			Flavor:    flavor.Name,
+		})
+	}
+
+	// The ClusterQueue is managed by the operator, so that it follows the changes of the profiles.
+	clusterQueue := createClusterQueue(clusterQueueName, []any{createResourceGroup(groupFlavors)}, gvks)
+	result = append(result, *clusterQueue)
+
+	return result, mapping, nil
+}
+
+// getHardwareProfileCoveredResources returns, sorted, the resources covered by the default
+// ClusterQueue: cpu, memory, the GPUs found on the cluster nodes and the resources identified by
+// the HardwareProfiles.
+func getHardwareProfileCoveredResources(nodes []corev1.Node, profileFlavors []hardwareProfileFlavor) []string {
+	covered := map[string]bool{
+		corev1.ResourceCPU.String():    true,
+		corev1.ResourceMemory.String(): true,
+	}
+
+	for _, node := range nodes {
+		for resourceKey := range supportedGPUMap {
+			if _, ok := node.Status.Allocatable[corev1.ResourceName(resourceKey)]; ok {
+				covered[resourceKey] = true
+			}
+		}
+	}
+
+	for _, flavor := range profileFlavors {
+		for _, identifier := range flavor.Profile.Spec.Identifiers {
+			covered[identifier.Identifier] = true
+		}
+	}
+
+	return slices.Sorted(maps.Keys(covered))
+}
+
+// assignNodesToFlavors assigns each node to the first profile flavor whose node selector matches
+// its labels, in name order. It returns the nodes of each profile flavor,
+// by flavor name, and the nodes left to the default flavor.
+func assignNodesToFlavors(nodes []corev1.Node, profileFlavors []hardwareProfileFlavor) (map[string][]corev1.Node, []corev1.Node) {
+	selectors := make([]labels.Selector, 0, len(profileFlavors))
+	for _, flavor := range profileFlavors {
+		selectors = append(selectors, labels.SelectorFromSet(flavor.Profile.Spec.SchedulingSpec.Node.NodeSelector))
+	}
+
+	flavorNodes := make(map[string][]corev1.Node, len(profileFlavors))
+	var defaultNodes []corev1.Node
+
+	for _, node := range nodes {
+		idx := slices.IndexFunc(selectors, func(selector labels.Selector) bool {
+			return selector.Matches(labels.Set(node.Labels))
+		})
+		if idx < 0 {
+			defaultNodes = append(defaultNodes, node)
+			continue
+		}
+
+		name := profileFlavors[idx].Name
+		flavorNodes[name] = append(flavorNodes[name], node)
+	}
+
+	return flavorNodes, defaultNodes
+}
+
+// getNodesFlavorResources returns the allocatable quantity of each resource on the nodes, in the
+// order of the given resources.
+func getNodesFlavorResources(nodes []corev1.Node, resourceNames []string) []FlavorResource {
+	total := make(map[string]*resource.Quantity, len(resourceNames))
+	for _, name := range resourceNames {
+		total[name] = &resource.Quantity{}
+	}
+
+	for _, node := range nodes {
+		for _, name := range resourceNames {
+			if value, ok := node.Status.Allocatable[corev1.ResourceName(name)]; ok {
+				total[name].Add(value)
+			}
+		}
+	}
+
+	flavorResources := make([]FlavorResource, 0, len(resourceNames))
+	for _, name := range resourceNames {
+		flavorResources = append(flavorResources, FlavorResource{Name: name, Value: total[name].String()})
+	}
+
+	return flavorResources
+}
+
+// createHardwareProfileResourceFlavor generates the ResourceFlavor of a HardwareProfile. Unlike
+// the default flavors, it is fully managed by the operator so that it follows the changes of the
+// profile and is garbage collected when the profile is removed.
+func createHardwareProfileResourceFlavor(flavor hardwareProfileFlavor, gvks *kueueResourceGVKs) (*unstructured.Unstructured, error) {
+	node := flavor.Profile.Spec.SchedulingSpec.Node
+	spec := map[string]any{}
+
+	if len(node.NodeSelector) > 0 {
+		nodeLabels := make(map[string]any, len(node.NodeSelector))
+		for k, v := range node.NodeSelector {
+			nodeLabels[k] = v
+		}
+		spec["nodeLabels"] = nodeLabels
+	}
+
+	if len(node.Tolerations) > 0 {
+		tolerations := make([]any, 0, len(node.Tolerations))
+		for i := range node.Tolerations {
+			toleration, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&node.Tolerations[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert tolerations of HardwareProfile %s/%s: %w", flavor.Profile.Namespace, flavor.Profile.Name, err)
+			}
+			tolerations = append(tolerations, toleration)
+		}
+		spec["tolerations"] = tolerations
+	}
+
+	if taints := tolerationTaints(node.Tolerations); len(taints) > 0 {
+		nodeTaints := make([]any, 0, len(taints))
+		for i := range taints {
+			taint, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&taints[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert taints of HardwareProfile %s/%s: %w", flavor.Profile.Namespace, flavor.Profile.Name, err)
+			}
+			nodeTaints = append(nodeTaints, taint)
+		}
+		spec["nodeTaints"] = nodeTaints
+	}
+
+	resourceFlavor := &unstructured.Unstructured{}
+	resourceFlavor.Object = map[string]any{
+		"apiVersion": gvks.ResourceFlavor.GroupVersion().String(),
+		"kind":       gvks.ResourceFlavor.Kind,
+		"metadata": map[string]any{
+			"name": flavor.Name,
+		},
+		"spec": spec,
+	}
+
+	return resourceFlavor, nil
+}
+
+// tolerationTaints returns the taints matched by the tolerations of a profile, set as node taints
+// of its flavor so that Kueue only admits workloads tolerating them. Tolerations without key match
+// every taint and have no equivalent taint, and Kueue does not consider PreferNoSchedule taints.
+func tolerationTaints(tolerations []corev1.Toleration) []corev1.Taint {
+	var taints []corev1.Taint
+	for _, toleration := range tolerations {
+		if toleration.Key == "" || toleration.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		taint := corev1.Taint{Key: toleration.Key, Effect: toleration.Effect}
+		if taint.Effect == "" {
+			taint.Effect = corev1.TaintEffectNoSchedule
+		}
+		if toleration.Operator != corev1.TolerationOpExists {
+			taint.Value = toleration.Value
+		}
+		taints = append(taints, taint)
+	}
+
+	return taints
+}
+
 // ClusterResourceInfo contains information about a node's resources and GPU capabilities.
 type ClusterResourceInfo struct {
 	CPU     ResourceQuantity
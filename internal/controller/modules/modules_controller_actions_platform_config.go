@@ -15,7 +15,6 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	odhtype "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/env"
 )
 
 const (
@@ -158,10 +157,11 @@ func mergePlatformKeys(u *unstructured.Unstructured, platformVersion string, ext
 
 func buildCertManagerConfigParams() map[string]string {
 	bc := certmanager.DefaultBootstrapConfig()
+	issuerName, issuerKind := certmanager.PlatformIssuerRef()
 
 	params := map[string]string{
-		CertManagerIssuerRefNameKey:     bc.CAIssuerName,
-		CertManagerIssuerRefKindKey:     env.GetOrDefault(certmanager.EnvIssuerRefKind, certmanager.DefaultIssuerRefKind),
+		CertManagerIssuerRefNameKey:     issuerName,
+		CertManagerIssuerRefKindKey:     issuerKind,
 		CertManagerCASecretNameKey:      bc.CertName,
 		CertManagerCASecretNamespaceKey: bc.CertManagerNamespace,
 	}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	respredicates "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
@@ -32,11 +33,17 @@ import (
 type CertConfigmapGeneratorReconciler struct {
 	sharedClient client.Client
 	certClient   client.Client
+
+	// platformCABundle is the CA bundle ConfigMap of the PKI bootstrapped by the cloud manager.
+	platformCABundle types.NamespacedName
 }
 
 // NewWithManager sets up the controller with the Manager.
 func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
-	r := CertConfigmapGeneratorReconciler{}
+	pki := certmanager.DefaultBootstrapConfig()
+	r := CertConfigmapGeneratorReconciler{
+		platformCABundle: types.NamespacedName{Namespace: pki.CertManagerNamespace, Name: pki.CABundleName},
+	}
 
 	targetCache, err := cache.New(mgr.GetConfig(), cache.Options{
 		HTTPClient:                  mgr.GetHTTPClient(),
@@ -45,11 +52,19 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 		ReaderFailOnMissingInformer: true,
 		ByObject: map[client.Object]cache.ByObject{
 			&corev1.ConfigMap{}: {
-				// We don't need to cache all the configmaps, but only those designated to
-				// hold Trust CA Bundles, that can be discriminated using a label selector
-				// and a field selector (as the name is fixed).
-				Label: labels.Set{odhlabels.K8SCommon.PartOf: PartOf}.AsSelector(),
-				Field: fields.Set{"metadata.name": CAConfigMapName}.AsSelector(),
+				Namespaces: map[string]cache.Config{
+					// We don't need to cache all the configmaps, but only those designated to
+					// hold Trust CA Bundles, that can be discriminated using a label selector
+					// and a field selector (as the name is fixed).
+					cache.AllNamespaces: {
+						LabelSelector: labels.Set{odhlabels.K8SCommon.PartOf: PartOf}.AsSelector(),
+						FieldSelector: fields.Set{"metadata.name": CAConfigMapName}.AsSelector(),
+					},
+					// The cert-manager namespace also holds the platform CA bundle published by
+					// the cloud manager, which is added to the Trust CA Bundles. A selector cannot
+					// match both ConfigMaps, so all the configmaps of this namespace are cached.
+					r.platformCABundle.Namespace: {},
+				},
 			},
 		},
 		DefaultTransform: func(in any) (any, error) {
@@ -129,16 +144,13 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 		//
 		// Leveraging PartialObjectMetadata minimizes API server load and reduces network traffic
 		// by fetching only metadata instead of the full object.
+		//
+		// A change to the platform CA bundle is propagated to the Trust CA Bundles of all
+		// namespaces.
 		source.TypedKind[client.Object](
 			targetCache,
 			resources.GvkToPartial(gvk.ConfigMap),
-			handlers.Fn(func(_ context.Context, obj client.Object) []reconcile.Request {
-				return []reconcile.Request{{
-					NamespacedName: types.NamespacedName{
-						Name: obj.GetNamespace(),
-					},
-				}}
-			}),
+			configMapEventHandler(r.sharedClient, r.platformCABundle),
 		),
 	)
 
@@ -162,7 +174,7 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 }
 
 // Reconcile will generate new configmap, odh-trusted-ca-bundle, that includes cluster-wide
-// trusted-ca bundle and custom ca bundle in every new namespace created.
+// trusted-ca bundle, custom ca bundle and platform CA bundle in every new namespace created.
 func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *corev1.Namespace) (ctrl.Result, error) {
 	l := logf.FromContext(ctx)
 
@@ -212,7 +224,13 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 	default:
 		l.Info("Adding CA bundle configmap")
 
-		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, dsci.Spec.TrustedCABundle.CustomCABundle); err != nil {
+		platformCAData, err := getPlatformCABundle(ctx, r.certClient, r.platformCABundle)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		caData := joinCABundles(dsci.Spec.TrustedCABundle.CustomCABundle, platformCAData)
+		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, caData); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error adding configmap to namespace: %w", err)
 		}
 	}
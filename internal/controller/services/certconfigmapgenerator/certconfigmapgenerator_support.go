@@ -3,6 +3,7 @@ package certconfigmapgenerator
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -18,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -73,6 +76,35 @@ func CreateOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, n
 	return nil
 }
 
+// getPlatformCABundle returns the root CAs published by the cloud manager in the platform CA
+// bundle ConfigMap, or an empty string when the ConfigMap does not exist. During a root CA
+// rotation, the bundle holds both the previous and the new root CA.
+func getPlatformCABundle(ctx context.Context, cli client.Client, key types.NamespacedName) (string, error) {
+	cm := corev1.ConfigMap{}
+
+	err := cli.Get(ctx, key, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get platform CA bundle ConfigMap %s: %w", key, err)
+	}
+
+	return cm.Data[certmanager.CABundleKey], nil
+}
+
+// joinCABundles concatenates the non-empty PEM bundles, one per line.
+func joinCABundles(bundles ...string) string {
+	parts := make([]string, 0, len(bundles))
+	for _, b := range bundles {
+		if b = strings.TrimSpace(b); b != "" {
+			parts = append(parts, b)
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
+
 func DeleteOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, namespace string) error {
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -114,23 +146,44 @@ func ShouldInjectTrustedCABundle(obj client.Object) bool {
 //   - handler.EventHandler: Event handler that maps DSCInitialization events to namespace reconcile requests
 func dsciEventHandler(cli client.Client) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
-		requests := make([]reconcile.Request, 0)
+		return namespaceRequests(ctx, cli)
+	})
+}
 
-		lo := client.ListOptions{}
+// namespaceRequests returns a reconcile request for every namespace of the cluster.
+func namespaceRequests(ctx context.Context, cli client.Client) []reconcile.Request {
+	requests := make([]reconcile.Request, 0)
 
-		namespaces := corev1.NamespaceList{}
+	lo := client.ListOptions{}
 
-		if err := cli.List(ctx, &namespaces, &lo); err != nil {
-			return []reconcile.Request{}
-		}
+	namespaces := corev1.NamespaceList{}
 
-		for _, ns := range namespaces.Items {
-			requests = append(requests, reconcile.Request{
-				NamespacedName: resources.NamespacedNameFromObject(&ns),
-			})
-		}
+	if err := cli.List(ctx, &namespaces, &lo); err != nil {
+		return []reconcile.Request{}
+	}
+
+	for _, ns := range namespaces.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: resources.NamespacedNameFromObject(&ns),
+		})
+	}
+
+	return requests
+}
 
-		return requests
+// configMapEventHandler creates an event handler for the cached ConfigMap events. An event on the
+// odh-trusted-ca-bundle ConfigMap of a namespace enqueues the namespace, so that external
+// modifications are reverted, while an event on the platform CA bundle enqueues all namespaces.
+func configMapEventHandler(cli client.Client, platformCABundle types.NamespacedName) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		switch {
+		case resources.NamespacedNameFromObject(obj) == platformCABundle:
+			return namespaceRequests(ctx, cli)
+		case obj.GetName() == CAConfigMapName:
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+		default:
+			return nil
+		}
 	})
 }
 
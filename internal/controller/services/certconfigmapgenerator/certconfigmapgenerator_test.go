@@ -18,6 +18,7 @@ import (
 
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
@@ -150,6 +151,43 @@ func TestCertConfigmapGeneratorReconciler(t *testing.T) {
 		g.Consistently(getCABundlesConfigMap(ctx, env.Client(), ns3.Name)).Should(BeNil())
 	})
 
+	t.Run("TrustedCABundle ManagementState set to Managed, platform CA bundle published", func(t *testing.T) {
+		ctx := t.Context()
+		g := G(t)
+
+		pki := certmanager.DefaultBootstrapConfig()
+		err := env.Client().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: pki.CertManagerNamespace}})
+		if !errors.IsAlreadyExists(err) {
+			g.Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		platformCABundle := corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: pki.CABundleName, Namespace: pki.CertManagerNamespace},
+			Data:       map[string]string{certmanager.CABundleKey: "platform-ca\n"},
+		}
+		g.Expect(env.Client().Create(ctx, &platformCABundle)).To(Succeed())
+
+		g.Eventually(getCABundlesConfigMap(ctx, env.Client(), ns2.Name)).Should(
+			WithTransform(func(cm *corev1.ConfigMap) string {
+				if cm == nil {
+					return ""
+				}
+				return cm.Data[certconfigmapgenerator.CADataFieldName]
+			}, ContainSubstring("platform-ca")),
+		)
+
+		g.Expect(env.Client().Delete(ctx, &platformCABundle)).To(Succeed())
+
+		g.Eventually(getCABundlesConfigMap(ctx, env.Client(), ns2.Name)).Should(
+			WithTransform(func(cm *corev1.ConfigMap) string {
+				if cm == nil {
+					return ""
+				}
+				return cm.Data[certconfigmapgenerator.CADataFieldName]
+			}, Not(ContainSubstring("platform-ca"))),
+		)
+	})
+
 	t.Run("TrustedCABundle ManagementState set to Unmanaged", func(t *testing.T) {
 		ctx := t.Context()
 		g := G(t)
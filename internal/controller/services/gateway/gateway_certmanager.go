@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// GatewayCertificateName is the name of the cert-manager Certificate issued for the gateway
+// when the CertManager certificate type is used.
+const GatewayCertificateName = DefaultGatewayName
+
+var errCertManagerNotInstalled = errors.New("cert-manager Certificate CRD not found; cert-manager must be installed to use certificate type " +
+	string(infrav1.CertManager))
+
+// gatewayCertificateStatus is the readiness of the gateway cert-manager Certificate.
+type gatewayCertificateStatus struct {
+	ready    bool
+	message  string
+	notAfter *metav1.Time
+}
+
+// createCertManagerCertificate requests a certificate for the gateway hostnames from cert-manager.
+// cert-manager writes the signed certificate to secretName, which the Gateway listener references.
+func createCertManagerCertificate(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	name string,
+	certConfig infrav1.CertificateSpec,
+	secretName string,
+	hostnames []string,
+) error {
+	hasCRD, err := cluster.HasCRD(ctx, rr.Client, gvk.CertManagerCertificate)
+	if err != nil {
+		return fmt.Errorf("failed to check for cert-manager Certificate CRD: %w", err)
+	}
+	if !hasCRD {
+		return errCertManagerNotInstalled
+	}
+
+	cert, err := newGatewayCertificate(name, certConfig, secretName, hostnames)
+	if err != nil {
+		return err
+	}
+
+	return rr.AddResources(cert)
+}
+
+// newGatewayCertificate returns the cert-manager Certificate for the gateway hostnames. Without an
+// explicit issuer the platform issuer signs the certificate, as resolved for every component outside
+// of the cloud manager. The issuer kind alone does not select an issuer, so it requires the name.
+func newGatewayCertificate(name string, certConfig infrav1.CertificateSpec, secretName string, hostnames []string) (*unstructured.Unstructured, error) {
+	issuerName, issuerKind := certConfig.IssuerName, certConfig.IssuerKind
+	switch {
+	case issuerName == "" && issuerKind != "":
+		return nil, fmt.Errorf("issuerName must be set when issuerKind is set to %s", issuerKind)
+	case issuerName == "":
+		issuerName, issuerKind = certmanager.PlatformIssuerRef()
+	case issuerKind == "":
+		issuerKind = certmanager.DefaultIssuerRefKind
+	}
+
+	dnsNames := make([]any, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		dnsNames = append(dnsNames, hostname)
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk.CertManagerCertificate)
+	u.SetName(name)
+	u.SetNamespace(GatewayNamespace)
+	u.SetLabels(map[string]string{
+		labels.PlatformPartOf: ServiceName,
+	})
+	if err := unstructured.SetNestedMap(u.Object, map[string]any{
+		"secretName": secretName,
+		"dnsNames":   dnsNames,
+		"issuerRef": map[string]any{
+			"name":  issuerName,
+			"kind":  issuerKind,
+			"group": gvk.CertManagerClusterIssuer.Group,
+		},
+	}, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set spec on gateway Certificate: %w", err)
+	}
+
+	return u, nil
+}
+
+// getGatewayCertificateStatus reads the Ready condition and expiry time of a gateway Certificate.
+// A missing Certificate is reported as not ready, as it has not been created yet.
+func getGatewayCertificateStatus(ctx context.Context, cli client.Client, name string) (gatewayCertificateStatus, error) {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(gvk.CertManagerCertificate)
+
+	err := cli.Get(ctx, client.ObjectKey{Name: name, Namespace: GatewayNamespace}, cert)
+	switch {
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		return gatewayCertificateStatus{message: "certificate " + name + " not found"}, nil
+	case err != nil:
+		return gatewayCertificateStatus{}, fmt.Errorf("failed to get gateway Certificate %s: %w", name, err)
+	}
+
+	return parseCertificateStatus(cert)
+}
+
+func parseCertificateStatus(cert *unstructured.Unstructured) (gatewayCertificateStatus, error) {
+	result := gatewayCertificateStatus{}
+
+	if notAfter, found, _ := unstructured.NestedString(cert.Object, "status", "notAfter"); found && notAfter != "" {
+		t, err := time.Parse(time.RFC3339, notAfter)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse notAfter of gateway Certificate: %w", err)
+		}
+		result.notAfter = &metav1.Time{Time: t}
+	}
+
+	conds, _, _ := unstructured.NestedSlice(cert.Object, "status", "conditions")
+	for _, c := range conds {
+		cond, ok := c.(map[string]any)
+		if !ok || cond["type"] != "Ready" {
+			continue
+		}
+
+		result.ready = cond["status"] == string(metav1.ConditionTrue)
+		if msg, ok := cond["message"].(string); ok {
+			result.message = msg
+		}
+
+		return result, nil
+	}
+
+	result.message = "certificate " + cert.GetName() + " has not been issued yet"
+
+	return result, nil
+}
@@ -0,0 +1,191 @@
+//go:build !integration
+
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
+
+	. "github.com/onsi/gomega"
+)
+
+// TestNewGatewayCertificate tests the cert-manager Certificate rendered for the gateway.
+func TestNewGatewayCertificate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		certConfig   infrav1.CertificateSpec
+		expectedName string
+		expectedKind string
+	}{
+		{
+			name:         "defaults to the bootstrapped CA ClusterIssuer",
+			certConfig:   infrav1.CertificateSpec{Type: infrav1.CertManager},
+			expectedName: certmanager.DefaultBootstrapConfig().CAIssuerName,
+			expectedKind: certmanager.DefaultIssuerRefKind,
+		},
+		{
+			name: "uses the configured issuer",
+			certConfig: infrav1.CertificateSpec{
+				Type:       infrav1.CertManager,
+				IssuerName: "corporate-issuer",
+				IssuerKind: "Issuer",
+			},
+			expectedName: "corporate-issuer",
+			expectedKind: "Issuer",
+		},
+		{
+			name: "defaults the kind to ClusterIssuer when only the name is set",
+			certConfig: infrav1.CertificateSpec{
+				Type:       infrav1.CertManager,
+				IssuerName: "acme",
+			},
+			expectedName: "acme",
+			expectedKind: certmanager.DefaultIssuerRefKind,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cert, err := newGatewayCertificate(GatewayCertificateName, tc.certConfig, DefaultGatewayTLSSecretName, []string{testHostnameDefault})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(cert.GroupVersionKind()).To(Equal(gvk.CertManagerCertificate))
+			g.Expect(cert.GetName()).To(Equal(GatewayCertificateName))
+			g.Expect(cert.GetNamespace()).To(Equal(GatewayNamespace))
+
+			secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+			g.Expect(secretName).To(Equal(DefaultGatewayTLSSecretName))
+
+			dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+			g.Expect(dnsNames).To(ConsistOf(testHostnameDefault))
+
+			issuerName, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+			g.Expect(issuerName).To(Equal(tc.expectedName))
+
+			issuerKind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+			g.Expect(issuerKind).To(Equal(tc.expectedKind))
+		})
+	}
+}
+
+// TestNewGatewayCertificatePlatformIssuer tests that the default issuer follows the platform issuer
+// configured for components outside of the cloud manager.
+func TestNewGatewayCertificatePlatformIssuer(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Setenv(certmanager.EnvCAIssuerName, "vault-issuer")
+	t.Setenv(certmanager.EnvIssuerRefKind, "Issuer")
+
+	cert, err := newGatewayCertificate(GatewayCertificateName, infrav1.CertificateSpec{Type: infrav1.CertManager},
+		DefaultGatewayTLSSecretName, []string{testHostnameDefault})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	issuerName, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+	g.Expect(issuerName).To(Equal("vault-issuer"))
+
+	issuerKind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+	g.Expect(issuerKind).To(Equal("Issuer"))
+}
+
+// TestNewGatewayCertificateRequiresIssuerName tests that an issuer kind without a name is rejected
+// instead of being combined with the default issuer name.
+func TestNewGatewayCertificateRequiresIssuerName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	_, err := newGatewayCertificate(GatewayCertificateName, infrav1.CertificateSpec{Type: infrav1.CertManager, IssuerKind: "Issuer"},
+		DefaultGatewayTLSSecretName, []string{testHostnameDefault})
+	g.Expect(err).To(MatchError(ContainSubstring("issuerName must be set")))
+}
+
+// TestParseCertificateStatus tests reading readiness and expiry from a cert-manager Certificate.
+func TestParseCertificateStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		status          map[string]any
+		expectedReady   bool
+		expectedExpiry  string
+		expectedMessage string
+		expectError     bool
+	}{
+		{
+			name:            "not issued when status is empty",
+			status:          nil,
+			expectedReady:   false,
+			expectedMessage: "has not been issued yet",
+		},
+		{
+			name: "ready with expiry",
+			status: map[string]any{
+				"notAfter": "2027-01-02T03:04:05Z",
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "True", "message": "Certificate is up to date and has not expired"},
+				},
+			},
+			expectedReady:   true,
+			expectedExpiry:  "2027-01-02T03:04:05Z",
+			expectedMessage: "up to date",
+		},
+		{
+			name: "not ready while the issuer is pending",
+			status: map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Issuing", "status": "True"},
+					map[string]any{"type": "Ready", "status": "False", "message": "Issuing certificate as Secret does not exist"},
+				},
+			},
+			expectedReady:   false,
+			expectedMessage: "Secret does not exist",
+		},
+		{
+			name: "invalid expiry",
+			status: map[string]any{
+				"notAfter": "not-a-time",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cert := &unstructured.Unstructured{Object: map[string]any{}}
+			cert.SetGroupVersionKind(gvk.CertManagerCertificate)
+			if tc.status != nil {
+				cert.Object["status"] = tc.status
+			}
+
+			result, err := parseCertificateStatus(cert)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result.ready).To(Equal(tc.expectedReady))
+			g.Expect(result.message).To(ContainSubstring(tc.expectedMessage))
+
+			if tc.expectedExpiry == "" {
+				g.Expect(result.notAfter).To(BeNil())
+			} else {
+				g.Expect(result.notAfter).NotTo(BeNil())
+				g.Expect(result.notAfter.UTC().Format("2006-01-02T15:04:05Z")).To(Equal(tc.expectedExpiry))
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+const (
+	ClientCertListenerName    = "https-mtls"
+	ClientCertFilterName      = "data-science-mtls-filter"
+	ClientCertSubdomainSuffix = "-mtls"
+	// ClientCertMetadataNamespace is the Envoy dynamic metadata namespace set by the mTLS Lua filter
+	// for requests authenticated by a client certificate. ext_authz is skipped for these requests.
+	ClientCertMetadataNamespace = "opendatahub.client_cert"
+
+	envoyFilterClientCertTemplate = "resources/envoyfilter-mtls.tmpl.yaml"
+)
+
+// ClientCertInfo contains computed values for mTLS client-certificate authentication.
+type ClientCertInfo struct {
+	Hostname        string // Hostname accepting client certificates (empty if mTLS is disabled)
+	CAConfigMapName string // ConfigMap holding the CA bundle that verifies client certificates
+}
+
+// computeClientCertInfo computes the mTLS hostname from the gateway hostname. mTLS is only served in
+// LoadBalancer mode, as OpenShift Routes re-encrypt traffic and drop the client certificate.
+func computeClientCertInfo(gatewayConfig *serviceApi.GatewayConfig, hostname string) ClientCertInfo {
+	if gatewayConfig == nil ||
+		gatewayConfig.Spec.ClientCertAuth == nil ||
+		gatewayConfig.Spec.IngressMode != serviceApi.IngressModeLoadBalancer {
+		return ClientCertInfo{}
+	}
+
+	currentSubdomain := getCurrentSubdomain(gatewayConfig)
+
+	subdomain := gatewayConfig.Spec.ClientCertAuth.Subdomain
+	if subdomain == "" {
+		subdomain = currentSubdomain + ClientCertSubdomainSuffix
+	}
+
+	return ClientCertInfo{
+		Hostname:        strings.Replace(hostname, currentSubdomain+".", subdomain+".", 1),
+		CAConfigMapName: gatewayConfig.Spec.ClientCertAuth.ClientCAConfigMapName,
+	}
+}
+
+// newClientCertListener returns the Gateway listener that requires and verifies client certificates.
+func newClientCertListener(info ClientCertInfo, tlsConfig gwapiv1.GatewayTLSConfig, allowedRoutes *gwapiv1.AllowedRoutes) gwapiv1.Listener {
+	hostname := gwapiv1.Hostname(info.Hostname)
+
+	tlsConfig.FrontendValidation = &gwapiv1.FrontendTLSValidation{
+		CACertificateRefs: []gwapiv1.ObjectReference{
+			{
+				Group: "",
+				Kind:  "ConfigMap",
+				Name:  gwapiv1.ObjectName(info.CAConfigMapName),
+			},
+		},
+	}
+
+	return gwapiv1.Listener{
+		Name:          ClientCertListenerName,
+		Protocol:      gwapiv1.HTTPSProtocolType,
+		Port:          StandardHTTPSPort,
+		Hostname:      &hostname,
+		TLS:           &tlsConfig,
+		AllowedRoutes: allowedRoutes,
+	}
+}
+
+// renderClientCertMappings renders the identity mappings as a single-line Lua table literal consumed
+// by the mTLS EnvoyFilter. Groups are joined with commas, as forwarded in x-auth-request-groups.
+func renderClientCertMappings(mappings []serviceApi.ClientCertIdentityMapping) string {
+	entries := make([]string, 0, len(mappings))
+	for _, m := range mappings {
+		fields := make([]string, 0, 4)
+		if m.Subject != "" {
+			fields = append(fields, "subject = "+luaQuote(m.Subject))
+		}
+		if m.SAN != "" {
+			fields = append(fields, "san = "+luaQuote(m.SAN))
+		}
+		if m.User != "" {
+			fields = append(fields, "user = "+luaQuote(m.User))
+		}
+		if len(m.Groups) > 0 {
+			fields = append(fields, "groups = "+luaQuote(strings.Join(m.Groups, ",")))
+		}
+		entries = append(entries, "{ "+strings.Join(fields, ", ")+" }")
+	}
+
+	return "{ " + strings.Join(entries, ", ") + " }"
+}
+
+// luaQuote returns s as a double-quoted Lua string literal. Control characters are written as
+// decimal escapes so the literal always fits on a single line.
+func luaQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c == 0x7f:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
@@ -0,0 +1,144 @@
+//go:build !integration
+
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+
+	. "github.com/onsi/gomega"
+)
+
+const testClientCAConfigMap = "ci-client-ca"
+
+func newTestClientCertGatewayConfig(ingressMode serviceApi.IngressMode, subdomain string) *serviceApi.GatewayConfig {
+	return &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testGatewayName,
+		},
+		Spec: serviceApi.GatewayConfigSpec{
+			IngressMode: ingressMode,
+			ClientCertAuth: &serviceApi.ClientCertAuthConfig{
+				ClientCAConfigMapName: testClientCAConfigMap,
+				Subdomain:             subdomain,
+				IdentityMappings: []serviceApi.ClientCertIdentityMapping{
+					{Subject: "CN=ci-bot,O=Example", User: "ci-bot"},
+				},
+			},
+		},
+	}
+}
+
+// TestComputeClientCertInfo tests the mTLS hostname computation.
+func TestComputeClientCertInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		gatewayConfig    *serviceApi.GatewayConfig
+		expectedHostname string
+	}{
+		{
+			name:             "disabled when gatewayConfig is nil",
+			gatewayConfig:    nil,
+			expectedHostname: "",
+		},
+		{
+			name: "disabled when clientCertAuth is not set",
+			gatewayConfig: &serviceApi.GatewayConfig{
+				Spec: serviceApi.GatewayConfigSpec{IngressMode: serviceApi.IngressModeLoadBalancer},
+			},
+			expectedHostname: "",
+		},
+		{
+			name:             "disabled in OcpRoute mode",
+			gatewayConfig:    newTestClientCertGatewayConfig(serviceApi.IngressModeOcpRoute, ""),
+			expectedHostname: "",
+		},
+		{
+			name:             "defaults to the gateway subdomain suffixed with -mtls",
+			gatewayConfig:    newTestClientCertGatewayConfig(serviceApi.IngressModeLoadBalancer, ""),
+			expectedHostname: "rh-ai-mtls." + testDomain,
+		},
+		{
+			name:             "uses the configured subdomain",
+			gatewayConfig:    newTestClientCertGatewayConfig(serviceApi.IngressModeLoadBalancer, "machines"),
+			expectedHostname: "machines." + testDomain,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			info := computeClientCertInfo(tc.gatewayConfig, testHostnameDefault)
+			g.Expect(info.Hostname).To(Equal(tc.expectedHostname))
+			if tc.expectedHostname != "" {
+				g.Expect(info.CAConfigMapName).To(Equal(testClientCAConfigMap))
+			}
+		})
+	}
+}
+
+// TestNewClientCertListener tests the Gateway listener verifying client certificates.
+func TestNewClientCertListener(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mode := gwapiv1.TLSModeTerminate
+	tlsConfig := gwapiv1.GatewayTLSConfig{
+		Mode: &mode,
+		CertificateRefs: []gwapiv1.SecretObjectReference{
+			{Name: gwapiv1.ObjectName(DefaultGatewayTLSSecretName)},
+		},
+	}
+
+	listener := newClientCertListener(ClientCertInfo{
+		Hostname:        "rh-ai-mtls." + testDomain,
+		CAConfigMapName: testClientCAConfigMap,
+	}, tlsConfig, nil)
+
+	g.Expect(string(listener.Name)).To(Equal(ClientCertListenerName))
+	g.Expect(listener.Port).To(Equal(gwapiv1.PortNumber(StandardHTTPSPort)))
+	g.Expect(string(*listener.Hostname)).To(Equal("rh-ai-mtls." + testDomain))
+	g.Expect(listener.TLS.CertificateRefs).To(Equal(tlsConfig.CertificateRefs))
+	g.Expect(listener.TLS.FrontendValidation).NotTo(BeNil())
+	g.Expect(listener.TLS.FrontendValidation.CACertificateRefs).To(HaveLen(1))
+	g.Expect(string(listener.TLS.FrontendValidation.CACertificateRefs[0].Kind)).To(Equal("ConfigMap"))
+	g.Expect(string(listener.TLS.FrontendValidation.CACertificateRefs[0].Name)).To(Equal(testClientCAConfigMap))
+
+	// The shared TLS config of the main listener must not require client certificates
+	g.Expect(tlsConfig.FrontendValidation).To(BeNil())
+}
+
+// TestRenderClientCertMappings tests the Lua table rendered for the mTLS EnvoyFilter.
+func TestRenderClientCertMappings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	rendered := renderClientCertMappings([]serviceApi.ClientCertIdentityMapping{
+		{Subject: "CN=ci-bot,O=Example", User: "ci-bot"},
+		{SAN: "spiffe://example.com/pipelines", Groups: []string{"pipelines", "ci"}},
+	})
+
+	g.Expect(rendered).To(Equal(`{ { subject = "CN=ci-bot,O=Example", user = "ci-bot" }, ` +
+		`{ san = "spiffe://example.com/pipelines", groups = "pipelines,ci" } }`))
+	g.Expect(renderClientCertMappings(nil)).To(Equal("{  }"))
+}
+
+// TestLuaQuote tests escaping of values embedded in Lua code.
+func TestLuaQuote(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(luaQuote("CN=bot")).To(Equal(`"CN=bot"`))
+	g.Expect(luaQuote(`CN=a"b\c`)).To(Equal(`"CN=a\"b\\c"`))
+	g.Expect(luaQuote("line\nbreak")).To(Equal(`"line\010break"`))
+	g.Expect(luaQuote("ünïcode")).To(Equal(`"ünïcode"`))
+}
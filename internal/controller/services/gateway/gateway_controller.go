@@ -24,6 +24,7 @@ import (
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
@@ -57,6 +58,9 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		OwnsGVK(gvk.ClusterRoleBinding).
 		OwnsGVK(gvk.EnvoyFilter, reconciler.Dynamic(reconciler.CrdExists(gvk.EnvoyFilter))).
 		OwnsGVK(gvk.DestinationRule, reconciler.Dynamic(reconciler.CrdExists(gvk.DestinationRule))).
+		OwnsGVK(gvk.CertManagerCertificate,
+			reconciler.Dynamic(reconciler.CrdExists(gvk.CertManagerCertificate)),
+			reconciler.WithPredicates(resources.CertificateStatusChanged())).
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
@@ -77,7 +81,14 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		Watches(
 			&gwapiv1.HTTPRoute{},
 			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.GatewayConfigName)),
-			reconciler.WithPredicates(resources.HTTPRouteReferencesGateway(DefaultGatewayName, GatewayNamespace)),
+			// PlatformRoutes are attached to the main Gateway or to a gateway shard.
+			reconciler.WithPredicates(resources.HTTPRouteReferencesGateways(isPlatformGateway, GatewayNamespace)),
+		).
+		// Reconcile when modules publish, change or withdraw a PlatformRoute.
+		Watches(
+			&serviceApi.PlatformRoute{},
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.GatewayConfigName)),
+			reconciler.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
 		// Reconcile when Dashboard CR is created or deleted so dashboard redirect
 		// resources are deployed or cleaned up accordingly.
@@ -95,10 +106,12 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 			reconciler.WithPredicates(resources.APIServerTLSSecurityProfileChanged()),
 		).
 		WithAction(createGatewayInfrastructure).
+		WithAction(createGatewayShards).
 		WithAction(createKubeAuthProxyInfrastructure). //  include destinationrule
 		WithAction(createEnvoyFilter).
 		WithAction(createNetworkPolicy).
 		WithAction(createOCPRoutes).
+		WithAction(createPlatformRoutes).
 		WithAction(createDashboardRedirectsAction).
 		WithAction(template.NewAction(
 			template.WithDataFn(getTemplateData),
@@ -107,7 +120,10 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 			deploy.WithCache(),
 		)).
 		WithAction(syncGatewayConfigStatus).
+		WithAction(syncGatewayShardStatus).
 		WithAction(gc.NewAction()).
+		// Must run last: it requeues the reconciliation for the next session secret rotation.
+		WithAction(requeueAuthSecretRotation).
 		WithConditions(ReadyConditionType)
 
 	if _, err := gw.Build(ctx); err != nil {
@@ -29,6 +29,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -62,7 +63,7 @@ func createGatewayInfrastructure(ctx context.Context, rr *odhtypes.Reconciliatio
 
 	// Handle ingress mode changes by deleting Gateway if configuration doesn't match.
 	// This is necessary because SSA doesn't remove fields that are omitted from the desired object.
-	if err := reconcileGatewayForModeChange(ctx, rr, gatewayConfig.Spec.IngressMode); err != nil {
+	if err := reconcileGatewayForModeChange(ctx, rr, DefaultGatewayName, gatewayConfig.Spec.IngressMode); err != nil {
 		return fmt.Errorf("failed to reconcile Gateway for mode change: %w", err)
 	}
 
@@ -84,7 +85,9 @@ func createGatewayInfrastructure(ctx context.Context, rr *odhtypes.Reconciliatio
 	// Compute legacy hostname for LoadBalancer mode (needs second listener)
 	legacyInfo := computeLegacyRedirectInfo(gatewayConfig, hostname)
 
-	if err := createGateway(rr, certSecretName, hostname, legacyInfo.LegacyHostname, gatewayConfig.Spec.IngressMode); err != nil {
+	clientCert := computeClientCertInfo(gatewayConfig, hostname)
+
+	if err := createGateway(rr, certSecretName, hostname, legacyInfo.LegacyHostname, gatewayConfig.Spec.IngressMode, clientCert); err != nil {
 		return fmt.Errorf("failed to create Gateway: %w", err)
 	}
 
@@ -163,8 +166,17 @@ func createKubeAuthProxyInfrastructure(ctx context.Context, rr *odhtypes.Reconci
 		return fmt.Errorf("failed to get secret values: %w", err)
 	}
 
+	secrets, err := rotateAuthProxySecrets(ctx, rr, gatewayConfig, authMode, authProxySecrets{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		cookieSecret: cookieSecret,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rotate auth proxy secrets: %w", err)
+	}
+
 	// Create the secret dynamically first
-	if err := createSecret(ctx, rr, clientID, clientSecret, cookieSecret); err != nil {
+	if err := createSecret(ctx, rr, secrets); err != nil {
 		rr.Conditions.MarkFalse(
 			ReadyConditionType,
 			conditions.WithReason(status.NotReadyReason),
@@ -239,6 +251,21 @@ func createEnvoyFilter(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 		Path: envoyFilterTemplate,
 	})
 
+	if gatewayConfig.Spec.ClientCertAuth != nil {
+		hostname, err := GetFQDN(ctx, rr.Client, gatewayConfig)
+		if err != nil {
+			return fmt.Errorf("failed to resolve domain: %w", err)
+		}
+
+		if clientCert := computeClientCertInfo(gatewayConfig, hostname); clientCert.Hostname != "" {
+			l.V(1).Info("Creating mTLS EnvoyFilter for gateway", "hostname", clientCert.Hostname)
+			rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+				FS:   gatewayResources,
+				Path: envoyFilterClientCertTemplate,
+			})
+		}
+	}
+
 	return nil
 }
 
@@ -252,10 +279,7 @@ func createNetworkPolicy(ctx context.Context, rr *odhtypes.ReconciliationRequest
 
 	// Ingress is enabled by default (when NetworkPolicy is nil or Ingress is nil)
 	// If Ingress is specified, use the explicit Enabled value
-	ingressEnabled := true
-	if gatewayConfig.Spec.NetworkPolicy != nil && gatewayConfig.Spec.NetworkPolicy.Ingress != nil {
-		ingressEnabled = gatewayConfig.Spec.NetworkPolicy.Ingress.Enabled
-	}
+	ingressEnabled := isIngressPolicyEnabled(gatewayConfig.Spec.NetworkPolicy)
 
 	// Only skip NetworkPolicy creation if ingress is explicitly disabled
 	if !ingressEnabled {
@@ -307,6 +331,22 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 	// Compute legacy redirect info for template
 	legacyInfo := computeLegacyRedirectInfo(gatewayConfig, hostname)
 
+	// The main Gateway and every shard Gateway authenticate through kube-auth-proxy
+	shards := computeShardInfos(gatewayConfig, hostname)
+	authnGateways := []map[string]any{
+		{"GatewayName": DefaultGatewayName, "FilterName": AuthnFilterName, "ClientCert": true, "AuthProxyPort": GatewayHTTPSPort},
+	}
+	shardHostnames := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		authnGateways = append(authnGateways, map[string]any{
+			"GatewayName":   shard.GatewayName,
+			"FilterName":    shard.AuthnFilterName,
+			"ClientCert":    false,
+			"AuthProxyPort": ShardAuthProxyHTTPSPort,
+		})
+		shardHostnames = append(shardHostnames, shard.Hostname)
+	}
+
 	templateData := map[string]any{
 		"GatewayNamespace":         GatewayNamespace,
 		"GatewayName":              DefaultGatewayName,
@@ -315,18 +355,19 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		"KubeAuthProxyServiceName": KubeAuthProxyName,
 		"KubeAuthProxySecretsName": KubeAuthProxySecretsName,
 		"KubeAuthProxyTLSName":     KubeAuthProxyTLSName,
-		"OAuthCallbackRouteName":   OAuthCallbackRouteName,
+		"OAuthCallbackRoutes":      getOAuthCallbackRoutes(shards),
 		"KubeAuthProxyImage":       getKubeAuthProxyImage(),
-		"AuthProxyHTTPPort":        AuthProxyHTTPPort,
 		"AuthProxyMetricsPort":     AuthProxyMetricsPort,
+		"ShardAuthProxyHTTPSPort":  ShardAuthProxyHTTPSPort,
+		"AuthProxyContainers":      getAuthProxyContainers(hostname, len(shards) > 0),
 		"StandardHTTPSPort":        StandardHTTPSPort,
 		"GatewayHTTPSPort":         GatewayHTTPSPort,
 		"AuthProxyOAuth2Path":      AuthProxyOAuth2Path,
 		"AuthProxyCookieName":      AuthProxyCookieName,
 		"TLSCertsVolumeName":       TLSCertsVolumeName,
 		"TLSCertsMountPath":        TLSCertsMountPath,
-		"EnvoyFilter":              AuthnFilterName,
-		"RedirectURL":              fmt.Sprintf("https://%s%s", hostname, OAuthCallbackPath),
+		"AuthnGateways":            authnGateways,
+		"ShardHostnames":           shardHostnames,
 		"DestinationRuleName":      DestinationRuleName,
 		"CookieExpire":             cookieExpire,
 		"CookieRefresh":            cookieRefresh,
@@ -352,6 +393,15 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 	templateData["DashboardRedirectImage"] = getDashboardRedirectImage()
 	templateData["RedirectConfigHash"] = CalculateRedirectConfigHash(hostname)
 
+	// Add mTLS client certificate fields; ClientCertHostname is empty when mTLS is disabled
+	clientCert := computeClientCertInfo(gatewayConfig, hostname)
+	templateData["ClientCertHostname"] = clientCert.Hostname
+	templateData["ClientCertFilterName"] = ClientCertFilterName
+	templateData["ClientCertMetadataNamespace"] = ClientCertMetadataNamespace
+	if clientCert.Hostname != "" {
+		templateData["ClientCertMappings"] = renderClientCertMappings(gatewayConfig.Spec.ClientCertAuth.IdentityMappings)
+	}
+
 	// Add OIDC-specific fields only if OIDC config is present
 	if gatewayConfig.Spec.OIDC != nil {
 		templateData["OIDCIssuerURL"] = gatewayConfig.Spec.OIDC.IssuerURL
@@ -407,6 +457,25 @@ func syncGatewayConfigStatus(ctx context.Context, rr *odhtypes.ReconciliationReq
 	}
 	gatewayConfig.Status.Domain = domain
 
+	// cert-manager issues the certificate asynchronously; the Gateway cannot serve TLS until it is ready.
+	gatewayConfig.Status.CertificateExpiryTime = nil
+	if gatewayConfig.Spec.IngressMode != serviceApi.IngressModeOcpRoute && getCertificateType(gatewayConfig) == string(infrav1.CertManager) {
+		certStatus, err := getGatewayCertificateStatus(ctx, rr.Client, GatewayCertificateName)
+		if err != nil {
+			return err
+		}
+		gatewayConfig.Status.CertificateExpiryTime = certStatus.notAfter
+
+		if !certStatus.ready {
+			rr.Conditions.MarkFalse(
+				ReadyConditionType,
+				conditions.WithReason(status.NotReadyReason),
+				conditions.WithMessage("%s: %s", status.GatewayCertificateNotReadyMessage, certStatus.message),
+			)
+			return nil
+		}
+	}
+
 	gateway := &gwapiv1.Gateway{}
 	err = rr.Client.Get(ctx, types.NamespacedName{
 		Name:      DefaultGatewayName,
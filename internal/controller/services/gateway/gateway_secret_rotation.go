@@ -0,0 +1,195 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const (
+	// previousClientSecretKey holds the OAuth client secret in use before the last rotation. It is
+	// kept as an additional secret of the OAuthClient while proxy pods roll out.
+	previousClientSecretKey = "PREVIOUS_CLIENT_SECRET" //nolint:gosec // This is a secret key name, not a secret
+
+	// clientSecretGracePeriod leaves proxy pods running with the previous client secret ample
+	// time to be replaced. It only covers the proxy rollout, not the sessions: oauth2-proxy accepts
+	// a single cookie secret, so there is no cookie overlap to configure.
+	clientSecretGracePeriod = time.Hour
+)
+
+// authProxySecrets are the values stored in the kube-auth-proxy-creds secret.
+type authProxySecrets struct {
+	clientID             string
+	clientSecret         string
+	cookieSecret         string
+	previousClientSecret string
+	rotatedAt            time.Time
+}
+
+// rotateAuthProxySecrets applies the CookieConfig rotation schedule to the session secrets. When
+// the rotation period has elapsed a new cookie secret, and in OpenShift OAuth mode a new client
+// secret, is generated. oauth2-proxy only accepts a single cookie secret, so the overlap is provided
+// by the OAuth server instead: the previous client secret stays an additional secret of the
+// OAuthClient until the grace period ends, and browsers holding a session cookie signed with the
+// previous cookie secret are sent through the login flow again. The rotation time is stored on the
+// kube-auth-proxy-creds secret and reported in status.
+func rotateAuthProxySecrets(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayConfig *serviceApi.GatewayConfig,
+	authMode cluster.AuthenticationMode,
+	current authProxySecrets,
+) (authProxySecrets, error) {
+	l := logf.FromContext(ctx).WithName("rotateAuthProxySecrets")
+	now := time.Now()
+	cookie := gatewayConfig.Spec.Cookie
+
+	if cookie.RotationPeriod.Duration <= 0 {
+		// No rotation time is recorded while rotation is disabled, so that it is seeded when
+		// rotation is enabled.
+		gatewayConfig.Status.LastRotationTime = nil
+		return current, nil
+	}
+
+	existingSecret := &corev1.Secret{}
+	err := rr.Client.Get(ctx, types.NamespacedName{
+		Name:      KubeAuthProxySecretsName,
+		Namespace: GatewayNamespace,
+	}, existingSecret)
+	if err != nil {
+		if !k8serr.IsNotFound(err) {
+			return current, fmt.Errorf("failed to get auth proxy secret %s/%s: %w", GatewayNamespace, KubeAuthProxySecretsName, err)
+		}
+
+		// Freshly generated secrets count as rotated now.
+		current.rotatedAt = now
+		gatewayConfig.Status.LastRotationTime = &metav1.Time{Time: now}
+		return current, nil
+	}
+
+	rotatedAt, recorded := getLastRotationTime(existingSecret)
+
+	switch {
+	case !recorded:
+		// Rotation was just enabled: seed the rotation time so that the first rotation happens one
+		// full period later.
+		current.rotatedAt = now
+	case isRotationDue(cookie, rotatedAt, now):
+		l.Info("rotating auth proxy session secrets", "lastRotation", rotatedAt)
+
+		cookieSecretGen, err := cluster.NewSecret("cookie-secret", "random", 32)
+		if err != nil {
+			return current, fmt.Errorf("failed to generate cookie secret: %w", err)
+		}
+		current.cookieSecret = cookieSecretGen.Value
+
+		// In OIDC mode the client secret is owned by the identity provider and cannot be rotated here.
+		if authMode == cluster.AuthModeIntegratedOAuth {
+			current.previousClientSecret = current.clientSecret
+			clientSecretGen, err := cluster.NewSecret("client-secret", "random", 24)
+			if err != nil {
+				return current, fmt.Errorf("failed to generate client secret: %w", err)
+			}
+			current.clientSecret = clientSecretGen.Value
+		}
+
+		current.rotatedAt = now
+	default:
+		current.rotatedAt = rotatedAt
+		if isWithinGracePeriod(rotatedAt, now) {
+			current.previousClientSecret = string(existingSecret.Data[previousClientSecretKey])
+		}
+	}
+
+	gatewayConfig.Status.LastRotationTime = &metav1.Time{Time: current.rotatedAt}
+
+	return current, nil
+}
+
+// requeueAuthSecretRotation schedules the next reconciliation at the next rotation deadline, either
+// the end of the grace period of the previous client secret or the next rotation.
+func requeueAuthSecretRotation(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gatewayConfig, err := validateGatewayConfig(rr)
+	if err != nil {
+		return err
+	}
+
+	if gatewayConfig.Status.LastRotationTime == nil {
+		return nil
+	}
+
+	if next := nextRotationCheck(gatewayConfig.Spec.Cookie, gatewayConfig.Status.LastRotationTime.Time, time.Now()); next > 0 {
+		return odherrors.NewRequeueAfterError(next)
+	}
+
+	return nil
+}
+
+// getLastRotationTime returns the rotation time recorded on the secret. It returns false for
+// secrets created before rotation was supported, or with an unreadable annotation.
+func getLastRotationTime(secret *corev1.Secret) (time.Time, bool) {
+	value, ok := secret.Annotations[annotations.SecretRotatedAt]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func isRotationDue(cookie serviceApi.CookieConfig, lastRotation time.Time, now time.Time) bool {
+	period := cookie.RotationPeriod.Duration
+	return period > 0 && !now.Before(lastRotation.Add(period))
+}
+
+func isWithinGracePeriod(lastRotation time.Time, now time.Time) bool {
+	return now.Before(lastRotation.Add(clientSecretGracePeriod))
+}
+
+// nextRotationCheck returns how long to wait until the grace period of the previous secret ends
+// or the next rotation is due. It returns 0 when rotation is disabled.
+func nextRotationCheck(cookie serviceApi.CookieConfig, lastRotation time.Time, now time.Time) time.Duration {
+	if cookie.RotationPeriod.Duration <= 0 {
+		return 0
+	}
+
+	next := lastRotation.Add(cookie.RotationPeriod.Duration)
+	if graceEnd := lastRotation.Add(clientSecretGracePeriod); graceEnd.After(now) && graceEnd.Before(next) {
+		next = graceEnd
+	}
+
+	if wait := next.Sub(now); wait > 0 {
+		return wait
+	}
+	return time.Second
+}
@@ -0,0 +1,251 @@
+//go:build !integration
+
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestCookieConfig(rotation time.Duration) serviceApi.CookieConfig {
+	return serviceApi.CookieConfig{
+		Expire:         metav1.Duration{Duration: 24 * time.Hour},
+		Refresh:        metav1.Duration{Duration: time.Hour},
+		RotationPeriod: metav1.Duration{Duration: rotation},
+	}
+}
+
+// TestIsRotationDue tests when the session secrets must be rotated.
+func TestIsRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	period := 90 * 24 * time.Hour
+
+	testCases := []struct {
+		name         string
+		cookie       serviceApi.CookieConfig
+		lastRotation time.Time
+		expected     bool
+	}{
+		{
+			name:         "disabled when rotation period is unset",
+			cookie:       newTestCookieConfig(0),
+			lastRotation: now.Add(-365 * 24 * time.Hour),
+			expected:     false,
+		},
+		{
+			name:         "not due before the rotation period elapsed",
+			cookie:       newTestCookieConfig(period),
+			lastRotation: now.Add(-period + time.Hour),
+			expected:     false,
+		},
+		{
+			name:         "due once the rotation period elapsed",
+			cookie:       newTestCookieConfig(period),
+			lastRotation: now.Add(-period),
+			expected:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(isRotationDue(tc.cookie, tc.lastRotation, now)).To(Equal(tc.expected))
+		})
+	}
+}
+
+// TestNextRotationCheck tests when the controller requeues for the next rotation event.
+func TestNextRotationCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	period := 90 * 24 * time.Hour
+	grace := clientSecretGracePeriod
+
+	testCases := []struct {
+		name         string
+		cookie       serviceApi.CookieConfig
+		lastRotation time.Time
+		expected     time.Duration
+	}{
+		{
+			name:         "no requeue when rotation is disabled",
+			cookie:       newTestCookieConfig(0),
+			lastRotation: now,
+			expected:     0,
+		},
+		{
+			name:         "requeues at the end of the grace period",
+			cookie:       newTestCookieConfig(period),
+			lastRotation: now.Add(-grace / 2),
+			expected:     grace - grace/2,
+		},
+		{
+			name:         "requeues at the next rotation after the grace period",
+			cookie:       newTestCookieConfig(period),
+			lastRotation: now.Add(-2 * grace),
+			expected:     period - 2*grace,
+		},
+		{
+			name:         "requeues shortly when the rotation is overdue",
+			cookie:       newTestCookieConfig(period),
+			lastRotation: now.Add(-2 * period),
+			expected:     time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(nextRotationCheck(tc.cookie, tc.lastRotation, now)).To(Equal(tc.expected))
+		})
+	}
+}
+
+// TestGetLastRotationTime tests reading the rotation time from the auth proxy secret.
+func TestGetLastRotationTime(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rotated := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+	_, recorded := getLastRotationTime(secret)
+	g.Expect(recorded).To(BeFalse(), "should not fall back to the creation time")
+
+	secret.Annotations = map[string]string{annotations.SecretRotatedAt: rotated.Format(time.RFC3339)}
+	rotatedAt, recorded := getLastRotationTime(secret)
+	g.Expect(recorded).To(BeTrue())
+	g.Expect(rotatedAt).To(Equal(rotated), "should use the rotation annotation")
+
+	secret.Annotations[annotations.SecretRotatedAt] = "invalid"
+	_, recorded = getLastRotationTime(secret)
+	g.Expect(recorded).To(BeFalse(), "should ignore an invalid annotation")
+}
+
+// TestRotateAuthProxySecrets tests when the session secrets are rotated and the rotation time recorded.
+func TestRotateAuthProxySecrets(t *testing.T) {
+	t.Parallel()
+
+	period := 90 * 24 * time.Hour
+	current := authProxySecrets{
+		clientID:     testAuthClientID,
+		clientSecret: testAuthClientSecret,
+		cookieSecret: testAuthCookieSecret,
+	}
+
+	newExistingSecret := func(annotationValues map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              KubeAuthProxySecretsName,
+				Namespace:         GatewayNamespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * period)),
+				Annotations:       annotationValues,
+			},
+			Data: map[string][]byte{
+				previousClientSecretKey: []byte("previous-client-secret"),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		rotation        time.Duration
+		existing        *corev1.Secret
+		expectRotated   bool
+		expectStatusSet bool
+		expectPrevious  string
+	}{
+		{
+			name:     "records nothing while rotation is disabled",
+			rotation: 0,
+			existing: newExistingSecret(map[string]string{
+				annotations.SecretRotatedAt: time.Now().Add(-2 * period).Format(time.RFC3339),
+			}),
+		},
+		{
+			name:            "seeds the rotation time of existing secrets when rotation is enabled",
+			rotation:        period,
+			existing:        newExistingSecret(nil),
+			expectStatusSet: true,
+		},
+		{
+			name:     "rotates once the rotation period elapsed",
+			rotation: period,
+			existing: newExistingSecret(map[string]string{
+				annotations.SecretRotatedAt: time.Now().Add(-period - time.Hour).Format(time.RFC3339),
+			}),
+			expectRotated:   true,
+			expectStatusSet: true,
+			expectPrevious:  testAuthClientSecret,
+		},
+		{
+			name:     "keeps the previous client secret during the grace period",
+			rotation: period,
+			existing: newExistingSecret(map[string]string{
+				annotations.SecretRotatedAt: time.Now().Add(-time.Minute).Format(time.RFC3339),
+			}),
+			expectStatusSet: true,
+			expectPrevious:  "previous-client-secret",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(tc.existing))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			gatewayConfig := &serviceApi.GatewayConfig{
+				Spec: serviceApi.GatewayConfigSpec{Cookie: newTestCookieConfig(tc.rotation)},
+			}
+			rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: gatewayConfig}
+
+			secrets, err := rotateAuthProxySecrets(t.Context(), rr, gatewayConfig, cluster.AuthModeIntegratedOAuth, current)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expectRotated {
+				g.Expect(secrets.cookieSecret).NotTo(Equal(testAuthCookieSecret))
+				g.Expect(secrets.clientSecret).NotTo(Equal(testAuthClientSecret))
+			} else {
+				g.Expect(secrets.cookieSecret).To(Equal(testAuthCookieSecret))
+				g.Expect(secrets.clientSecret).To(Equal(testAuthClientSecret))
+			}
+			g.Expect(secrets.previousClientSecret).To(Equal(tc.expectPrevious))
+
+			if tc.expectStatusSet {
+				g.Expect(gatewayConfig.Status.LastRotationTime).NotTo(BeNil())
+				g.Expect(secrets.rotatedAt).To(BeTemporally("~", gatewayConfig.Status.LastRotationTime.Time))
+				g.Expect(time.Since(secrets.rotatedAt)).To(BeNumerically("<", period), "first rotation must be a full period away")
+			} else {
+				g.Expect(gatewayConfig.Status.LastRotationTime).To(BeNil())
+				g.Expect(secrets.rotatedAt.IsZero()).To(BeTrue())
+			}
+		})
+	}
+}
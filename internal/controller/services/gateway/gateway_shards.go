@@ -0,0 +1,506 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// GatewayStatusPort is the Istio gateway readiness port probed by the kubelet.
+	GatewayStatusPort = 15021
+	// GatewayMetricsPort is the Istio gateway Prometheus metrics port.
+	GatewayMetricsPort = 15090
+)
+
+// ShardInfo contains computed values for a gateway shard.
+type ShardInfo struct {
+	Name                 string
+	GatewayName          string // Name of the shard Gateway and of its OCP Route
+	Hostname             string // Hostname served by the shard Gateway
+	IngressMode          serviceApi.IngressMode
+	ServiceName          string // Name of the Service created for the shard Gateway
+	ServiceTLSSecretName string // Service-CA serving certificate of the shard Gateway service in OcpRoute mode
+	InfraConfigMapName   string // ClusterIP service configuration of the shard Gateway in OcpRoute mode
+	AuthnFilterName      string // Name of the ext_authz EnvoyFilter selecting the shard Gateway pods
+	Shard                *serviceApi.GatewayShard
+}
+
+// computeShardInfos computes the shard hostnames from the main gateway hostname, replacing the
+// main subdomain with the shard subdomain.
+func computeShardInfos(gatewayConfig *serviceApi.GatewayConfig, hostname string) []ShardInfo {
+	if gatewayConfig == nil || len(gatewayConfig.Spec.Shards) == 0 {
+		return nil
+	}
+
+	currentSubdomain := getCurrentSubdomain(gatewayConfig)
+
+	infos := make([]ShardInfo, 0, len(gatewayConfig.Spec.Shards))
+	for i := range gatewayConfig.Spec.Shards {
+		shard := &gatewayConfig.Spec.Shards[i]
+
+		ingressMode := shard.IngressMode
+		if ingressMode == "" {
+			ingressMode = gatewayConfig.Spec.IngressMode
+		}
+
+		gatewayName := shardGatewayName(shard.Name)
+
+		infos = append(infos, ShardInfo{
+			Name:                 shard.Name,
+			GatewayName:          gatewayName,
+			Hostname:             strings.Replace(hostname, currentSubdomain+".", shard.Subdomain+".", 1),
+			IngressMode:          ingressMode,
+			ServiceName:          gatewayName + "-" + GatewayClassName,
+			ServiceTLSSecretName: gatewayName + "-service-tls",
+			InfraConfigMapName:   gatewayName + "-config",
+			AuthnFilterName:      AuthnFilterName + "-" + shard.Name,
+			Shard:                shard,
+		})
+	}
+
+	return infos
+}
+
+// shardCertificateConfig returns the certificate of a shard, defaulting to the certificate type of
+// the main gateway. A secret name set on the main gateway certificate is not inherited, as it holds
+// a certificate for the main hostname.
+func shardCertificateConfig(gatewayConfig *serviceApi.GatewayConfig, shard *serviceApi.GatewayShard) infrav1.CertificateSpec {
+	if shard.Certificate != nil {
+		return *shard.Certificate
+	}
+
+	var certConfig infrav1.CertificateSpec
+	if gatewayConfig.Spec.Certificate != nil {
+		certConfig = *gatewayConfig.Spec.Certificate
+		certConfig.SecretName = ""
+	}
+
+	return certConfig
+}
+
+// createGatewayShards deploys a Gateway per shard, exposed through its own OCP Route or LoadBalancer
+// service, with its own certificate and NetworkPolicy. Requests to shards are authenticated by the
+// shared kube-auth-proxy; the ext_authz EnvoyFilter of each shard is rendered with the main one.
+//
+// Resources of removed shards are owned by the GatewayConfig and cleaned up by the GC action.
+func createGatewayShards(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	l := logf.FromContext(ctx).WithName("createGatewayShards")
+
+	gatewayConfig, err := validateGatewayConfig(rr)
+	if err != nil {
+		return err
+	}
+
+	if len(gatewayConfig.Spec.Shards) == 0 {
+		return nil
+	}
+
+	if err := validateShardSubdomains(gatewayConfig); err != nil {
+		return err
+	}
+
+	hostname, err := GetFQDN(ctx, rr.Client, gatewayConfig)
+	if err != nil {
+		return fmt.Errorf("failed to resolve domain: %w", err)
+	}
+
+	for _, info := range computeShardInfos(gatewayConfig, hostname) {
+		l.V(1).Info("Creating gateway shard", "shard", info.Name, "hostname", info.Hostname, "ingressMode", info.IngressMode)
+
+		if err := reconcileGatewayForModeChange(ctx, rr, info.GatewayName, info.IngressMode); err != nil {
+			return fmt.Errorf("failed to reconcile Gateway of shard %s for mode change: %w", info.Name, err)
+		}
+
+		certSecretName := info.ServiceTLSSecretName
+		if info.IngressMode != serviceApi.IngressModeOcpRoute {
+			certSecretName, err = provisionCertificate(ctx, rr, gatewayConfig,
+				shardCertificateConfig(gatewayConfig, info.Shard),
+				info.GatewayName,
+				fmt.Sprintf("%s-%s-tls", gatewayConfig.Name, info.Name),
+				[]string{info.Hostname})
+			if err != nil {
+				return fmt.Errorf("failed to handle certificates of shard %s: %w", info.Name, err)
+			}
+		}
+
+		gateway := newShardGateway(info, certSecretName)
+
+		if info.IngressMode == serviceApi.IngressModeOcpRoute {
+			if err := configureClusterIPInfrastructure(rr, gateway, info.InfraConfigMapName, info.ServiceTLSSecretName); err != nil {
+				return fmt.Errorf("failed to configure ClusterIP infrastructure of shard %s: %w", info.Name, err)
+			}
+			if err := rr.AddResources(newShardRoute(info)); err != nil {
+				return fmt.Errorf("failed to add Route of shard %s: %w", info.Name, err)
+			}
+		}
+
+		if err := rr.AddResources(gateway); err != nil {
+			return fmt.Errorf("failed to add Gateway of shard %s: %w", info.Name, err)
+		}
+
+		if isIngressPolicyEnabled(info.Shard.NetworkPolicy) {
+			if err := rr.AddResources(newShardNetworkPolicy(info)); err != nil {
+				return fmt.Errorf("failed to add NetworkPolicy of shard %s: %w", info.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateShardSubdomains checks that no shard serves the hostname of the main Gateway or of another
+// shard. Uniqueness across shards is also enforced by the CRD, but the main subdomain may be defaulted.
+func validateShardSubdomains(gatewayConfig *serviceApi.GatewayConfig) error {
+	currentSubdomain := getCurrentSubdomain(gatewayConfig)
+
+	seen := make(map[string]string, len(gatewayConfig.Spec.Shards))
+	for _, shard := range gatewayConfig.Spec.Shards {
+		if shard.Subdomain == currentSubdomain {
+			return fmt.Errorf("subdomain %q of shard %s is the subdomain of the main gateway", shard.Subdomain, shard.Name)
+		}
+		if other, ok := seen[shard.Subdomain]; ok {
+			return fmt.Errorf("subdomain %q of shard %s is already used by shard %s", shard.Subdomain, shard.Name, other)
+		}
+		seen[shard.Subdomain] = shard.Name
+	}
+
+	return nil
+}
+
+// shardGatewayName returns the name of the Gateway of a shard.
+func shardGatewayName(shard string) string {
+	return DefaultGatewayName + "-" + shard
+}
+
+// isPlatformGateway reports whether name is the main Gateway or follows the naming of shard Gateways.
+// It matches by name only as it runs in event predicates; HTTPRoutes of an unrelated Gateway sharing
+// the prefix only trigger a spurious reconcile.
+func isPlatformGateway(name string) bool {
+	return name == DefaultGatewayName || strings.HasPrefix(name, DefaultGatewayName+"-")
+}
+
+// getRedirectURL returns the absolute OAuth callback URL of the kube-auth-proxy container serving
+// the main gateway, so that logins on the legacy and client certificate hostnames complete on the
+// registered callback.
+func getRedirectURL(hostname string) string {
+	return fmt.Sprintf("https://%s%s", hostname, OAuthCallbackPath)
+}
+
+// getAuthProxyContainers returns the kube-auth-proxy containers. oauth2-proxy takes a single
+// callback URL, so with shards a second container, sharing the pod, secrets and serving certificate,
+// serves the shard gateways with a relative callback: each shard hostname then completes the login
+// flow and receives its session cookie on its own hostname.
+func getAuthProxyContainers(hostname string, hasShards bool) []map[string]any {
+	containers := []map[string]any{
+		{
+			"Name":        KubeAuthProxyName,
+			"PortSuffix":  "",
+			"HTTPPort":    AuthProxyHTTPPort,
+			"HTTPSPort":   GatewayHTTPSPort,
+			"MetricsPort": AuthProxyMetricsPort,
+			"RedirectURL": getRedirectURL(hostname),
+		},
+	}
+	if hasShards {
+		containers = append(containers, map[string]any{
+			"Name":        KubeAuthProxyName + "-shards",
+			"PortSuffix":  "-shards",
+			"HTTPPort":    ShardAuthProxyHTTPPort,
+			"HTTPSPort":   ShardAuthProxyHTTPSPort,
+			"MetricsPort": ShardAuthProxyMetricsPort,
+			"RedirectURL": OAuthCallbackPath,
+		})
+	}
+	return containers
+}
+
+// getOAuthCallbackRoutes returns the HTTPRoutes sending the OAuth endpoints of each gateway to the
+// kube-auth-proxy container serving it.
+func getOAuthCallbackRoutes(shards []ShardInfo) []map[string]any {
+	routes := []map[string]any{
+		{"Name": OAuthCallbackRouteName, "GatewayNames": []string{DefaultGatewayName}, "Port": GatewayHTTPSPort},
+	}
+	if len(shards) > 0 {
+		gatewayNames := make([]string, 0, len(shards))
+		for _, shard := range shards {
+			gatewayNames = append(gatewayNames, shard.GatewayName)
+		}
+		routes = append(routes, map[string]any{
+			"Name":         OAuthCallbackRouteName + "-shards",
+			"GatewayNames": gatewayNames,
+			"Port":         ShardAuthProxyHTTPSPort,
+		})
+	}
+	return routes
+}
+
+// isIngressPolicyEnabled reports whether NetworkPolicy ingress rules apply; they do unless explicitly disabled.
+func isIngressPolicyEnabled(networkPolicy *serviceApi.NetworkPolicyConfig) bool {
+	if networkPolicy != nil && networkPolicy.Ingress != nil {
+		return networkPolicy.Ingress.Enabled
+	}
+	return true
+}
+
+// newShardGateway returns the Gateway of a shard. Like the main Gateway, it only accepts routes from
+// the gateway and application namespaces.
+func newShardGateway(info ShardInfo, certSecretName string) *gwapiv1.Gateway {
+	httpsMode := gwapiv1.TLSModeTerminate
+	allowedNamespaces := gwapiv1.NamespacesFromSelector
+
+	listener := gwapiv1.Listener{
+		Name:     "https",
+		Protocol: gwapiv1.HTTPSProtocolType,
+		Port:     StandardHTTPSPort,
+		TLS: &gwapiv1.GatewayTLSConfig{
+			Mode: &httpsMode,
+			CertificateRefs: []gwapiv1.SecretObjectReference{
+				{
+					Name: gwapiv1.ObjectName(certSecretName),
+				},
+			},
+		},
+		AllowedRoutes: &gwapiv1.AllowedRoutes{
+			Namespaces: &gwapiv1.RouteNamespaces{
+				From: &allowedNamespaces,
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "kubernetes.io/metadata.name",
+							Operator: metav1.LabelSelectorOpIn,
+							Values: []string{
+								GatewayNamespace,
+								cluster.GetApplicationNamespace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if info.IngressMode != serviceApi.IngressModeOcpRoute {
+		hostname := gwapiv1.Hostname(info.Hostname)
+		listener.Hostname = &hostname
+	}
+
+	return &gwapiv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      info.GatewayName,
+			Namespace: GatewayNamespace,
+			Labels: map[string]string{
+				IstioRevisionLabel:           IstioRevisionValue,
+				serviceApi.GatewayShardLabel: info.Name,
+			},
+		},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: GatewayClassName,
+			Listeners:        []gwapiv1.Listener{listener},
+		},
+	}
+}
+
+// newShardRoute returns the OCP Route exposing a shard Gateway in OcpRoute mode.
+func newShardRoute(info ShardInfo) *routev1.Route {
+	weight := int32(100)
+
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      info.GatewayName,
+			Namespace: GatewayNamespace,
+			Labels: map[string]string{
+				labels.K8SCommon.PartOf:      PartOfGatewayConfig,
+				serviceApi.GatewayShardLabel: info.Name,
+			},
+			Annotations: map[string]string{
+				"router.openshift.io/service-ca-certificate": "true",
+			},
+		},
+		Spec: routev1.RouteSpec{
+			Host: info.Hostname,
+			To: routev1.RouteTargetReference{
+				Kind:   "Service",
+				Name:   info.ServiceName,
+				Weight: &weight,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromInt32(StandardHTTPSPort),
+			},
+			TLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationReencrypt,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+}
+
+// newShardNetworkPolicy returns the NetworkPolicy of the shard Gateway pods. In OcpRoute mode HTTPS
+// traffic is only accepted from the OpenShift router, so the shard cannot be reached from other
+// tenants inside the cluster.
+func newShardNetworkPolicy(info ShardInfo) *networkingv1.NetworkPolicy {
+	tcp := corev1.ProtocolTCP
+	httpsPort := intstr.FromInt32(StandardHTTPSPort)
+	statusPort := intstr.FromInt32(GatewayStatusPort)
+	metricsPort := intstr.FromInt32(GatewayMetricsPort)
+
+	httpsRule := networkingv1.NetworkPolicyIngressRule{
+		Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &httpsPort}},
+	}
+	if info.IngressMode == serviceApi.IngressModeOcpRoute {
+		httpsRule.From = []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"policy-group.network.openshift.io/ingress": "",
+					},
+				},
+			},
+		}
+	}
+
+	monitoringPeers := make([]networkingv1.NetworkPolicyPeer, 0, 2)
+	for _, ns := range []string{"openshift-monitoring", "openshift-user-workload-monitoring"} {
+		monitoringPeers = append(monitoringPeers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": ns,
+				},
+			},
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      info.GatewayName,
+			Namespace: GatewayNamespace,
+			Labels: map[string]string{
+				labels.PlatformPartOf:        PartOfGatewayConfig,
+				serviceApi.GatewayShardLabel: info.Name,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					labels.GatewayAPI.GatewayName: info.GatewayName,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				httpsRule,
+				// The kubelet probes the readiness port from the node, which NetworkPolicies do not restrict
+				// on OVN-Kubernetes; the rule keeps the port reachable for other CNIs.
+				{
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &statusPort}},
+				},
+				{
+					From:  monitoringPeers,
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &metricsPort}},
+				},
+			},
+		},
+	}
+}
+
+// syncGatewayShardStatus reports the domain and readiness of each shard. A shard that is not ready
+// does not affect the readiness of the main Gateway.
+func syncGatewayShardStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gatewayConfig, err := validateGatewayConfig(rr)
+	if err != nil {
+		return err
+	}
+
+	gatewayConfig.Status.Shards = nil
+	if len(gatewayConfig.Spec.Shards) == 0 {
+		return nil
+	}
+
+	hostname, err := GetFQDN(ctx, rr.Client, gatewayConfig)
+	if err != nil {
+		return fmt.Errorf("failed to resolve domain: %w", err)
+	}
+
+	for _, info := range computeShardInfos(gatewayConfig, hostname) {
+		shardStatus, err := getShardStatus(ctx, rr.Client, gatewayConfig, info)
+		if err != nil {
+			return err
+		}
+		gatewayConfig.Status.Shards = append(gatewayConfig.Status.Shards, shardStatus)
+	}
+
+	return nil
+}
+
+func getShardStatus(
+	ctx context.Context,
+	cli client.Client,
+	gatewayConfig *serviceApi.GatewayConfig,
+	info ShardInfo,
+) (serviceApi.GatewayShardStatus, error) {
+	shardStatus := serviceApi.GatewayShardStatus{
+		Name:   info.Name,
+		Domain: info.Hostname,
+	}
+
+	if info.IngressMode != serviceApi.IngressModeOcpRoute &&
+		shardCertificateConfig(gatewayConfig, info.Shard).Type == infrav1.CertManager {
+		certStatus, err := getGatewayCertificateStatus(ctx, cli, info.GatewayName)
+		if err != nil {
+			return shardStatus, err
+		}
+		if !certStatus.ready {
+			shardStatus.Message = fmt.Sprintf("%s: %s", status.GatewayCertificateNotReadyMessage, certStatus.message)
+			return shardStatus, nil
+		}
+	}
+
+	gateway := &gwapiv1.Gateway{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: info.GatewayName, Namespace: GatewayNamespace}, gateway); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			shardStatus.Message = "Gateway " + info.GatewayName + " not found"
+			return shardStatus, nil
+		}
+		return shardStatus, fmt.Errorf("failed to get Gateway of shard %s: %w", info.Name, err)
+	}
+
+	if !isGatewayReady(gateway) {
+		shardStatus.Message = "Gateway " + info.GatewayName + " is not accepted yet"
+		return shardStatus, nil
+	}
+
+	shardStatus.Ready = true
+
+	return shardStatus, nil
+}
@@ -0,0 +1,302 @@
+//go:build !integration
+
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestShardGatewayConfig(ingressMode serviceApi.IngressMode, shards ...serviceApi.GatewayShard) *serviceApi.GatewayConfig {
+	return &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testGatewayName,
+		},
+		Spec: serviceApi.GatewayConfigSpec{
+			IngressMode: ingressMode,
+			Shards:      shards,
+		},
+	}
+}
+
+// TestComputeShardInfos tests the names and hostnames computed for gateway shards.
+func TestComputeShardInfos(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(computeShardInfos(nil, testHostnameDefault)).To(BeEmpty())
+	g.Expect(computeShardInfos(newTestShardGatewayConfig(serviceApi.IngressModeOcpRoute), testHostnameDefault)).To(BeEmpty())
+
+	gatewayConfig := newTestShardGatewayConfig(serviceApi.IngressModeOcpRoute,
+		serviceApi.GatewayShard{Name: testShardName, Subdomain: "team-a"},
+		serviceApi.GatewayShard{Name: "team-b", Subdomain: "tenant-b", IngressMode: serviceApi.IngressModeLoadBalancer},
+	)
+
+	infos := computeShardInfos(gatewayConfig, testHostnameDefault)
+	g.Expect(infos).To(HaveLen(2))
+
+	g.Expect(infos[0].GatewayName).To(Equal("data-science-gateway-team-a"))
+	g.Expect(infos[0].Hostname).To(Equal(testHostnameShard))
+	g.Expect(infos[0].IngressMode).To(Equal(serviceApi.IngressModeOcpRoute), "should inherit the GatewayConfig ingress mode")
+	g.Expect(infos[0].ServiceName).To(Equal("data-science-gateway-team-a-" + GatewayClassName))
+	g.Expect(infos[0].AuthnFilterName).To(Equal(AuthnFilterName + "-team-a"))
+
+	g.Expect(infos[1].Hostname).To(Equal("tenant-b.apps.example.com"))
+	g.Expect(infos[1].IngressMode).To(Equal(serviceApi.IngressModeLoadBalancer))
+}
+
+// TestShardCertificateConfig tests the certificate defaults of gateway shards.
+func TestShardCertificateConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayConfig := newTestShardGatewayConfig(serviceApi.IngressModeLoadBalancer)
+	gatewayConfig.Spec.Certificate = &infrav1.CertificateSpec{Type: infrav1.Provided, SecretName: "main-tls"}
+
+	inherited := shardCertificateConfig(gatewayConfig, &serviceApi.GatewayShard{Name: testShardName})
+	g.Expect(inherited.Type).To(Equal(infrav1.Provided))
+	g.Expect(inherited.SecretName).To(BeEmpty(), "the main gateway secret must not be inherited")
+
+	own := shardCertificateConfig(gatewayConfig, &serviceApi.GatewayShard{
+		Name:        testShardName,
+		Certificate: &infrav1.CertificateSpec{Type: infrav1.CertManager},
+	})
+	g.Expect(own.Type).To(Equal(infrav1.CertManager))
+
+	g.Expect(shardCertificateConfig(newTestShardGatewayConfig(serviceApi.IngressModeLoadBalancer),
+		&serviceApi.GatewayShard{Name: testShardName}).Type).To(BeEmpty())
+}
+
+// TestNewShardGateway tests the Gateway rendered for a shard.
+func TestNewShardGateway(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		ingressMode   serviceApi.IngressMode
+		expectHostSet bool
+	}{
+		{
+			name:          "no listener hostname in OcpRoute mode",
+			ingressMode:   serviceApi.IngressModeOcpRoute,
+			expectHostSet: false,
+		},
+		{
+			name:          "listener hostname in LoadBalancer mode",
+			ingressMode:   serviceApi.IngressModeLoadBalancer,
+			expectHostSet: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gatewayConfig := newTestShardGatewayConfig(tc.ingressMode, serviceApi.GatewayShard{Name: testShardName, Subdomain: "team-a"})
+			info := computeShardInfos(gatewayConfig, testHostnameDefault)[0]
+
+			gateway := newShardGateway(info, "team-a-tls")
+
+			g.Expect(gateway.Name).To(Equal(info.GatewayName))
+			g.Expect(gateway.Namespace).To(Equal(GatewayNamespace))
+			g.Expect(gateway.Labels).To(HaveKeyWithValue(serviceApi.GatewayShardLabel, testShardName))
+			g.Expect(string(gateway.Spec.GatewayClassName)).To(Equal(GatewayClassName))
+			g.Expect(gateway.Spec.Listeners).To(HaveLen(1))
+			g.Expect(string(gateway.Spec.Listeners[0].TLS.CertificateRefs[0].Name)).To(Equal("team-a-tls"))
+
+			if tc.expectHostSet {
+				g.Expect(gateway.Spec.Listeners[0].Hostname).NotTo(BeNil())
+				g.Expect(string(*gateway.Spec.Listeners[0].Hostname)).To(Equal(testHostnameShard))
+			} else {
+				g.Expect(gateway.Spec.Listeners[0].Hostname).To(BeNil())
+			}
+		})
+	}
+}
+
+// TestNewShardRoute tests the OCP Route exposing a shard in OcpRoute mode.
+func TestNewShardRoute(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayConfig := newTestShardGatewayConfig(serviceApi.IngressModeOcpRoute, serviceApi.GatewayShard{Name: testShardName, Subdomain: "team-a"})
+	info := computeShardInfos(gatewayConfig, testHostnameDefault)[0]
+
+	route := newShardRoute(info)
+
+	g.Expect(route.Name).To(Equal(info.GatewayName))
+	g.Expect(route.Spec.Host).To(Equal(testHostnameShard))
+	g.Expect(route.Spec.To.Name).To(Equal(info.ServiceName))
+	g.Expect(route.Spec.TLS).NotTo(BeNil())
+	g.Expect(string(route.Spec.TLS.Termination)).To(Equal("reencrypt"))
+}
+
+// TestNewShardNetworkPolicy tests the NetworkPolicy of shard Gateway pods.
+func TestNewShardNetworkPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		ingressMode        serviceApi.IngressMode
+		expectRouterSource bool
+	}{
+		{
+			name:               "HTTPS only from the router in OcpRoute mode",
+			ingressMode:        serviceApi.IngressModeOcpRoute,
+			expectRouterSource: true,
+		},
+		{
+			name:               "HTTPS from any source in LoadBalancer mode",
+			ingressMode:        serviceApi.IngressModeLoadBalancer,
+			expectRouterSource: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gatewayConfig := newTestShardGatewayConfig(tc.ingressMode, serviceApi.GatewayShard{Name: testShardName, Subdomain: "team-a"})
+			info := computeShardInfos(gatewayConfig, testHostnameDefault)[0]
+
+			np := newShardNetworkPolicy(info)
+
+			g.Expect(np.Labels).To(HaveKeyWithValue(labels.PlatformPartOf, PartOfGatewayConfig))
+			g.Expect(np.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(labels.GatewayAPI.GatewayName, info.GatewayName))
+			g.Expect(np.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+			g.Expect(np.Spec.Ingress).To(HaveLen(3))
+
+			httpsRule := np.Spec.Ingress[0]
+			g.Expect(httpsRule.Ports[0].Port.IntValue()).To(Equal(StandardHTTPSPort))
+			if tc.expectRouterSource {
+				g.Expect(httpsRule.From).To(HaveLen(1))
+				g.Expect(httpsRule.From[0].NamespaceSelector.MatchLabels).To(HaveKey("policy-group.network.openshift.io/ingress"))
+			} else {
+				g.Expect(httpsRule.From).To(BeEmpty())
+			}
+		})
+	}
+}
+
+// TestIsIngressPolicyEnabled tests the NetworkPolicy ingress default.
+func TestIsIngressPolicyEnabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(isIngressPolicyEnabled(nil)).To(BeTrue())
+	g.Expect(isIngressPolicyEnabled(&serviceApi.NetworkPolicyConfig{})).To(BeTrue())
+	g.Expect(isIngressPolicyEnabled(&serviceApi.NetworkPolicyConfig{
+		Ingress: &serviceApi.IngressPolicyConfig{Enabled: false},
+	})).To(BeFalse())
+	g.Expect(isIngressPolicyEnabled(&serviceApi.NetworkPolicyConfig{
+		Ingress: ptr.To(serviceApi.IngressPolicyConfig{Enabled: true}),
+	})).To(BeTrue())
+}
+
+// TestGetAuthProxyContainers tests the OAuth callback URLs of the kube-auth-proxy containers with and without shards.
+func TestGetAuthProxyContainers(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mainCallback := "https://" + testHostnameDefault + OAuthCallbackPath
+
+	containers := getAuthProxyContainers(testHostnameDefault, false)
+	g.Expect(containers).To(HaveLen(1))
+	g.Expect(containers[0]).To(HaveKeyWithValue("RedirectURL", mainCallback))
+	g.Expect(containers[0]).To(HaveKeyWithValue("HTTPSPort", GatewayHTTPSPort))
+
+	containers = getAuthProxyContainers(testHostnameDefault, true)
+	g.Expect(containers).To(HaveLen(2))
+	g.Expect(containers[0]).To(HaveKeyWithValue("RedirectURL", mainCallback), "the main gateway keeps its absolute callback")
+	g.Expect(containers[1]).To(HaveKeyWithValue("RedirectURL", OAuthCallbackPath))
+	g.Expect(containers[1]).To(HaveKeyWithValue("HTTPSPort", ShardAuthProxyHTTPSPort))
+}
+
+// TestGetOAuthCallbackRoutes tests that the OAuth endpoints of shards are served by the shard container.
+func TestGetOAuthCallbackRoutes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(getOAuthCallbackRoutes(nil)).To(HaveLen(1))
+
+	routes := getOAuthCallbackRoutes([]ShardInfo{{Name: testShardName, GatewayName: DefaultGatewayName + "-" + testShardName}})
+	g.Expect(routes).To(HaveLen(2))
+	g.Expect(routes[0]).To(HaveKeyWithValue("GatewayNames", []string{DefaultGatewayName}))
+	g.Expect(routes[0]).To(HaveKeyWithValue("Port", GatewayHTTPSPort))
+	g.Expect(routes[1]).To(HaveKeyWithValue("GatewayNames", []string{DefaultGatewayName + "-" + testShardName}))
+	g.Expect(routes[1]).To(HaveKeyWithValue("Port", ShardAuthProxyHTTPSPort))
+}
+
+// TestIsPlatformGateway tests matching HTTPRoute parent gateways against the main Gateway and the shards.
+func TestIsPlatformGateway(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(isPlatformGateway(DefaultGatewayName)).To(BeTrue())
+	g.Expect(isPlatformGateway(shardGatewayName(testShardName))).To(BeTrue())
+	g.Expect(isPlatformGateway(DefaultGatewayName + "2")).To(BeFalse())
+	g.Expect(isPlatformGateway("other-gateway")).To(BeFalse())
+}
+
+// TestValidateShardSubdomains tests that shards cannot serve the hostname of the main gateway or of another shard.
+func TestValidateShardSubdomains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		subdomain string
+		shards    []serviceApi.GatewayShard
+		wantErr   string
+	}{
+		{
+			name:   "distinct subdomains",
+			shards: []serviceApi.GatewayShard{{Name: "a", Subdomain: "team-a"}, {Name: "b", Subdomain: "team-b"}},
+		},
+		{
+			name:    "default main subdomain",
+			shards:  []serviceApi.GatewayShard{{Name: "a", Subdomain: DefaultGatewaySubdomain}},
+			wantErr: "subdomain of the main gateway",
+		},
+		{
+			name:      "custom main subdomain",
+			subdomain: "ai",
+			shards:    []serviceApi.GatewayShard{{Name: "a", Subdomain: "ai"}},
+			wantErr:   "subdomain of the main gateway",
+		},
+		{
+			name:    "duplicate shard subdomain",
+			shards:  []serviceApi.GatewayShard{{Name: "a", Subdomain: "team-a"}, {Name: "b", Subdomain: "team-a"}},
+			wantErr: "already used by shard a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gatewayConfig := &serviceApi.GatewayConfig{
+				Spec: serviceApi.GatewayConfigSpec{Subdomain: tt.subdomain, Shards: tt.shards},
+			}
+
+			err := validateShardSubdomains(gatewayConfig)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
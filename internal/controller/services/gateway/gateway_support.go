@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	oauthv1 "github.com/openshift/api/oauth/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -24,6 +25,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
@@ -58,6 +60,11 @@ const (
 	StandardHTTPSPort    = 443
 	GatewayHTTPSPort     = 8443
 
+	// Ports of the kube-auth-proxy container serving the gateway shards.
+	ShardAuthProxyHTTPPort    = 4181
+	ShardAuthProxyHTTPSPort   = 8444
+	ShardAuthProxyMetricsPort = 9001
+
 	AuthProxyOAuth2Path = "/oauth2"
 	OAuthCallbackPath   = AuthProxyOAuth2Path + "/callback"
 	// OAuth2 proxy cookie name - used in both proxy args and EnvoyFilter Lua filter.
@@ -188,13 +195,33 @@ func handleCertificates(ctx context.Context, rr *odhtypes.ReconciliationRequest,
 		certConfig = *gatewayConfig.Spec.Certificate
 	}
 
+	hostnames := []string{domain}
+	if clientCert := computeClientCertInfo(gatewayConfig, domain); clientCert.Hostname != "" {
+		hostnames = append(hostnames, clientCert.Hostname)
+	}
+
+	return provisionCertificate(ctx, rr, gatewayConfig, certConfig, GatewayCertificateName, fmt.Sprintf("%s-tls", gatewayConfig.Name), hostnames)
+}
+
+// provisionCertificate makes the TLS certificate of a gateway available in secretName, defaulting to
+// defaultSecretName. hostnames[0] is the gateway hostname; the remaining hostnames are only added to
+// cert-manager certificates.
+func provisionCertificate(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayConfig *serviceApi.GatewayConfig,
+	certConfig infrav1.CertificateSpec,
+	certificateName string,
+	defaultSecretName string,
+	hostnames []string,
+) (string, error) {
 	if certConfig.Type == "" {
 		certConfig.Type = infrav1.OpenshiftDefaultIngress
 	}
 
 	secretName := certConfig.SecretName
 	if secretName == "" {
-		secretName = fmt.Sprintf("%s-tls", gatewayConfig.Name)
+		secretName = defaultSecretName
 	}
 
 	switch certConfig.Type {
@@ -209,8 +236,8 @@ func handleCertificates(ctx context.Context, rr *odhtypes.ReconciliationRequest,
 		}
 		return secretName, nil
 	case infrav1.SelfSigned:
-		// domain parameter already contains the full FQDN (subdomain.baseDomain) from GetFQDN
-		if err := cluster.CreateSelfSignedCertificate(ctx, rr.Client, secretName, domain, GatewayNamespace,
+		// hostnames[0] already contains the full FQDN (subdomain.baseDomain) from GetFQDN
+		if err := cluster.CreateSelfSignedCertificate(ctx, rr.Client, secretName, hostnames[0], GatewayNamespace,
 			cluster.WithLabels( // add label easy to know it is from us.
 				labels.PlatformPartOf, ServiceName,
 			),
@@ -221,6 +248,11 @@ func handleCertificates(ctx context.Context, rr *odhtypes.ReconciliationRequest,
 		return secretName, nil
 	case infrav1.Provided:
 		return secretName, nil
+	case infrav1.CertManager:
+		if err := createCertManagerCertificate(ctx, rr, certificateName, certConfig, secretName, hostnames); err != nil {
+			return "", fmt.Errorf("failed to create cert-manager certificate: %w", err)
+		}
+		return secretName, nil
 	default:
 		return "", fmt.Errorf("unsupported certificate type: %s", certConfig.Type)
 	}
@@ -239,7 +271,14 @@ func createGatewayClass(rr *odhtypes.ReconciliationRequest) error {
 	return rr.AddResources(gatewayClass)
 }
 
-func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, domain string, legacyDomain string, ingressMode serviceApi.IngressMode) error {
+func createGateway(
+	rr *odhtypes.ReconciliationRequest,
+	certSecretName string,
+	domain string,
+	legacyDomain string,
+	ingressMode serviceApi.IngressMode,
+	clientCert ClientCertInfo,
+) error {
 	listeners := []gwapiv1.Listener{}
 
 	if certSecretName != "" {
@@ -304,6 +343,12 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 			}
 			listeners = append(listeners, legacyListener)
 		}
+
+		// Add mTLS listener for LoadBalancer mode on a dedicated hostname so browser clients on the
+		// main hostname are never prompted for a client certificate.
+		if ingressMode != serviceApi.IngressModeOcpRoute && clientCert.Hostname != "" {
+			listeners = append(listeners, newClientCertListener(clientCert, *tlsConfig, allowedRoutes))
+		}
 	}
 
 	gateway := &gwapiv1.Gateway{
@@ -321,7 +366,7 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 	}
 
 	if ingressMode == serviceApi.IngressModeOcpRoute {
-		if err := configureClusterIPInfrastructure(rr, gateway); err != nil {
+		if err := configureClusterIPInfrastructure(rr, gateway, GatewayInfraConfigMapName, GatewayServiceTLSSecretName); err != nil {
 			return err
 		}
 	}
@@ -330,18 +375,24 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 }
 
 // configureClusterIPInfrastructure creates a ConfigMap for ClusterIP service configuration
-// and sets the Gateway's infrastructure reference.
-func configureClusterIPInfrastructure(rr *odhtypes.ReconciliationRequest, gateway *gwapiv1.Gateway) error {
+// and sets the Gateway's infrastructure reference. The service-CA writes the serving certificate
+// of the Gateway service to serviceTLSSecretName.
+func configureClusterIPInfrastructure(
+	rr *odhtypes.ReconciliationRequest,
+	gateway *gwapiv1.Gateway,
+	configMapName string,
+	serviceTLSSecretName string,
+) error {
 	serviceConfig := fmt.Sprintf(`metadata:
   annotations:
     service.beta.openshift.io/serving-cert-secret-name: "%s"
 spec:
   type: ClusterIP
-`, GatewayServiceTLSSecretName)
+`, serviceTLSSecretName)
 
 	infraConfigMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      GatewayInfraConfigMapName,
+			Name:      configMapName,
 			Namespace: GatewayNamespace,
 			Labels: map[string]string{
 				labels.PlatformPartOf: PartOfGatewayConfig,
@@ -359,7 +410,7 @@ spec:
 		ParametersRef: &gwapiv1.LocalParametersReference{
 			Group: "",
 			Kind:  "ConfigMap",
-			Name:  GatewayInfraConfigMapName,
+			Name:  gwapiv1.ObjectName(configMapName),
 		},
 	}
 
@@ -387,21 +438,30 @@ func createOAuthClient(ctx context.Context, rr *odhtypes.ReconciliationRequest,
 	if err != nil {
 		return fmt.Errorf("failed to resolve domain: %w", err)
 	}
-	redirectURL := fmt.Sprintf("https://%s%s", domain, OAuthCallbackPath)
+	redirectURIs := []string{fmt.Sprintf("https://%s%s", domain, OAuthCallbackPath)}
+	for _, shard := range computeShardInfos(gatewayConfig, domain) {
+		redirectURIs = append(redirectURIs, fmt.Sprintf("https://%s%s", shard.Hostname, OAuthCallbackPath))
+	}
+
 	oauthClient := &oauthv1.OAuthClient{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: AuthClientID,
 		},
 		GrantMethod:  oauthv1.GrantHandlerAuto,
-		RedirectURIs: []string{redirectURL},
+		RedirectURIs: redirectURIs,
 		Secret:       clientSecret, // encoded string
 	}
 
+	// Keep accepting the previous client secret while proxy pods roll out after a rotation.
+	if previous := authSecret.Data[previousClientSecretKey]; len(previous) > 0 {
+		oauthClient.AdditionalSecrets = []string{string(previous)}
+	}
+
 	return rr.AddResources(oauthClient)
 }
 
 // createSecret dynamically creates the kube-auth-proxy-creds secret immediately on the cluster.
-func createSecret(ctx context.Context, rr *odhtypes.ReconciliationRequest, clientID, clientSecret, cookieSecret string) error {
+func createSecret(ctx context.Context, rr *odhtypes.ReconciliationRequest, secrets authProxySecrets) error {
 	gatewayConfig, ok := rr.Instance.(*serviceApi.GatewayConfig)
 	if !ok {
 		return errors.New("instance is not of type *services.GatewayConfig")
@@ -420,12 +480,22 @@ func createSecret(ctx context.Context, rr *odhtypes.ReconciliationRequest, clien
 	}
 
 	_, err := controllerutil.CreateOrUpdate(ctx, rr.Client, secret, func() error {
-		secret.StringData = map[string]string{
-			"OAUTH2_PROXY_CLIENT_ID":     clientID,
-			"OAUTH2_PROXY_CLIENT_SECRET": clientSecret,
-			"OAUTH2_PROXY_COOKIE_SECRET": cookieSecret,
+		// Data is replaced rather than merged so previous values are dropped once the grace period ends.
+		secret.StringData = nil
+		secret.Data = map[string][]byte{
+			"OAUTH2_PROXY_CLIENT_ID":     []byte(secrets.clientID),
+			"OAUTH2_PROXY_CLIENT_SECRET": []byte(secrets.clientSecret),
+			"OAUTH2_PROXY_COOKIE_SECRET": []byte(secrets.cookieSecret),
+		}
+		if secrets.previousClientSecret != "" {
+			secret.Data[previousClientSecretKey] = []byte(secrets.previousClientSecret)
 		}
 		resources.SetLabels(secret, labelList)
+		if secrets.rotatedAt.IsZero() {
+			resources.RemoveAnnotation(secret, annotations.SecretRotatedAt)
+		} else {
+			resources.SetAnnotation(secret, annotations.SecretRotatedAt, secrets.rotatedAt.UTC().Format(time.RFC3339))
+		}
 		return controllerutil.SetControllerReference(gatewayConfig, secret, rr.Client.Scheme())
 	})
 
@@ -695,12 +765,17 @@ func detectAndSetIngressMode(ctx context.Context, rr *odhtypes.ReconciliationReq
 
 // reconcileGatewayForModeChange deletes the Gateway if its configuration doesn't match
 // the desired ingress mode. SSA won't remove fields like hostname, so we force recreation.
-func reconcileGatewayForModeChange(ctx context.Context, rr *odhtypes.ReconciliationRequest, desiredMode serviceApi.IngressMode) error {
+func reconcileGatewayForModeChange(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayName string,
+	desiredMode serviceApi.IngressMode,
+) error {
 	l := logf.FromContext(ctx).WithName("reconcileGatewayForModeChange")
 
 	gateway := &gwapiv1.Gateway{}
 	err := rr.Client.Get(ctx, client.ObjectKey{
-		Name:      DefaultGatewayName,
+		Name:      gatewayName,
 		Namespace: GatewayNamespace,
 	}, gateway)
 
@@ -729,7 +804,7 @@ func reconcileGatewayForModeChange(ctx context.Context, rr *odhtypes.Reconciliat
 		return nil
 	}
 
-	l.Info("Deleting Gateway for ingress mode change", "desiredMode", desiredMode)
+	l.Info("Deleting Gateway for ingress mode change", "gateway", gatewayName, "desiredMode", desiredMode)
 	if err := rr.Client.Delete(ctx, gateway); err != nil {
 		return fmt.Errorf("failed to delete Gateway: %w", err)
 	}
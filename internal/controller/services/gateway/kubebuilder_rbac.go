@@ -6,6 +6,10 @@ package gateway
 // +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=gatewayconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=gatewayconfigs/finalizers,verbs=update
 
+// PlatformRoutes published by modules and attached to the platform Gateway
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=platformroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=platformroutes/status,verbs=get;update;patch
+
 // Dashboard CR (read-only, to check if Dashboard is deployed before creating redirects)
 // +kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=dashboards,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=list;watch
@@ -20,3 +24,5 @@ package gateway
 // Gateway controller creates and manages the following Istio resources
 // +kubebuilder:rbac:groups=networking.istio.io,resources=destinationrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.istio.io,resources=envoyfilters,verbs=get;list;watch;create;update;patch;delete
+// cert-manager Certificate for the gateway when the CertManager certificate type is used
+// +kubebuilder:rbac:groups="cert-manager.io",resources=certificates,verbs=get;list;watch;create;update;patch;delete
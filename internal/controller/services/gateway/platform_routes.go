@@ -0,0 +1,438 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// PlatformRoute acceptance reasons.
+const (
+	PlatformRouteAcceptedReason            = "Accepted"
+	PlatformRouteConflictReason            = "Conflict"
+	PlatformRouteReservedPathReason        = "ReservedPath"
+	PlatformRouteNamespaceNotAllowedReason = "NamespaceNotAllowed"
+	PlatformRouteHostnameNotAllowedReason  = "HostnameNotAllowed"
+	PlatformRouteUnknownShardReason        = "UnknownShard"
+	PlatformRoutePendingReason             = "Pending"
+)
+
+// platformHTTPRouteNamePrefix prefixes the HTTPRoutes rendered for PlatformRoutes, so that they do
+// not replace an HTTPRoute of the same name created by a module in the namespace of the route.
+const platformHTTPRouteNamePrefix = "platformroute-"
+
+// platformRouteGateway is a Gateway PlatformRoutes can be attached to: the main platform Gateway,
+// keyed by the empty shard name, or a gateway shard.
+type platformRouteGateway struct {
+	name     string
+	hostname string
+}
+
+// platformRouteResult is the outcome of resolving a single PlatformRoute against
+// the platform Gateway and every other PlatformRoute in the cluster. accepted reports whether an
+// HTTPRoute is rendered for the route, status whether the Gateway has accepted that HTTPRoute.
+type platformRouteResult struct {
+	route    *serviceApi.PlatformRoute
+	gateway  string
+	hostname string
+	url      string
+	accepted bool
+	status   metav1.ConditionStatus
+	reason   string
+	message  string
+}
+
+// createPlatformRoutes attaches every accepted PlatformRoute to the platform Gateway through an
+// HTTPRoute, reports the outcome on each PlatformRoute and lists the resulting URLs in the
+// GatewayConfig status once the Gateway has accepted the HTTPRoute. Authentication is enforced by the Gateway EnvoyFilter, which sends every
+// request through kube-auth-proxy, so the rendered HTTPRoutes need no extra configuration.
+//
+// HTTPRoutes are owned by the GatewayConfig, so routes of deleted or rejected PlatformRoutes are
+// removed by the GC action.
+func createPlatformRoutes(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	l := logf.FromContext(ctx).WithName("createPlatformRoutes")
+
+	gatewayConfig, err := validateGatewayConfig(rr)
+	if err != nil {
+		return err
+	}
+
+	hostname, err := GetFQDN(ctx, rr.Client, gatewayConfig)
+	if err != nil {
+		return fmt.Errorf("failed to resolve domain: %w", err)
+	}
+
+	routes := &serviceApi.PlatformRouteList{}
+	if err := rr.Client.List(ctx, routes); err != nil {
+		return fmt.Errorf("failed to list PlatformRoutes: %w", err)
+	}
+
+	httpRoutes := &gwapiv1.HTTPRouteList{}
+	if err := rr.Client.List(ctx, httpRoutes); err != nil {
+		return fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+
+	gateways := map[string]platformRouteGateway{
+		"": {name: DefaultGatewayName, hostname: hostname},
+	}
+	for _, shard := range computeShardInfos(gatewayConfig, hostname) {
+		gateways[shard.Name] = platformRouteGateway{name: shard.GatewayName, hostname: shard.Hostname}
+	}
+
+	results := resolvePlatformRoutes(routes.Items, gateways, httpRoutes.Items)
+
+	gatewayConfig.Status.Routes = nil
+	for i := range results {
+		res := &results[i]
+
+		if res.accepted {
+			if err := rr.AddResources(newPlatformHTTPRoute(res.route, res.gateway, res.hostname)); err != nil {
+				return fmt.Errorf("failed to add HTTPRoute for PlatformRoute %s/%s: %w", res.route.Namespace, res.route.Name, err)
+			}
+
+			if err := applyHTTPRouteStatus(ctx, rr.Client, res); err != nil {
+				return err
+			}
+		} else {
+			l.V(1).Info("PlatformRoute rejected", "name", res.route.Name, "namespace", res.route.Namespace, "reason", res.reason)
+		}
+
+		if res.status == metav1.ConditionTrue {
+			gatewayConfig.Status.Routes = append(gatewayConfig.Status.Routes, serviceApi.GatewayRouteStatus{
+				Name:      res.route.Name,
+				Namespace: res.route.Namespace,
+				URL:       res.url,
+			})
+		}
+
+		if err := updatePlatformRouteStatus(ctx, rr.Client, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolvePlatformRoutes decides which PlatformRoutes can be attached to the platform Gateway or,
+// when labelled with serviceApi.GatewayShardLabel, to a gateway shard. Routes are evaluated oldest
+// first, so an existing route keeps its path prefix when a newer route claims the same one.
+//
+// A route must use the hostname of its gateway: the Gateway https listener and its certificate
+// only cover that hostname, so an HTTPRoute for any other hostname would never receive traffic.
+// Routes are therefore not served on the legacy redirect or client certificate hostnames.
+//
+// Path prefixes matched by other HTTPRoutes attached to the same gateway are claimed before any
+// PlatformRoute, as the Gateway would otherwise merge both routes on that prefix.
+func resolvePlatformRoutes(
+	routes []serviceApi.PlatformRoute,
+	gateways map[string]platformRouteGateway,
+	httpRoutes []gwapiv1.HTTPRoute,
+) []platformRouteResult {
+	sorted := make([]*serviceApi.PlatformRoute, 0, len(routes))
+	for i := range routes {
+		if !routes[i].DeletionTimestamp.IsZero() {
+			continue
+		}
+		sorted = append(sorted, &routes[i])
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].CreationTimestamp, sorted[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		if sorted[i].Namespace != sorted[j].Namespace {
+			return sorted[i].Namespace < sorted[j].Namespace
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	allowedNamespaces := map[string]bool{
+		GatewayNamespace:                  true,
+		cluster.GetApplicationNamespace(): true,
+	}
+
+	claimed := claimedHTTPRoutePaths(httpRoutes, gateways)
+	results := make([]platformRouteResult, 0, len(sorted))
+
+	for _, route := range sorted {
+		shard := route.Labels[serviceApi.GatewayShardLabel]
+		gateway, known := gateways[shard]
+
+		res := platformRouteResult{
+			route:    route,
+			gateway:  gateway.name,
+			hostname: gateway.hostname,
+			status:   metav1.ConditionFalse,
+		}
+		if route.Spec.Hostname != "" {
+			res.hostname = route.Spec.Hostname
+		}
+
+		pathPrefix := normalizePathPrefix(route.Spec.PathPrefix)
+		key := res.hostname + pathPrefix
+
+		switch {
+		case !known:
+			res.reason = PlatformRouteUnknownShardReason
+			res.message = fmt.Sprintf("gateway shard %s is not defined in GatewayConfig %s", shard, serviceApi.GatewayConfigName)
+		case !allowedNamespaces[route.Namespace]:
+			res.reason = PlatformRouteNamespaceNotAllowedReason
+			res.message = fmt.Sprintf("PlatformRoutes are only accepted in namespaces %s and %s",
+				GatewayNamespace, cluster.GetApplicationNamespace())
+		case res.hostname != gateway.hostname:
+			res.reason = PlatformRouteHostnameNotAllowedReason
+			res.message = fmt.Sprintf("hostname %s is not served by gateway %s, whose listener and certificate only cover %s",
+				res.hostname, gateway.name, gateway.hostname)
+		case isReservedPath(pathPrefix):
+			res.reason = PlatformRouteReservedPathReason
+			res.message = fmt.Sprintf("path prefix %s is reserved by the platform authentication proxy", route.Spec.PathPrefix)
+		case claimed[key] != "":
+			res.reason = PlatformRouteConflictReason
+			res.message = fmt.Sprintf("hostname %s and path prefix %s are already claimed by %s",
+				res.hostname, pathPrefix, claimed[key])
+		default:
+			claimed[key] = "PlatformRoute " + route.Namespace + "/" + route.Name
+			res.accepted = true
+			res.status = metav1.ConditionTrue
+			res.reason = PlatformRouteAcceptedReason
+			res.url = "https://" + res.hostname + pathPrefix
+			res.message = "Route attached to gateway " + gateway.name
+		}
+
+		results = append(results, res)
+	}
+
+	return results
+}
+
+// claimedHTTPRoutePaths returns the hostname and path prefixes matched by the HTTPRoutes attached
+// to one of the gateways, keyed like the PlatformRoute claims. HTTPRoutes rendered for PlatformRoutes
+// are skipped, as their claims are recomputed from the PlatformRoutes themselves.
+func claimedHTTPRoutePaths(httpRoutes []gwapiv1.HTTPRoute, gateways map[string]platformRouteGateway) map[string]string {
+	hostnames := make(map[string]string, len(gateways))
+	for _, gateway := range gateways {
+		hostnames[gateway.name] = gateway.hostname
+	}
+
+	claimed := make(map[string]string)
+	for i := range httpRoutes {
+		httpRoute := &httpRoutes[i]
+		if isPlatformHTTPRoute(httpRoute) {
+			continue
+		}
+
+		for _, ref := range httpRoute.Spec.ParentRefs {
+			refNamespace := httpRoute.Namespace
+			if ref.Namespace != nil {
+				refNamespace = string(*ref.Namespace)
+			}
+			hostname, ok := hostnames[string(ref.Name)]
+			if !ok || refNamespace != GatewayNamespace {
+				continue
+			}
+			if len(httpRoute.Spec.Hostnames) > 0 && !slices.Contains(httpRoute.Spec.Hostnames, gwapiv1.Hostname(hostname)) {
+				continue
+			}
+
+			owner := "HTTPRoute " + httpRoute.Namespace + "/" + httpRoute.Name
+			for _, rule := range httpRoute.Spec.Rules {
+				for _, match := range rule.Matches {
+					pathPrefix := "/"
+					if match.Path != nil {
+						if match.Path.Type != nil && *match.Path.Type != gwapiv1.PathMatchPathPrefix {
+							continue
+						}
+						pathPrefix = ptr.Deref(match.Path.Value, "/")
+					}
+					key := hostname + normalizePathPrefix(pathPrefix)
+					if _, exists := claimed[key]; !exists {
+						claimed[key] = owner
+					}
+				}
+			}
+		}
+	}
+
+	return claimed
+}
+
+// platformHTTPRouteName returns the name of the HTTPRoute rendered for a PlatformRoute.
+func platformHTTPRouteName(route *serviceApi.PlatformRoute) string {
+	return platformHTTPRouteNamePrefix + route.Name
+}
+
+// isPlatformHTTPRoute reports whether an HTTPRoute was rendered for a PlatformRoute.
+func isPlatformHTTPRoute(httpRoute *gwapiv1.HTTPRoute) bool {
+	return strings.HasPrefix(httpRoute.Name, platformHTTPRouteNamePrefix) &&
+		httpRoute.Labels[labels.PlatformPartOf] == PartOfGatewayConfig
+}
+
+// normalizePathPrefix strips trailing slashes so /mlflow and /mlflow/ are treated as the same prefix.
+func normalizePathPrefix(prefix string) string {
+	trimmed := strings.TrimRight(prefix, "/")
+	if trimmed == "" {
+		return "/"
+	}
+	return trimmed
+}
+
+// isReservedPath reports whether a path prefix overlaps with the auth proxy endpoints, which must
+// stay reachable on the gateway hostname for the OAuth/OIDC flow to work.
+func isReservedPath(pathPrefix string) bool {
+	return pathPrefix == "/" ||
+		pathPrefix == AuthProxyOAuth2Path ||
+		strings.HasPrefix(pathPrefix, AuthProxyOAuth2Path+"/")
+}
+
+func newPlatformHTTPRoute(route *serviceApi.PlatformRoute, gatewayName string, hostname string) *gwapiv1.HTTPRoute {
+	pathType := gwapiv1.PathMatchPathPrefix
+	port := gwapiv1.PortNumber(route.Spec.Backend.Port)
+
+	return &gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      platformHTTPRouteName(route),
+			Namespace: route.Namespace,
+			Labels: map[string]string{
+				labels.PlatformPartOf: PartOfGatewayConfig,
+			},
+		},
+		Spec: gwapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gwapiv1.CommonRouteSpec{
+				ParentRefs: []gwapiv1.ParentReference{
+					{
+						Name:      gwapiv1.ObjectName(gatewayName),
+						Namespace: ptr.To(gwapiv1.Namespace(GatewayNamespace)),
+					},
+				},
+			},
+			Hostnames: []gwapiv1.Hostname{gwapiv1.Hostname(hostname)},
+			Rules: []gwapiv1.HTTPRouteRule{
+				{
+					Matches: []gwapiv1.HTTPRouteMatch{
+						{
+							Path: &gwapiv1.HTTPPathMatch{
+								Type:  &pathType,
+								Value: ptr.To(normalizePathPrefix(route.Spec.PathPrefix)),
+							},
+						},
+					},
+					BackendRefs: []gwapiv1.HTTPBackendRef{
+						{
+							BackendRef: gwapiv1.BackendRef{
+								BackendObjectReference: gwapiv1.BackendObjectReference{
+									Name: gwapiv1.ObjectName(route.Spec.Backend.ServiceName),
+									Port: &port,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// applyHTTPRouteStatus downgrades a resolved PlatformRoute until the Gateway has accepted its
+// HTTPRoute, as reported by the Accepted condition of the HTTPRoute status.parents entry for the
+// gateway. HTTPRoute status changes trigger a new reconciliation through the HTTPRoute watch.
+func applyHTTPRouteStatus(ctx context.Context, cli client.Client, res *platformRouteResult) error {
+	httpRoute := &gwapiv1.HTTPRoute{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: res.route.Namespace, Name: platformHTTPRouteName(res.route)}, httpRoute)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to get HTTPRoute for PlatformRoute %s/%s: %w", res.route.Namespace, res.route.Name, err)
+	}
+
+	var parentCond *metav1.Condition
+	if err == nil {
+		for i := range httpRoute.Status.Parents {
+			ref := httpRoute.Status.Parents[i].ParentRef
+			if string(ref.Name) != res.gateway || (ref.Namespace != nil && string(*ref.Namespace) != GatewayNamespace) {
+				continue
+			}
+			parentCond = meta.FindStatusCondition(httpRoute.Status.Parents[i].Conditions, string(gwapiv1.RouteConditionAccepted))
+			break
+		}
+	}
+
+	switch {
+	case parentCond == nil || parentCond.ObservedGeneration < httpRoute.Generation:
+		res.status = metav1.ConditionUnknown
+		res.reason = PlatformRoutePendingReason
+		res.message = fmt.Sprintf("waiting for gateway %s to accept the HTTPRoute", res.gateway)
+		res.url = ""
+	case parentCond.Status != metav1.ConditionTrue:
+		res.status = metav1.ConditionFalse
+		res.reason = parentCond.Reason
+		res.message = fmt.Sprintf("gateway %s did not accept the HTTPRoute: %s", res.gateway, parentCond.Message)
+		res.url = ""
+	}
+
+	return nil
+}
+
+// updatePlatformRouteStatus records the acceptance result on the PlatformRoute, skipping the
+// API call when nothing changed to avoid requeue loops through the PlatformRoute watch.
+func updatePlatformRouteStatus(ctx context.Context, cli client.Client, res *platformRouteResult) error {
+	route := res.route
+
+	cond := common.Condition{
+		Type:               serviceApi.PlatformRouteAcceptedCondition,
+		Status:             res.status,
+		Reason:             res.reason,
+		Message:            res.message,
+		ObservedGeneration: route.Generation,
+	}
+	existing := conditions.FindStatusCondition(route, cond.Type)
+	if existing != nil &&
+		existing.Status == cond.Status &&
+		existing.Reason == cond.Reason &&
+		existing.Message == cond.Message &&
+		existing.ObservedGeneration == cond.ObservedGeneration &&
+		route.Status.URL == res.url &&
+		route.Status.ObservedGeneration == route.Generation {
+		return nil
+	}
+
+	conditions.SetStatusCondition(route, cond)
+	route.Status.URL = res.url
+	route.Status.ObservedGeneration = route.Generation
+
+	if err := cli.Status().Update(ctx, route); err != nil {
+		return fmt.Errorf("failed to update status of PlatformRoute %s/%s: %w", route.Namespace, route.Name, err)
+	}
+
+	return nil
+}
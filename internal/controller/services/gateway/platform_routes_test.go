@@ -0,0 +1,346 @@
+//go:build !integration
+
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestPlatformRoute(name, namespace, hostname, pathPrefix string, age time.Duration) serviceApi.PlatformRoute {
+	return serviceApi.PlatformRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Spec: serviceApi.PlatformRouteSpec{
+			Hostname:   hostname,
+			PathPrefix: pathPrefix,
+			Backend: serviceApi.PlatformRouteBackend{
+				ServiceName: name,
+				Port:        8443,
+			},
+		},
+	}
+}
+
+const (
+	testShardName     = "team-a"
+	testHostnameShard = "team-a.apps.example.com"
+)
+
+func newTestRouteGateways() map[string]platformRouteGateway {
+	return map[string]platformRouteGateway{
+		"":            {name: DefaultGatewayName, hostname: testHostnameDefault},
+		testShardName: {name: DefaultGatewayName + "-" + testShardName, hostname: testHostnameShard},
+	}
+}
+
+func newTestShardPlatformRoute(name, shard, pathPrefix string, age time.Duration) serviceApi.PlatformRoute {
+	route := newTestPlatformRoute(name, cluster.GetApplicationNamespace(), "", pathPrefix, age)
+	route.Labels = map[string]string{serviceApi.GatewayShardLabel: shard}
+	return route
+}
+
+func newTestHTTPRoute(name, namespace, gatewayName, pathPrefix string) gwapiv1.HTTPRoute {
+	return gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: gwapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gwapiv1.CommonRouteSpec{
+				ParentRefs: []gwapiv1.ParentReference{{
+					Name:      gwapiv1.ObjectName(gatewayName),
+					Namespace: ptr.To(gwapiv1.Namespace(GatewayNamespace)),
+				}},
+			},
+			Rules: []gwapiv1.HTTPRouteRule{{
+				Matches: []gwapiv1.HTTPRouteMatch{{
+					Path: &gwapiv1.HTTPPathMatch{Value: ptr.To(pathPrefix)},
+				}},
+			}},
+		},
+	}
+}
+
+func TestResolvePlatformRoutes(t *testing.T) {
+	t.Parallel()
+
+	appNs := cluster.GetApplicationNamespace()
+	rendered := newTestPlatformRoute("feast", appNs, "", "/feast", time.Hour)
+
+	testCases := []struct {
+		name             string
+		routes           []serviceApi.PlatformRoute
+		httpRoutes       []gwapiv1.HTTPRoute
+		expectedAccepted map[string]bool
+		expectedReasons  map[string]string
+		expectedURLs     map[string]string
+		expectedGateways map[string]string
+	}{
+		{
+			name: "accepts routes with distinct path prefixes",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("mlflow", appNs, "", "/mlflow", time.Hour),
+				newTestPlatformRoute("feast", appNs, "", "/feast/", time.Minute),
+			},
+			expectedAccepted: map[string]bool{"mlflow": true, "feast": true},
+			expectedURLs: map[string]string{
+				"mlflow": "https://" + testHostnameDefault + "/mlflow",
+				"feast":  "https://" + testHostnameDefault + "/feast",
+			},
+		},
+		{
+			name: "rejects the newer of two routes claiming the same path prefix",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("newer", appNs, "", "/mlflow/", time.Minute),
+				newTestPlatformRoute("older", GatewayNamespace, "", "/mlflow", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"older": true, "newer": false},
+			expectedReasons:  map[string]string{"newer": PlatformRouteConflictReason},
+		},
+		{
+			name: "rejects paths reserved by the auth proxy",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("root", appNs, "", "/", time.Hour),
+				newTestPlatformRoute("oauth", appNs, "", "/oauth2/callback", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"root": false, "oauth": false},
+			expectedReasons: map[string]string{
+				"root":  PlatformRouteReservedPathReason,
+				"oauth": PlatformRouteReservedPathReason,
+			},
+		},
+		{
+			name: "rejects routes outside the allowed namespaces",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("other", "user-namespace", "", "/other", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"other": false},
+			expectedReasons:  map[string]string{"other": PlatformRouteNamespaceNotAllowedReason},
+		},
+		{
+			name: "rejects hostnames not covered by the gateway listener",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("custom", appNs, testHostnameCustom, "/api", time.Hour),
+				newTestPlatformRoute("explicit", appNs, testHostnameDefault, "/app", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"custom": false, "explicit": true},
+			expectedReasons:  map[string]string{"custom": PlatformRouteHostnameNotAllowedReason},
+			expectedURLs: map[string]string{
+				"explicit": "https://" + testHostnameDefault + "/app",
+			},
+		},
+		{
+			name: "attaches labelled routes to their gateway shard",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("main", appNs, "", "/app", time.Hour),
+				newTestShardPlatformRoute("sharded", testShardName, "/app", time.Minute),
+			},
+			expectedAccepted: map[string]bool{"main": true, "sharded": true},
+			expectedURLs: map[string]string{
+				"main":    "https://" + testHostnameDefault + "/app",
+				"sharded": "https://" + testHostnameShard + "/app",
+			},
+			expectedGateways: map[string]string{
+				"main":    DefaultGatewayName,
+				"sharded": DefaultGatewayName + "-" + testShardName,
+			},
+		},
+		{
+			name: "rejects routes labelled with an unknown shard",
+			routes: []serviceApi.PlatformRoute{
+				newTestShardPlatformRoute("orphan", "team-b", "/app", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"orphan": false},
+			expectedReasons:  map[string]string{"orphan": PlatformRouteUnknownShardReason},
+		},
+		{
+			name: "rejects paths reserved by the auth proxy on shards",
+			routes: []serviceApi.PlatformRoute{
+				newTestShardPlatformRoute("oauth", testShardName, "/oauth2", time.Hour),
+			},
+			expectedAccepted: map[string]bool{"oauth": false},
+			expectedReasons:  map[string]string{"oauth": PlatformRouteReservedPathReason},
+		},
+		{
+			name: "rejects path prefixes claimed by other HTTPRoutes of the gateway",
+			routes: []serviceApi.PlatformRoute{
+				newTestPlatformRoute("mlflow", appNs, "", "/mlflow", time.Hour),
+				newTestShardPlatformRoute("sharded", testShardName, "/mlflow", time.Hour),
+				newTestPlatformRoute("feast", appNs, "", "/feast", time.Hour),
+			},
+			httpRoutes: []gwapiv1.HTTPRoute{
+				newTestHTTPRoute("mlflow", appNs, DefaultGatewayName, "/mlflow/"),
+				newTestHTTPRoute("other", appNs, "other-gateway", "/mlflow"),
+				*newPlatformHTTPRoute(&rendered, DefaultGatewayName, testHostnameDefault),
+			},
+			expectedAccepted: map[string]bool{"mlflow": false, "sharded": true, "feast": true},
+			expectedReasons:  map[string]string{"mlflow": PlatformRouteConflictReason},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			results := resolvePlatformRoutes(tc.routes, newTestRouteGateways(), tc.httpRoutes)
+			g.Expect(results).To(HaveLen(len(tc.routes)))
+
+			for _, res := range results {
+				name := res.route.Name
+				g.Expect(res.accepted).To(Equal(tc.expectedAccepted[name]), "unexpected acceptance for %s: %s", name, res.message)
+				if reason, ok := tc.expectedReasons[name]; ok {
+					g.Expect(res.reason).To(Equal(reason))
+				}
+				if url, ok := tc.expectedURLs[name]; ok {
+					g.Expect(res.url).To(Equal(url))
+				}
+				if gateway, ok := tc.expectedGateways[name]; ok {
+					g.Expect(res.gateway).To(Equal(gateway))
+				}
+				if res.accepted {
+					g.Expect(res.status).To(Equal(metav1.ConditionTrue))
+				} else {
+					g.Expect(res.status).To(Equal(metav1.ConditionFalse))
+					g.Expect(res.url).To(BeEmpty())
+				}
+			}
+		})
+	}
+}
+
+func TestResolvePlatformRoutesSkipsDeletedRoutes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deleting := newTestPlatformRoute("deleting", cluster.GetApplicationNamespace(), "", "/app", time.Hour)
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deleting.Finalizers = []string{"example.com/finalizer"}
+	replacement := newTestPlatformRoute("replacement", cluster.GetApplicationNamespace(), "", "/app", time.Minute)
+
+	results := resolvePlatformRoutes([]serviceApi.PlatformRoute{deleting, replacement}, newTestRouteGateways(), nil)
+
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].route.Name).To(Equal("replacement"))
+	g.Expect(results[0].accepted).To(BeTrue())
+}
+
+func TestNewPlatformHTTPRoute(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	route := newTestPlatformRoute("mlflow", cluster.GetApplicationNamespace(), "", "/mlflow/", time.Hour)
+	httpRoute := newPlatformHTTPRoute(&route, DefaultGatewayName, testHostnameDefault)
+
+	g.Expect(httpRoute.Name).To(Equal(platformHTTPRouteNamePrefix + "mlflow"))
+	g.Expect(httpRoute.Namespace).To(Equal(cluster.GetApplicationNamespace()))
+	g.Expect(httpRoute.Spec.ParentRefs).To(HaveLen(1))
+	g.Expect(httpRoute.Spec.ParentRefs[0].Name).To(Equal(gwapiv1.ObjectName(DefaultGatewayName)))
+	g.Expect(string(*httpRoute.Spec.ParentRefs[0].Namespace)).To(Equal(GatewayNamespace))
+	g.Expect(httpRoute.Spec.Hostnames).To(ConsistOf(gwapiv1.Hostname(testHostnameDefault)))
+	g.Expect(httpRoute.Spec.Rules).To(HaveLen(1))
+	g.Expect(*httpRoute.Spec.Rules[0].Matches[0].Path.Value).To(Equal("/mlflow"))
+	g.Expect(httpRoute.Spec.Rules[0].BackendRefs[0].Name).To(Equal(gwapiv1.ObjectName("mlflow")))
+	g.Expect(*httpRoute.Spec.Rules[0].BackendRefs[0].Port).To(Equal(gwapiv1.PortNumber(8443)))
+}
+
+func newTestHTTPRouteParentStatus(gatewayName string, status metav1.ConditionStatus, reason string) gwapiv1.RouteParentStatus {
+	return gwapiv1.RouteParentStatus{
+		ParentRef: gwapiv1.ParentReference{
+			Name:      gwapiv1.ObjectName(gatewayName),
+			Namespace: ptr.To(gwapiv1.Namespace(GatewayNamespace)),
+		},
+		ControllerName: "openshift.io/gateway-controller/v1",
+		Conditions: []metav1.Condition{{
+			Type:               string(gwapiv1.RouteConditionAccepted),
+			Status:             status,
+			Reason:             reason,
+			Message:            "reported by the gateway",
+			ObservedGeneration: 1,
+		}},
+	}
+}
+
+func TestApplyHTTPRouteStatus(t *testing.T) {
+	t.Parallel()
+
+	appNs := cluster.GetApplicationNamespace()
+
+	testCases := []struct {
+		name           string
+		parents        []gwapiv1.RouteParentStatus
+		noHTTPRoute    bool
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "accepted by the gateway",
+			parents:        []gwapiv1.RouteParentStatus{newTestHTTPRouteParentStatus(DefaultGatewayName, metav1.ConditionTrue, "Accepted")},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: PlatformRouteAcceptedReason,
+		},
+		{
+			name:           "rejected by the gateway",
+			parents:        []gwapiv1.RouteParentStatus{newTestHTTPRouteParentStatus(DefaultGatewayName, metav1.ConditionFalse, "NotAllowedByListeners")},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "NotAllowedByListeners",
+		},
+		{
+			name:           "only another gateway reported",
+			parents:        []gwapiv1.RouteParentStatus{newTestHTTPRouteParentStatus("other-gateway", metav1.ConditionTrue, "Accepted")},
+			expectedStatus: metav1.ConditionUnknown,
+			expectedReason: PlatformRoutePendingReason,
+		},
+		{
+			name:           "HTTPRoute not created yet",
+			noHTTPRoute:    true,
+			expectedStatus: metav1.ConditionUnknown,
+			expectedReason: PlatformRoutePendingReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			route := newTestPlatformRoute("mlflow", appNs, "", "/mlflow", time.Hour)
+			var objects []client.Object
+			if !tc.noHTTPRoute {
+				httpRoute := newPlatformHTTPRoute(&route, DefaultGatewayName, testHostnameDefault)
+				httpRoute.Generation = 1
+				httpRoute.Status.Parents = tc.parents
+				objects = append(objects, httpRoute)
+			}
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(objects...))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			results := resolvePlatformRoutes([]serviceApi.PlatformRoute{route}, newTestRouteGateways(), nil)
+			g.Expect(results).To(HaveLen(1))
+			res := &results[0]
+
+			g.Expect(applyHTTPRouteStatus(t.Context(), cli, res)).To(Succeed())
+			g.Expect(res.status).To(Equal(tc.expectedStatus))
+			g.Expect(res.reason).To(Equal(tc.expectedReason))
+			if tc.expectedStatus == metav1.ConditionTrue {
+				g.Expect(res.url).To(Equal("https://" + testHostnameDefault + "/mlflow"))
+			} else {
+				g.Expect(res.url).To(BeEmpty())
+			}
+		})
+	}
+}
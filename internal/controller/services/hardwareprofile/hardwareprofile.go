@@ -0,0 +1,71 @@
+package hardwareprofile
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	hwpwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
+)
+
+const (
+	ServiceName = "hardwareprofile"
+)
+
+func NewHandler() *serviceHandler { return &serviceHandler{} }
+
+type serviceHandler struct {
+}
+
+func (h *serviceHandler) Init(_ common.Platform) error {
+	return nil
+}
+
+func (h *serviceHandler) GetName() string {
+	return ServiceName
+}
+
+func (h *serviceHandler) GetManagementState(_ common.Platform, _ *dsciv2.DSCInitialization) operatorv1.ManagementState {
+	return operatorv1.Managed
+}
+
+func (h *serviceHandler) NewReconciler(_ context.Context, mgr ctrl.Manager) error {
+	rec := &HardwareProfileStatusReconciler{
+		Client: mgr.GetClient(),
+		Reader: mgr.GetAPIReader(),
+	}
+
+	if err := rec.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("could not create the %s controller: %w", ServiceName, err)
+	}
+
+	targetRec := &HardwareProfileTargetReconciler{
+		Client:        mgr.GetClient(),
+		RESTMapper:    mgr.GetRESTMapper(),
+		WebhookServer: mgr.GetWebhookServer(),
+	}
+
+	if err := targetRec.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("could not create the %s targets controller: %w", ServiceName, err)
+	}
+
+	propagationRec := &PropagationReconciler{
+		Client: mgr.GetClient(),
+		Reader: mgr.GetAPIReader(),
+		Injector: &hwpwebhook.Injector{
+			Client:  mgr.GetAPIReader(),
+			Name:    "hardwareprofile-propagation",
+			Targets: mgr.GetClient(),
+		},
+	}
+
+	if err := propagationRec.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("could not create the %s propagation controller: %w", ServiceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Package hardwareprofile contains the controllers reporting the observed state of HardwareProfiles
+// and registering HardwareProfileTargets with the hardware profile webhook.
+package hardwareprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+)
+
+// resyncPeriod is the interval at which the workload counts and the LocalQueue
+// existence are refreshed, as neither workloads nor LocalQueues are watched. The
+// workloads are listed once per period for all the profiles.
+const resyncPeriod = 5 * time.Minute
+
+// HardwareProfileStatusReconciler fills the status of HardwareProfiles.
+type HardwareProfileStatusReconciler struct {
+	Client client.Client
+	// Reader reads LocalQueues and workloads, which live in any namespace and
+	// are not cached by the manager.
+	Reader client.Reader
+
+	workloads *workloadCounter
+}
+
+func (r *HardwareProfileStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.workloads = &workloadCounter{Reader: r.Reader, Targets: r.Client}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("hardwareprofile-status").
+		For(&infrav1.HardwareProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.allProfiles),
+			builder.WithPredicates(nodeSchedulingChanged()),
+		).
+		Complete(r)
+}
+
+func (r *HardwareProfileStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("HardwareProfileStatus")
+
+	hwp := &infrav1.HardwareProfile{}
+	if err := r.Client.Get(ctx, req.NamespacedName, hwp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := hwp.Status.DeepCopy()
+	status.ObservedGeneration = hwp.Generation
+
+	nodes := &corev1.NodeList{}
+	if err := r.Client.List(ctx, nodes); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	meta.SetStatusCondition(&status.Conditions, schedulableCondition(hwp, nodes.Items))
+
+	if queue := localQueueName(hwp); queue != "" {
+		cond, err := queueExistsCondition(ctx, r.Reader, hwp, queue)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		meta.SetStatusCondition(&status.Conditions, cond)
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, infrav1.HardwareProfileConditionQueueExists)
+	}
+
+	workloads, err := r.workloads.count(ctx, hwp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	status.Workloads = workloads
+	status.WorkloadCount = 0
+	for _, w := range workloads {
+		status.WorkloadCount += w.Count
+	}
+
+	if equality.Semantic.DeepEqual(&hwp.Status, status) {
+		return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+	}
+
+	hwp.Status = *status
+	if err := r.Client.Status().Update(ctx, hwp); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update status of HardwareProfile %s: %w", req.NamespacedName, err)
+	}
+
+	log.V(1).Info("Updated HardwareProfile status", "name", hwp.Name, "namespace", hwp.Namespace, "workloads", status.WorkloadCount)
+
+	return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+}
+
+// allProfiles enqueues every HardwareProfile, as a node change may affect any of them.
+func (r *HardwareProfileStatusReconciler) allProfiles(ctx context.Context, _ client.Object) []reconcile.Request {
+	profiles := &infrav1.HardwareProfileList{}
+	if err := r.Client.List(ctx, profiles); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to list HardwareProfiles")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(profiles.Items))
+	for _, hwp := range profiles.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: hwp.Name, Namespace: hwp.Namespace},
+		})
+	}
+
+	return requests
+}
+
+// nodeSchedulingChanged filters node events down to the changes that affect
+// whether a profile is schedulable, ignoring the frequent node status updates.
+func nodeSchedulingChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, ok := e.ObjectOld.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			newNode, ok := e.ObjectNew.(*corev1.Node)
+			if !ok {
+				return false
+			}
+
+			return !equality.Semantic.DeepEqual(oldNode.Labels, newNode.Labels) ||
+				!equality.Semantic.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) ||
+				oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+				!equality.Semantic.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
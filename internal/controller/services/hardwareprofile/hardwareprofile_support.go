@@ -0,0 +1,267 @@
+package hardwareprofile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Condition reasons.
+const (
+	reasonNodesAvailable     = "NodesAvailable"
+	reasonNoMatchingNodes    = "NoMatchingNodes"
+	reasonLocalQueueFound    = "LocalQueueFound"
+	reasonLocalQueueNotFound = "LocalQueueNotFound"
+	reasonKueueNotInstalled  = "KueueNotInstalled"
+)
+
+// acceleratorResourceType is the HardwareIdentifier resource type of accelerators.
+const acceleratorResourceType = "Accelerator"
+
+// workloadKinds are the built-in workloads that can reference a HardwareProfile through the
+// hardware profile annotations, in addition to the kinds declared by HardwareProfileTargets.
+// Each kind is listed once, through the first of its versions that is served.
+var workloadKinds = [][]schema.GroupVersionKind{
+	{gvk.Notebook},
+	{gvk.InferenceServices},
+	{gvk.LLMInferenceServiceV1Alpha2, gvk.LLMInferenceServiceV1Alpha1},
+}
+
+// schedulableCondition returns the Schedulable condition of hwp given the cluster nodes.
+func schedulableCondition(hwp *infrav1.HardwareProfile, nodes []corev1.Node) metav1.Condition {
+	matching := 0
+	for i := range nodes {
+		if nodeMatches(hwp, &nodes[i]) {
+			matching++
+		}
+	}
+
+	if matching == 0 {
+		return metav1.Condition{
+			Type:               infrav1.HardwareProfileConditionSchedulable,
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonNoMatchingNodes,
+			Message:            "No node matches the node selector and tolerations of the profile and provides its accelerators",
+			ObservedGeneration: hwp.Generation,
+		}
+	}
+
+	return metav1.Condition{
+		Type:               infrav1.HardwareProfileConditionSchedulable,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonNodesAvailable,
+		Message:            fmt.Sprintf("%d node(s) match the profile", matching),
+		ObservedGeneration: hwp.Generation,
+	}
+}
+
+// nodeMatches reports whether workloads using hwp can be scheduled on node:
+// the node is schedulable, matches the node selector, its NoSchedule and
+// NoExecute taints are tolerated, and it has allocatable accelerators.
+//
+// Profiles using Queue scheduling get their node placement and tolerations from
+// the Kueue ResourceFlavors, so only their accelerators are checked.
+func nodeMatches(hwp *infrav1.HardwareProfile, node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+
+	for _, id := range hwp.Spec.Identifiers {
+		if id.ResourceType != acceleratorResourceType {
+			continue
+		}
+
+		allocatable, ok := node.Status.Allocatable[corev1.ResourceName(id.Identifier)]
+		if !ok || allocatable.IsZero() {
+			return false
+		}
+	}
+
+	scheduling := hwp.Spec.SchedulingSpec
+	switch {
+	case scheduling != nil && scheduling.SchedulingType == infrav1.QueueScheduling:
+		return true
+	case scheduling != nil && scheduling.Node != nil:
+		if !labels.SelectorFromSet(scheduling.Node.NodeSelector).Matches(labels.Set(node.Labels)) {
+			return false
+		}
+
+		return !hasBlockingTaint(node, scheduling.Node.Tolerations)
+	default:
+		return !hasBlockingTaint(node, nil)
+	}
+}
+
+// hasBlockingTaint reports whether node has a NoSchedule or NoExecute taint not tolerated by tolerations.
+func hasBlockingTaint(node *corev1.Node, tolerations []corev1.Toleration) bool {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		if !slices.ContainsFunc(tolerations, func(t corev1.Toleration) bool {
+			return t.ToleratesTaint(taint)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// localQueueName returns the LocalQueue referenced by hwp, or an empty string
+// if it does not use Queue scheduling.
+func localQueueName(hwp *infrav1.HardwareProfile) string {
+	scheduling := hwp.Spec.SchedulingSpec
+	if scheduling == nil || scheduling.SchedulingType != infrav1.QueueScheduling || scheduling.Kueue == nil {
+		return ""
+	}
+
+	return scheduling.Kueue.LocalQueueName
+}
+
+// queueExistsCondition returns the QueueExists condition of hwp, which references the LocalQueue queue.
+func queueExistsCondition(ctx context.Context, cli client.Reader, hwp *infrav1.HardwareProfile, queue string) (metav1.Condition, error) {
+	cond := metav1.Condition{
+		Type:               infrav1.HardwareProfileConditionQueueExists,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonLocalQueueFound,
+		Message:            fmt.Sprintf("LocalQueue %s exists", queue),
+		ObservedGeneration: hwp.Generation,
+	}
+
+	err := cli.Get(ctx, client.ObjectKey{Name: queue, Namespace: hwp.Namespace}, resources.GvkToPartial(gvk.LocalQueue))
+	switch {
+	case meta.IsNoMatchError(err):
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonKueueNotInstalled
+		cond.Message = "The LocalQueue API is not available"
+	case k8serr.IsNotFound(err):
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonLocalQueueNotFound
+		cond.Message = fmt.Sprintf("LocalQueue %s not found in namespace %s", queue, hwp.Namespace)
+	case err != nil:
+		return metav1.Condition{}, fmt.Errorf("failed to get LocalQueue %s/%s: %w", hwp.Namespace, queue, err)
+	}
+
+	return cond, nil
+}
+
+// workloadCounter counts the workloads referencing each HardwareProfile. The workloads are
+// listed cluster-wide at most once per resync period, and the counts of all the profiles
+// are computed from the same listing.
+type workloadCounter struct {
+	// Reader lists the workloads, which live in any namespace and are not cached by the manager.
+	Reader client.Reader
+	// Targets lists the HardwareProfileTargets declaring additional workload kinds.
+	Targets client.Reader
+
+	mu       sync.Mutex
+	listedAt time.Time
+	counts   map[types.NamespacedName][]infrav1.HardwareProfileWorkloads
+}
+
+// count returns the workloads referencing hwp, by kind and namespace, listing the
+// workloads again if the counts are older than the resync period.
+func (c *workloadCounter) count(ctx context.Context, hwp *infrav1.HardwareProfile) ([]infrav1.HardwareProfileWorkloads, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts == nil || time.Since(c.listedAt) >= resyncPeriod {
+		counts, err := c.countAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.counts = counts
+		c.listedAt = time.Now()
+	}
+
+	return slices.Clone(c.counts[client.ObjectKeyFromObject(hwp)]), nil
+}
+
+// countAll counts the workloads referencing each HardwareProfile, by kind and namespace.
+// Workload kinds whose API is not installed are skipped.
+func (c *workloadCounter) countAll(ctx context.Context) (map[types.NamespacedName][]infrav1.HardwareProfileWorkloads, error) {
+	kinds := slices.Clone(workloadKinds)
+
+	targets := &infrav1.HardwareProfileTargetList{}
+	if err := c.Targets.List(ctx, targets); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to list HardwareProfileTargets: %w", err)
+	}
+	for i := range targets.Items {
+		kinds = append(kinds, []schema.GroupVersionKind{targets.Items[i].Spec.GroupVersionKind()})
+	}
+
+	listed := map[schema.GroupKind]bool{}
+	counts := map[types.NamespacedName]map[infrav1.HardwareProfileWorkloads]int32{}
+
+	for _, versions := range kinds {
+		for _, kind := range versions {
+			if listed[kind.GroupKind()] {
+				break
+			}
+
+			list := &metav1.PartialObjectMetadataList{}
+			list.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+
+			if err := c.Reader.List(ctx, list); err != nil {
+				if meta.IsNoMatchError(err) {
+					continue
+				}
+
+				return nil, fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+			}
+
+			listed[kind.GroupKind()] = true
+
+			for i := range list.Items {
+				ref, ok := infrav1.HardwareProfileReference(&list.Items[i])
+				if !ok {
+					continue
+				}
+
+				if counts[ref] == nil {
+					counts[ref] = map[infrav1.HardwareProfileWorkloads]int32{}
+				}
+				counts[ref][infrav1.HardwareProfileWorkloads{Kind: kind.Kind, Namespace: list.Items[i].Namespace}]++
+			}
+
+			break
+		}
+	}
+
+	result := make(map[types.NamespacedName][]infrav1.HardwareProfileWorkloads, len(counts))
+	for ref, byKind := range counts {
+		workloads := make([]infrav1.HardwareProfileWorkloads, 0, len(byKind))
+		for key, count := range byKind {
+			key.Count = count
+			workloads = append(workloads, key)
+		}
+
+		slices.SortFunc(workloads, func(a, b infrav1.HardwareProfileWorkloads) int {
+			return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace))
+		})
+
+		result[ref] = workloads
+	}
+
+	return result, nil
+}
@@ -0,0 +1,212 @@
+//nolint:testpackage // white-box tests for unexported status helpers
+package hardwareprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/gomega"
+)
+
+const gpu = "nvidia.com/gpu"
+
+func gpuNode(name string, gpus int64, nodeLabels map[string]string, taints ...corev1.Taint) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels},
+		Spec:       corev1.NodeSpec{Taints: taints},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{gpu: *resource.NewQuantity(gpus, resource.DecimalSI)},
+		},
+	}
+}
+
+func gpuProfile(scheduling *infrav1.SchedulingSpec) *infrav1.HardwareProfile {
+	return &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "profiles", Generation: 3},
+		Spec: infrav1.HardwareProfileSpec{
+			Identifiers:    []infrav1.HardwareIdentifier{{Identifier: gpu, ResourceType: acceleratorResourceType}},
+			SchedulingSpec: scheduling,
+		},
+	}
+}
+
+func TestNodeMatches(t *testing.T) {
+	gpuTaint := corev1.Taint{Key: gpu, Effect: corev1.TaintEffectNoSchedule}
+	nodeScheduling := &infrav1.SchedulingSpec{
+		SchedulingType: infrav1.NodeScheduling,
+		Node: &infrav1.NodeSchedulingSpec{
+			NodeSelector: map[string]string{"accelerator": "a100"},
+			Tolerations:  []corev1.Toleration{{Key: gpu, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+		},
+	}
+	queueScheduling := &infrav1.SchedulingSpec{
+		SchedulingType: infrav1.QueueScheduling,
+		Kueue:          &infrav1.KueueSchedulingSpec{LocalQueueName: "default"},
+	}
+
+	tests := []struct {
+		name    string
+		hwp     *infrav1.HardwareProfile
+		node    corev1.Node
+		matches bool
+	}{
+		{
+			name:    "matching selector with tolerated taint",
+			hwp:     gpuProfile(nodeScheduling),
+			node:    gpuNode("a", 8, map[string]string{"accelerator": "a100"}, gpuTaint),
+			matches: true,
+		},
+		{
+			name: "selector mismatch",
+			hwp:  gpuProfile(nodeScheduling),
+			node: gpuNode("a", 8, map[string]string{"accelerator": "t4"}),
+		},
+		{
+			name: "no allocatable accelerator",
+			hwp:  gpuProfile(nodeScheduling),
+			node: gpuNode("a", 0, map[string]string{"accelerator": "a100"}),
+		},
+		{
+			name: "untolerated taint",
+			hwp:  gpuProfile(nil),
+			node: gpuNode("a", 8, nil, gpuTaint),
+		},
+		{
+			name:    "PreferNoSchedule taint does not block",
+			hwp:     gpuProfile(nil),
+			node:    gpuNode("a", 8, nil, corev1.Taint{Key: gpu, Effect: corev1.TaintEffectPreferNoSchedule}),
+			matches: true,
+		},
+		{
+			name:    "queue scheduling ignores taints",
+			hwp:     gpuProfile(queueScheduling),
+			node:    gpuNode("a", 8, nil, gpuTaint),
+			matches: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nodeMatches(tt.hwp, &tt.node)).To(Equal(tt.matches))
+		})
+	}
+}
+
+func TestSchedulableCondition(t *testing.T) {
+	g := NewWithT(t)
+	hwp := gpuProfile(nil)
+
+	cond := schedulableCondition(hwp, []corev1.Node{gpuNode("a", 8, nil), gpuNode("b", 0, nil), gpuNode("c", 1, nil)})
+	g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(cond.Message).To(Equal("2 node(s) match the profile"))
+	g.Expect(cond.ObservedGeneration).To(Equal(hwp.Generation))
+
+	cond = schedulableCondition(hwp, nil)
+	g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(cond.Reason).To(Equal(reasonNoMatchingNodes))
+}
+
+// workloadReader serves PartialObjectMetadata lists of the workloads of each kind, and
+// reports the kinds it has no workloads for as not installed.
+type workloadReader struct {
+	workloads map[schema.GroupVersionKind][]metav1.PartialObjectMetadata
+	targets   []infrav1.HardwareProfileTarget
+	lists     int
+}
+
+func (r *workloadReader) Get(_ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return errors.New("not implemented")
+}
+
+func (r *workloadReader) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	switch l := list.(type) {
+	case *infrav1.HardwareProfileTargetList:
+		l.Items = r.targets
+	case *metav1.PartialObjectMetadataList:
+		listGVK := l.GroupVersionKind()
+		kind := listGVK.GroupVersion().WithKind(strings.TrimSuffix(listGVK.Kind, "List"))
+
+		items, ok := r.workloads[kind]
+		if !ok {
+			return &meta.NoKindMatchError{GroupKind: kind.GroupKind(), SearchedVersions: []string{kind.Version}}
+		}
+
+		r.lists++
+		l.Items = items
+	default:
+		return fmt.Errorf("unexpected list %T", list)
+	}
+
+	return nil
+}
+
+func TestWorkloadCounter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rayCluster := schema.GroupVersionKind{Group: "ray.io", Version: "v1", Kind: "RayCluster"}
+
+	workload := func(namespace string, profileAnnotations map[string]string) metav1.PartialObjectMetadata {
+		return metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{Name: "w", Namespace: namespace, Annotations: profileAnnotations},
+		}
+	}
+	explicit := map[string]string{
+		annotations.HardwareProfileName:      "gpu",
+		annotations.HardwareProfileNamespace: "profiles",
+	}
+
+	reader := &workloadReader{
+		workloads: map[schema.GroupVersionKind][]metav1.PartialObjectMetadata{
+			gvk.Notebook: {
+				workload("user", explicit),
+				workload("user", explicit),
+				workload("profiles", map[string]string{annotations.HardwareProfileName: "gpu"}),
+				workload("user", map[string]string{annotations.HardwareProfileName: "gpu"}),
+				workload("user", nil),
+			},
+			// Only the v1alpha1 LLMInferenceService API is served.
+			gvk.LLMInferenceServiceV1Alpha1: {workload("user", explicit)},
+			rayCluster:                      {workload("ray", explicit)},
+		},
+		targets: []infrav1.HardwareProfileTarget{*target("rayclusters", rayCluster)},
+	}
+
+	counter := &workloadCounter{Reader: reader, Targets: reader}
+
+	workloads, err := counter.count(ctx, gpuProfile(nil))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(workloads).To(Equal([]infrav1.HardwareProfileWorkloads{
+		{Kind: gvk.LLMInferenceServiceV1Alpha1.Kind, Namespace: "user", Count: 1},
+		{Kind: gvk.Notebook.Kind, Namespace: "profiles", Count: 1},
+		{Kind: gvk.Notebook.Kind, Namespace: "user", Count: 2},
+		{Kind: rayCluster.Kind, Namespace: "ray", Count: 1},
+	}))
+	g.Expect(reader.lists).To(Equal(3))
+
+	// The profile referenced from the user namespace is counted from the same listing.
+	other := gpuProfile(nil)
+	other.Namespace = "user"
+
+	workloads, err = counter.count(ctx, other)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(workloads).To(Equal([]infrav1.HardwareProfileWorkloads{
+		{Kind: gvk.Notebook.Kind, Namespace: "user", Count: 1},
+	}))
+	g.Expect(reader.lists).To(Equal(3))
+}
@@ -0,0 +1,260 @@
+package hardwareprofile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	hwpwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// defaultPropagationBatchSize is the number of workloads updated at once by the Batched
+// strategy when the profile does not set a batch size.
+const defaultPropagationBatchSize = 10
+
+// propagationBatchInterval is the delay between two batches of workload updates, and
+// before retrying the workloads modified concurrently.
+const propagationBatchInterval = 30 * time.Second
+
+// maxPropagationReferences caps the number of workloads listed in the propagation status.
+const maxPropagationReferences = 50
+
+// Propagated condition reasons.
+const (
+	reasonPropagated        = "Propagated"
+	reasonPropagating       = "Propagating"
+	reasonPropagationFailed = "PropagationFailed"
+)
+
+// propagatedKinds are the built-in workload kinds the profile changes are propagated to, in
+// addition to the kinds declared by HardwareProfileTargets. LLMInferenceServices are listed
+// once, through their oldest served version.
+var propagatedKinds = []schema.GroupVersionKind{
+	gvk.Notebook,
+	gvk.InferenceServices,
+	gvk.LLMInferenceServiceV1Alpha1,
+}
+
+// PropagationReconciler applies the changes of HardwareProfiles to the existing workloads
+// referencing them, according to the propagation strategy of each profile. The settings of
+// the previously propagated spec are removed from the workloads and those of the current
+// spec applied, with the logic the Injector uses when a workload switches profiles.
+type PropagationReconciler struct {
+	Client client.Client
+	// Reader reads the workloads, which live in any namespace and are not cached by the manager.
+	Reader   client.Reader
+	Injector *hwpwebhook.Injector
+}
+
+func (r *PropagationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("hardwareprofile-propagation").
+		For(&infrav1.HardwareProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+func (r *PropagationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("HardwareProfilePropagation")
+
+	hwp := &infrav1.HardwareProfile{}
+	if err := r.Client.Get(ctx, req.NamespacedName, hwp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	current := propagatedSpec(hwp)
+	status := hwp.Status.Propagation.DeepCopy()
+
+	switch {
+	case propagationStrategy(hwp) == infrav1.NonePropagation:
+		// Propagation is opt-in: forget the propagated spec once it is disabled.
+		if status == nil {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, r.patchStatus(ctx, hwp, nil, nil)
+	case status != nil && status.PropagatedGeneration == hwp.Generation:
+		return ctrl.Result{}, nil
+	case status == nil || status.PropagatedSpec == nil,
+		equality.Semantic.DeepEqual(status.PropagatedSpec, current):
+		// The workloads were last injected with the current settings, or the propagation
+		// was just enabled and the current settings are the baseline of later changes.
+		status = &infrav1.HardwareProfilePropagationStatus{Generation: hwp.Generation}
+		completePropagation(status, hwp, current)
+
+		return ctrl.Result{}, r.patchStatus(ctx, hwp, status, propagatedCondition(hwp, status, false))
+	}
+
+	if status.Generation != hwp.Generation {
+		status.Generation = hwp.Generation
+		status.Updated = nil
+		status.Failed = nil
+	}
+
+	pending, err := r.propagate(ctx, hwp, status)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !pending {
+		completePropagation(status, hwp, current)
+	}
+
+	if err := r.patchStatus(ctx, hwp, status, propagatedCondition(hwp, status, pending)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Propagated HardwareProfile changes", "name", hwp.Name, "namespace", hwp.Namespace,
+		"generation", hwp.Generation, "updated", len(status.Updated), "failed", len(status.Failed), "pending", pending)
+
+	if pending {
+		return ctrl.Result{RequeueAfter: propagationBatchInterval}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// propagate updates a batch of the workloads referencing hwp, recording them in status, and
+// reports whether workloads are left to update.
+func (r *PropagationReconciler) propagate(ctx context.Context, hwp *infrav1.HardwareProfile, status *infrav1.HardwareProfilePropagationStatus) (bool, error) {
+	workloads, err := r.referencingWorkloads(ctx, hwp)
+	if err != nil {
+		return false, err
+	}
+
+	budget := len(workloads)
+	if propagationStrategy(hwp) == infrav1.BatchedPropagation {
+		budget = int(cmp.Or(hwp.Spec.Propagation.BatchSize, defaultPropagationBatchSize))
+	}
+
+	pending := false
+	for _, obj := range workloads {
+		ref := infrav1.HardwareProfileWorkloadReference{Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+		updated := obj.DeepCopy()
+		if err := r.Injector.ReapplyHardwareProfile(ctx, updated, status.PropagatedSpec, hwp); err != nil {
+			recordFailure(status, ref, err)
+			continue
+		}
+
+		if equality.Semantic.DeepEqual(obj, updated) {
+			// Already up to date, possibly by a previous batch or a webhook admission.
+			forgetFailure(status, ref)
+			continue
+		}
+
+		if budget == 0 {
+			pending = true
+			break
+		}
+
+		if err := r.Client.Update(ctx, updated); err != nil {
+			if k8serr.IsConflict(err) {
+				// Modified concurrently, retried with the next batch.
+				pending = true
+				continue
+			}
+
+			recordFailure(status, ref, err)
+			continue
+		}
+
+		budget--
+		forgetFailure(status, ref)
+		if len(status.Updated) < maxPropagationReferences && !slices.Contains(status.Updated, ref) {
+			status.Updated = append(status.Updated, ref)
+		}
+	}
+
+	return pending, nil
+}
+
+// referencingWorkloads returns the workloads referencing hwp, sorted by kind, namespace and name.
+// Workload kinds whose API is not installed are skipped.
+func (r *PropagationReconciler) referencingWorkloads(ctx context.Context, hwp *infrav1.HardwareProfile) ([]*unstructured.Unstructured, error) {
+	kinds := slices.Clone(propagatedKinds)
+
+	targets := &infrav1.HardwareProfileTargetList{}
+	if err := r.Client.List(ctx, targets); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to list HardwareProfileTargets: %w", err)
+	}
+	for idx := range targets.Items {
+		if kind := targets.Items[idx].Spec.GroupVersionKind(); !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	var workloads []*unstructured.Unstructured
+	for _, kind := range kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+
+		if err := r.Reader.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+		}
+
+		for idx := range list.Items {
+			if ref, ok := infrav1.HardwareProfileReference(&list.Items[idx]); ok && ref == client.ObjectKeyFromObject(hwp) {
+				workloads = append(workloads, &list.Items[idx])
+			}
+		}
+	}
+
+	slices.SortStableFunc(workloads, func(a, b *unstructured.Unstructured) int {
+		return cmp.Or(
+			cmp.Compare(a.GetKind(), b.GetKind()),
+			cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+			cmp.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	return workloads, nil
+}
+
+// patchStatus sets the propagation status and Propagated condition of hwp, removing the
+// condition when cond is nil.
+func (r *PropagationReconciler) patchStatus(
+	ctx context.Context,
+	hwp *infrav1.HardwareProfile,
+	status *infrav1.HardwareProfilePropagationStatus,
+	cond *metav1.Condition,
+) error {
+	original := hwp.DeepCopy()
+
+	hwp.Status.Propagation = status
+	if cond != nil {
+		meta.SetStatusCondition(&hwp.Status.Conditions, *cond)
+	} else {
+		meta.RemoveStatusCondition(&hwp.Status.Conditions, infrav1.HardwareProfileConditionPropagated)
+	}
+
+	if equality.Semantic.DeepEqual(&original.Status, &hwp.Status) {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})
+	if err := r.Client.Status().Patch(ctx, hwp, patch); err != nil {
+		return fmt.Errorf("failed to update status of HardwareProfile %s/%s: %w", hwp.Namespace, hwp.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package hardwareprofile
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+)
+
+// propagationStrategy returns the propagation strategy of hwp, None when unset.
+func propagationStrategy(hwp *infrav1.HardwareProfile) infrav1.PropagationStrategy {
+	if hwp.Spec.Propagation == nil || hwp.Spec.Propagation.Strategy == "" {
+		return infrav1.NonePropagation
+	}
+
+	return hwp.Spec.Propagation.Strategy
+}
+
+// propagatedSpec returns the settings of hwp applied to workloads, without its propagation spec.
+func propagatedSpec(hwp *infrav1.HardwareProfile) *infrav1.HardwareProfileSpec {
+	spec := hwp.Spec.DeepCopy()
+	spec.Propagation = nil
+
+	return spec
+}
+
+// completePropagation records spec as propagated to all the workloads referencing hwp.
+func completePropagation(status *infrav1.HardwareProfilePropagationStatus, hwp *infrav1.HardwareProfile, spec *infrav1.HardwareProfileSpec) {
+	status.PropagatedGeneration = hwp.Generation
+	status.PropagatedSpec = spec
+}
+
+// propagatedCondition returns the Propagated condition of hwp for status.
+func propagatedCondition(hwp *infrav1.HardwareProfile, status *infrav1.HardwareProfilePropagationStatus, pending bool) *metav1.Condition {
+	cond := &metav1.Condition{
+		Type:               infrav1.HardwareProfileConditionPropagated,
+		ObservedGeneration: hwp.Generation,
+	}
+
+	switch {
+	case pending:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonPropagating
+		cond.Message = fmt.Sprintf("%d workload(s) updated so far", len(status.Updated))
+	case len(status.Failed) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonPropagationFailed
+		cond.Message = fmt.Sprintf("The profile could not be applied to %d workload(s)", len(status.Failed))
+	default:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = reasonPropagated
+		cond.Message = fmt.Sprintf("%d workload(s) updated", len(status.Updated))
+	}
+
+	return cond
+}
+
+// recordFailure records that the profile could not be applied to the workload ref.
+func recordFailure(status *infrav1.HardwareProfilePropagationStatus, ref infrav1.HardwareProfileWorkloadReference, err error) {
+	for idx := range status.Failed {
+		if status.Failed[idx].HardwareProfileWorkloadReference == ref {
+			status.Failed[idx].Message = err.Error()
+			return
+		}
+	}
+
+	if len(status.Failed) < maxPropagationReferences {
+		status.Failed = append(status.Failed, infrav1.HardwareProfileWorkloadFailure{
+			HardwareProfileWorkloadReference: ref,
+			Message:                          err.Error(),
+		})
+	}
+}
+
+// forgetFailure removes the failure recorded for the workload ref, once it is up to date.
+func forgetFailure(status *infrav1.HardwareProfilePropagationStatus, ref infrav1.HardwareProfileWorkloadReference) {
+	status.Failed = slices.DeleteFunc(status.Failed, func(f infrav1.HardwareProfileWorkloadFailure) bool {
+		return f.HardwareProfileWorkloadReference == ref
+	})
+}
@@ -0,0 +1,239 @@
+//nolint:testpackage // white-box tests for the unexported propagation helpers
+package hardwareprofile
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	hwpwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func propagatedProfileSpec(count int32, taint string) infrav1.HardwareProfileSpec {
+	return infrav1.HardwareProfileSpec{
+		Identifiers: []infrav1.HardwareIdentifier{
+			{Identifier: gpu, DefaultCount: intstr.FromInt32(count), ResourceType: acceleratorResourceType},
+		},
+		SchedulingSpec: &infrav1.SchedulingSpec{
+			SchedulingType: infrav1.NodeScheduling,
+			Node: &infrav1.NodeSchedulingSpec{
+				Tolerations: []corev1.Toleration{{Key: taint, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+			},
+		},
+	}
+}
+
+func inferenceService(name, profile, gpus string) *unstructured.Unstructured {
+	obj := resources.GvkToUnstructured(gvk.InferenceServices)
+	obj.SetName(name)
+	obj.SetNamespace("user")
+	obj.SetAnnotations(map[string]string{annotations.HardwareProfileName: profile})
+	obj.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"model": map[string]any{
+				"name": "model",
+				"resources": map[string]any{
+					"requests": map[string]any{gpu: gpus},
+					"limits":   map[string]any{gpu: gpus},
+				},
+			},
+		},
+	}
+
+	return obj
+}
+
+func notebook(name, profile, gpus string) *unstructured.Unstructured {
+	obj := resources.GvkToUnstructured(gvk.Notebook)
+	obj.SetName(name)
+	obj.SetNamespace("user")
+	obj.SetAnnotations(map[string]string{annotations.HardwareProfileName: profile})
+	obj.Object["spec"] = map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"containers": []any{
+					map[string]any{
+						"name": name,
+						"resources": map[string]any{
+							"requests": map[string]any{gpu: gpus},
+							"limits":   map[string]any{gpu: gpus},
+						},
+					},
+				},
+				"tolerations": []any{
+					map[string]any{"key": "old", "operator": "Exists", "effect": "NoSchedule"},
+				},
+			},
+		},
+	}
+
+	return obj
+}
+
+func TestPropagationReconcilerBatched(t *testing.T) {
+	g := NewWithT(t)
+
+	sch := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+
+	previous := propagatedProfileSpec(1, "old")
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "user", Generation: 2},
+		Spec:       propagatedProfileSpec(2, "new"),
+		Status: infrav1.HardwareProfileStatus{
+			Propagation: &infrav1.HardwareProfilePropagationStatus{
+				PropagatedGeneration: 1,
+				PropagatedSpec:       &previous,
+				Generation:           1,
+			},
+		},
+	}
+	hwp.Spec.Propagation = &infrav1.PropagationSpec{Strategy: infrav1.BatchedPropagation, BatchSize: 2}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(
+			hwp,
+			inferenceService("a", "gpu", "1"),
+			inferenceService("b", "gpu", "1"),
+			inferenceService("c", "gpu", "1"),
+			inferenceService("other", "cpu", "1"),
+		).
+		WithStatusSubresource(&infrav1.HardwareProfile{}).
+		Build()
+
+	r := &PropagationReconciler{Client: cli, Reader: cli, Injector: &hwpwebhook.Injector{Targets: cli}}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpu", Namespace: "user"}}
+
+	result, err := r.Reconcile(t.Context(), req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(propagationBatchInterval))
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKeyFromObject(hwp), hwp)).To(Succeed())
+	g.Expect(hwp.Status.Propagation.PropagatedGeneration).To(Equal(int64(1)))
+	g.Expect(hwp.Status.Propagation.Generation).To(Equal(int64(2)))
+	g.Expect(hwp.Status.Propagation.Updated).To(HaveLen(2))
+
+	result, err = r.Reconcile(t.Context(), req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKeyFromObject(hwp), hwp)).To(Succeed())
+	g.Expect(hwp.Status.Propagation.PropagatedGeneration).To(Equal(int64(2)))
+	g.Expect(hwp.Status.Propagation.PropagatedSpec.Identifiers[0].DefaultCount).To(Equal(intstr.FromInt32(2)))
+	g.Expect(hwp.Status.Propagation.Updated).To(HaveLen(3))
+	g.Expect(hwp.Status.Propagation.Failed).To(BeEmpty())
+
+	for _, name := range []string{"a", "b", "c"} {
+		obj := resources.GvkToUnstructured(gvk.InferenceServices)
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: name, Namespace: "user"}, obj)).To(Succeed())
+
+		limits, _, err := unstructured.NestedStringMap(obj.Object, "spec", "predictor", "model", "resources", "limits")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(limits).To(HaveKeyWithValue(gpu, "2"))
+	}
+
+	other := resources.GvkToUnstructured(gvk.InferenceServices)
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "other", Namespace: "user"}, other)).To(Succeed())
+	limits, _, err := unstructured.NestedStringMap(other.Object, "spec", "predictor", "model", "resources", "limits")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(limits).To(HaveKeyWithValue(gpu, "1"))
+}
+
+func TestPropagationReconcilerBaseline(t *testing.T) {
+	g := NewWithT(t)
+
+	sch := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "user", Generation: 1},
+		Spec:       propagatedProfileSpec(1, "old"),
+	}
+	hwp.Spec.Propagation = &infrav1.PropagationSpec{Strategy: infrav1.ImmediatePropagation}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(hwp, inferenceService("a", "gpu", "1")).
+		WithStatusSubresource(&infrav1.HardwareProfile{}).
+		Build()
+
+	r := &PropagationReconciler{Client: cli, Reader: cli, Injector: &hwpwebhook.Injector{Targets: cli}}
+
+	_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpu", Namespace: "user"}})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKeyFromObject(hwp), hwp)).To(Succeed())
+	g.Expect(hwp.Status.Propagation.PropagatedGeneration).To(Equal(int64(1)))
+	g.Expect(hwp.Status.Propagation.PropagatedSpec.Propagation).To(BeNil())
+	g.Expect(hwp.Status.Propagation.Updated).To(BeEmpty())
+	g.Expect(hwp.Status.Conditions).To(ContainElement(And(
+		HaveField("Type", infrav1.HardwareProfileConditionPropagated),
+		HaveField("Status", metav1.ConditionTrue),
+	)))
+}
+
+func TestPropagationReconcilerNotebooks(t *testing.T) {
+	g := NewWithT(t)
+
+	sch := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+
+	previous := propagatedProfileSpec(1, "old")
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "user", Generation: 2},
+		Spec:       propagatedProfileSpec(2, "new"),
+		Status: infrav1.HardwareProfileStatus{
+			Propagation: &infrav1.HardwareProfilePropagationStatus{
+				PropagatedGeneration: 1,
+				PropagatedSpec:       &previous,
+				Generation:           1,
+			},
+		},
+	}
+	hwp.Spec.Propagation = &infrav1.PropagationSpec{Strategy: infrav1.ImmediatePropagation}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(hwp, notebook("workbench", "gpu", "1")).
+		WithStatusSubresource(&infrav1.HardwareProfile{}).
+		Build()
+
+	r := &PropagationReconciler{Client: cli, Reader: cli, Injector: &hwpwebhook.Injector{Targets: cli}}
+
+	_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpu", Namespace: "user"}})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKeyFromObject(hwp), hwp)).To(Succeed())
+	g.Expect(hwp.Status.Propagation.PropagatedGeneration).To(Equal(int64(2)))
+	g.Expect(hwp.Status.Propagation.Failed).To(BeEmpty())
+	g.Expect(hwp.Status.Propagation.Updated).To(ConsistOf(infrav1.HardwareProfileWorkloadReference{
+		Kind: gvk.Notebook.Kind, Namespace: "user", Name: "workbench",
+	}))
+
+	obj := resources.GvkToUnstructured(gvk.Notebook)
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "workbench", Namespace: "user"}, obj)).To(Succeed())
+
+	containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(containers).To(HaveLen(1))
+	g.Expect(containers[0]).To(HaveKeyWithValue("resources", HaveKeyWithValue("limits", HaveKeyWithValue(gpu, "2"))))
+
+	tolerations, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "tolerations")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tolerations).To(ConsistOf(HaveKeyWithValue("key", "new")))
+}
@@ -0,0 +1,244 @@
+package hardwareprofile
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// HardwareProfileTargetReconciler registers the workload kinds declared by
+// HardwareProfileTargets with the hardware profile webhook, through a
+// MutatingWebhookConfiguration shared by all the targets and owned by the
+// targets it registers, so that it is removed with the last of them.
+type HardwareProfileTargetReconciler struct {
+	Client     client.Client
+	RESTMapper meta.RESTMapper
+	// WebhookServer is the webhook server of the operator. The workload kinds are only
+	// registered while it serves the hardware profile webhook, i.e. not in builds
+	// without webhooks.
+	WebhookServer webhook.Server
+}
+
+func (r *HardwareProfileTargetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("hardwareprofile-targets").
+		Watches(
+			&infrav1.HardwareProfileTarget{},
+			handler.EnqueueRequestsFromMapFunc(webhookConfigurationRequest),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&admissionregistrationv1.MutatingWebhookConfiguration{},
+			handler.EnqueueRequestsFromMapFunc(webhookConfigurationRequest),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == webhookConfigurationName
+			})),
+		).
+		Complete(r)
+}
+
+// webhookConfigurationRequest maps every event to the single reconcile request of the
+// MutatingWebhookConfiguration, as it depends on all the targets.
+func webhookConfigurationRequest(context.Context, client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: webhookConfigurationName}}}
+}
+
+func (r *HardwareProfileTargetReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("HardwareProfileTarget")
+
+	targets := &infrav1.HardwareProfileTargetList{}
+	if err := r.Client.List(ctx, targets); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list HardwareProfileTargets: %w", err)
+	}
+
+	// The webhook uses the first target by name when several declare the same kind.
+	slices.SortFunc(targets.Items, func(a, b infrav1.HardwareProfileTarget) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	statuses := make([]*infrav1.HardwareProfileTargetStatus, len(targets.Items))
+	declaredBy := map[schema.GroupVersionKind]string{}
+
+	var rules []admissionregistrationv1.RuleWithOperations
+	var owners []metav1.OwnerReference
+	for idx := range targets.Items {
+		target := &targets.Items[idx]
+
+		status, rule, err := r.resolve(target, declaredBy)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if rule != nil {
+			rules = append(rules, *rule)
+			owners = append(owners, targetOwnerReference(target))
+			declaredBy[target.Spec.GroupVersionKind()] = target.Name
+		}
+
+		statuses[idx] = status
+	}
+
+	if err := r.applyWebhookConfiguration(ctx, rules, owners); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	for idx := range targets.Items {
+		target := &targets.Items[idx]
+		if equality.Semantic.DeepEqual(&target.Status, statuses[idx]) {
+			continue
+		}
+
+		target.Status = *statuses[idx]
+		if err := r.Client.Status().Update(ctx, target); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update status of HardwareProfileTarget %s: %w", target.Name, err)
+		}
+	}
+
+	log.V(1).Info("Registered HardwareProfileTargets with the hardware profile webhook", "targets", len(targets.Items), "rules", len(rules))
+
+	// Re-check the targets whose kind is not served yet, as CRDs are not watched.
+	return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+}
+
+// resolve returns the status of target and, when its kind is served by the cluster and
+// not declared by another target, the webhook rule matching its workloads.
+func (r *HardwareProfileTargetReconciler) resolve(
+	target *infrav1.HardwareProfileTarget,
+	declaredBy map[schema.GroupVersionKind]string,
+) (*infrav1.HardwareProfileTargetStatus, *admissionregistrationv1.RuleWithOperations, error) {
+	status := target.Status.DeepCopy()
+	status.ObservedGeneration = target.Generation
+	status.Resource = ""
+
+	cond := metav1.Condition{
+		Type:               infrav1.HardwareProfileTargetConditionReady,
+		ObservedGeneration: target.Generation,
+	}
+
+	if !r.servesWebhook() {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonWebhookNotServed
+		cond.Message = "The hardware profile webhook is not served by the operator"
+		meta.SetStatusCondition(&status.Conditions, cond)
+
+		return status, nil, nil
+	}
+
+	kind := target.Spec.GroupVersionKind()
+	if owner, ok := declaredBy[kind]; ok {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonDuplicateKind
+		cond.Message = fmt.Sprintf("%s is already declared by HardwareProfileTarget %s", kind, owner)
+		meta.SetStatusCondition(&status.Conditions, cond)
+
+		return status, nil, nil
+	}
+
+	mapping, err := r.RESTMapper.RESTMapping(kind.GroupKind(), kind.Version)
+	switch {
+	case meta.IsNoMatchError(err):
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonKindNotFound
+		cond.Message = fmt.Sprintf("%s is not served by the cluster", kind)
+		meta.SetStatusCondition(&status.Conditions, cond)
+
+		return status, nil, nil
+	case err != nil:
+		return nil, nil, fmt.Errorf("failed to resolve the resource of %s: %w", kind, err)
+	}
+
+	status.Resource = mapping.Resource.Resource
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = reasonRegistered
+	cond.Message = fmt.Sprintf("%s is registered with the hardware profile webhook", mapping.Resource.GroupResource())
+	meta.SetStatusCondition(&status.Conditions, cond)
+
+	return status, webhookRule(mapping), nil
+}
+
+// servesWebhook reports whether the webhook server of the operator serves the hardware profile webhook.
+func (r *HardwareProfileTargetReconciler) servesWebhook() bool {
+	if r.WebhookServer == nil {
+		return false
+	}
+
+	// The mux is only created when the first webhook is registered.
+	mux := r.WebhookServer.WebhookMux()
+	if mux == nil {
+		return false
+	}
+
+	_, pattern := mux.Handler(&http.Request{Method: http.MethodPost, URL: &url.URL{Path: webhookPath}})
+
+	return pattern != ""
+}
+
+// applyWebhookConfiguration creates or updates the MutatingWebhookConfiguration for rules,
+// owned by the targets declaring them, or deletes it when there are none.
+func (r *HardwareProfileTargetReconciler) applyWebhookConfiguration(
+	ctx context.Context,
+	rules []admissionregistrationv1.RuleWithOperations,
+	owners []metav1.OwnerReference,
+) error {
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: webhookConfigurationName},
+	}
+
+	if len(rules) == 0 {
+		if err := r.Client.Delete(ctx, mwc); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to delete MutatingWebhookConfiguration %s: %w", webhookConfigurationName, err)
+		}
+
+		return nil
+	}
+
+	namespace, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to get the operator namespace: %w", err)
+	}
+
+	expected := webhookConfiguration(namespace, cluster.GetRelease().Name, rules)
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, mwc, func() error {
+		if mwc.Annotations == nil {
+			mwc.Annotations = map[string]string{}
+		}
+		maps.Copy(mwc.Annotations, expected.Annotations)
+		mwc.OwnerReferences = owners
+
+		// Keep the CA bundle injected into the existing configuration.
+		if len(mwc.Webhooks) == 1 && expected.Webhooks[0].ClientConfig.CABundle == nil {
+			expected.Webhooks[0].ClientConfig.CABundle = mwc.Webhooks[0].ClientConfig.CABundle
+		}
+		mwc.Webhooks = expected.Webhooks
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply MutatingWebhookConfiguration %s: %w", webhookConfigurationName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package hardwareprofile
+
+import (
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// webhookConfigurationName is the name of the MutatingWebhookConfiguration, and of its single
+// webhook, routing the workloads declared by HardwareProfileTargets to the hardware profile webhook.
+const webhookConfigurationName = "hardwareprofile-targets.opendatahub.io"
+
+// webhookPath is the path the hardware profile webhook is served at.
+const webhookPath = "/mutate-hardware-profile"
+
+// HardwareProfileTarget condition reasons.
+const (
+	reasonRegistered    = "Registered"
+	reasonKindNotFound  = "KindNotFound"
+	reasonDuplicateKind = "DuplicateKind"
+	// reasonWebhookNotServed is reported when the operator does not serve the hardware
+	// profile webhook, e.g. when built without webhooks.
+	reasonWebhookNotServed = "WebhookNotServed"
+)
+
+// Annotations asking for the injection of the webhook CA bundle.
+const (
+	openshiftInjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+	certManagerInjectCAFromAnnotation = "cert-manager.io/inject-ca-from"
+)
+
+// hasProfileAnnotation only sends the workloads referencing, or that referenced, a
+// HardwareProfile to the webhook, which also removes the profile settings when the
+// annotation is removed.
+var hasProfileAnnotation = fmt.Sprintf(
+	`(object != null && has(object.metadata.annotations) && '%[1]s' in object.metadata.annotations) || `+
+		`(oldObject != null && has(oldObject.metadata.annotations) && '%[1]s' in oldObject.metadata.annotations)`,
+	annotations.HardwareProfileName)
+
+// webhookRule returns the rule matching the creation and update of the resources of mapping.
+func webhookRule(mapping *meta.RESTMapping) *admissionregistrationv1.RuleWithOperations {
+	return &admissionregistrationv1.RuleWithOperations{
+		Operations: []admissionregistrationv1.OperationType{
+			admissionregistrationv1.Create,
+			admissionregistrationv1.Update,
+		},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{mapping.Resource.Group},
+			APIVersions: []string{mapping.Resource.Version},
+			Resources:   []string{mapping.Resource.Resource},
+			Scope:       ptr.To(admissionregistrationv1.AllScopes),
+		},
+	}
+}
+
+// targetOwnerReference returns the owner reference of the MutatingWebhookConfiguration to target.
+func targetOwnerReference(target *infrav1.HardwareProfileTarget) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: gvk.HardwareProfileTarget.GroupVersion().String(),
+		Kind:       gvk.HardwareProfileTarget.Kind,
+		Name:       target.Name,
+		UID:        target.UID,
+	}
+}
+
+// webhookConfiguration returns the MutatingWebhookConfiguration sending the workloads matched
+// by rules to the hardware profile webhook of the operator deployed in namespace.
+//
+// Every defaulted field is set, so that the configuration read back from the cluster
+// equals the expected one and is not updated on each reconciliation.
+func webhookConfiguration(namespace string, platform common.Platform, rules []admissionregistrationv1.RuleWithOperations) *admissionregistrationv1.MutatingWebhookConfiguration {
+	serviceName, caAnnotations := webhookService(namespace, platform)
+
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        webhookConfigurationName,
+			Annotations: caAnnotations,
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: webhookConfigurationName,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Name:      serviceName,
+					Namespace: namespace,
+					Path:      ptr.To(webhookPath),
+					Port:      ptr.To[int32](443),
+				},
+			},
+			Rules: rules,
+			MatchConditions: []admissionregistrationv1.MatchCondition{{
+				Name:       "has-hardware-profile-annotation",
+				Expression: hasProfileAnnotation,
+			}},
+			FailurePolicy:           ptr.To(admissionregistrationv1.Fail),
+			MatchPolicy:             ptr.To(admissionregistrationv1.Equivalent),
+			NamespaceSelector:       &metav1.LabelSelector{},
+			ObjectSelector:          &metav1.LabelSelector{},
+			SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+			TimeoutSeconds:          ptr.To[int32](10),
+			AdmissionReviewVersions: []string{"v1"},
+			ReinvocationPolicy:      ptr.To(admissionregistrationv1.NeverReinvocationPolicy),
+		}},
+	}
+}
+
+// webhookService returns the name of the operator webhook Service and the annotations
+// injecting its CA bundle: the OpenShift service CA signs the serving certificate on
+// OpenShift, cert-manager does on other Kubernetes distributions.
+func webhookService(namespace string, platform common.Platform) (string, map[string]string) {
+	switch platform {
+	case cluster.XKS:
+		certConfig := certmanager.BootstrapOperatorCertConfig(namespace)
+		return certConfig.WebhookServiceName, map[string]string{
+			certManagerInjectCAFromAnnotation: namespace + "/" + certConfig.WebhookCertName,
+		}
+	case cluster.OpenDataHub:
+		return "opendatahub-operator-webhook-service", map[string]string{openshiftInjectCABundleAnnotation: "true"}
+	default:
+		return "rhods-operator-webhook-service", map[string]string{openshiftInjectCABundleAnnotation: "true"}
+	}
+}
@@ -0,0 +1,127 @@
+//nolint:testpackage // white-box tests for unexported webhook registration helpers
+package hardwareprofile
+
+import (
+	"net/http"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/gomega"
+)
+
+func target(name string, kind schema.GroupVersionKind) *infrav1.HardwareProfileTarget {
+	return &infrav1.HardwareProfileTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 2},
+		Spec: infrav1.HardwareProfileTargetSpec{
+			Group:   kind.Group,
+			Version: kind.Version,
+			Kind:    kind.Kind,
+			PodTemplates: []infrav1.HardwareProfilePodTemplate{
+				{Name: "main", PodSpecPath: "spec.template.spec"},
+			},
+		},
+	}
+}
+
+func TestResolveTarget(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	rayCluster := schema.GroupVersionKind{Group: "ray.io", Version: "v1", Kind: "RayCluster"}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(deployment, meta.RESTScopeNamespace)
+
+	server := webhook.NewServer(webhook.Options{})
+	server.Register(webhookPath, http.NotFoundHandler())
+
+	r := &HardwareProfileTargetReconciler{RESTMapper: mapper, WebhookServer: server}
+
+	t.Run("served kind", func(t *testing.T) {
+		g := NewWithT(t)
+
+		status, rule, err := r.resolve(target("deployments", deployment), map[schema.GroupVersionKind]string{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(status.Resource).To(Equal("deployments"))
+		g.Expect(status.ObservedGeneration).To(Equal(int64(2)))
+		g.Expect(meta.IsStatusConditionTrue(status.Conditions, infrav1.HardwareProfileTargetConditionReady)).To(BeTrue())
+		g.Expect(rule).NotTo(BeNil())
+		g.Expect(rule.APIGroups).To(Equal([]string{"apps"}))
+		g.Expect(rule.Resources).To(Equal([]string{"deployments"}))
+	})
+
+	t.Run("kind not served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		status, rule, err := r.resolve(target("rayclusters", rayCluster), map[schema.GroupVersionKind]string{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rule).To(BeNil())
+		g.Expect(meta.FindStatusCondition(status.Conditions, infrav1.HardwareProfileTargetConditionReady).Reason).To(Equal(reasonKindNotFound))
+	})
+
+	t.Run("kind declared by another target", func(t *testing.T) {
+		g := NewWithT(t)
+
+		status, rule, err := r.resolve(target("more-deployments", deployment), map[schema.GroupVersionKind]string{deployment: "deployments"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rule).To(BeNil())
+		g.Expect(meta.FindStatusCondition(status.Conditions, infrav1.HardwareProfileTargetConditionReady).Reason).To(Equal(reasonDuplicateKind))
+	})
+
+	t.Run("webhook not served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		withoutWebhook := &HardwareProfileTargetReconciler{RESTMapper: mapper, WebhookServer: webhook.NewServer(webhook.Options{})}
+
+		status, rule, err := withoutWebhook.resolve(target("deployments", deployment), map[schema.GroupVersionKind]string{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rule).To(BeNil())
+		g.Expect(meta.FindStatusCondition(status.Conditions, infrav1.HardwareProfileTargetConditionReady).Reason).To(Equal(reasonWebhookNotServed))
+	})
+}
+
+func TestTargetOwnerReference(t *testing.T) {
+	g := NewWithT(t)
+
+	deployments := target("deployments", schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	deployments.UID = "uid"
+
+	g.Expect(targetOwnerReference(deployments)).To(Equal(metav1.OwnerReference{
+		APIVersion: "infrastructure.opendatahub.io/v1",
+		Kind:       "HardwareProfileTarget",
+		Name:       "deployments",
+		UID:        "uid",
+	}))
+}
+
+func TestWebhookConfiguration(t *testing.T) {
+	rules := []admissionregistrationv1.RuleWithOperations{{}}
+
+	t.Run("OpenShift", func(t *testing.T) {
+		g := NewWithT(t)
+
+		mwc := webhookConfiguration("redhat-ods-operator", cluster.SelfManagedRhoai, rules)
+		g.Expect(mwc.Annotations).To(HaveKeyWithValue(openshiftInjectCABundleAnnotation, "true"))
+		g.Expect(mwc.Webhooks).To(HaveLen(1))
+
+		service := mwc.Webhooks[0].ClientConfig.Service
+		g.Expect(service.Name).To(Equal("rhods-operator-webhook-service"))
+		g.Expect(service.Namespace).To(Equal("redhat-ods-operator"))
+		g.Expect(*service.Path).To(Equal(webhookPath))
+		g.Expect(mwc.Webhooks[0].Rules).To(Equal(rules))
+	})
+
+	t.Run("XKS", func(t *testing.T) {
+		g := NewWithT(t)
+
+		mwc := webhookConfiguration("opendatahub-operator-system", cluster.XKS, rules)
+		g.Expect(mwc.Annotations).To(HaveKeyWithValue(certManagerInjectCAFromAnnotation, "opendatahub-operator-system/opendatahub-operator-webhook-cert"))
+		g.Expect(mwc.Webhooks[0].ClientConfig.Service.Name).To(Equal("opendatahub-operator-webhook-service"))
+	})
+}
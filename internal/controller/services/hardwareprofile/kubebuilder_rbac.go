@@ -0,0 +1,25 @@
+package hardwareprofile
+
+// HardwareProfile status
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=hardwareprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=hardwareprofiles/status,verbs=get;update;patch
+
+// Nodes matched against the profile scheduling configuration
+// +kubebuilder:rbac:groups="core",resources=nodes,verbs=get;list;watch
+
+// LocalQueues referenced by Queue scheduling profiles
+// +kubebuilder:rbac:groups="kueue.x-k8s.io",resources=localqueues,verbs=get
+
+// Workloads referencing the profiles
+// +kubebuilder:rbac:groups="kubeflow.org",resources=notebooks,verbs=list
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferenceservices;llminferenceservices,verbs=list
+
+// HardwareProfileTargets, registered with the hardware profile webhook
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=hardwareprofiletargets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.opendatahub.io,resources=hardwareprofiletargets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// Workloads updated when propagating HardwareProfile changes. The kinds declared by
+// HardwareProfileTargets are granted by the hardwareprofile-targets aggregated ClusterRole.
+// +kubebuilder:rbac:groups="kubeflow.org",resources=notebooks,verbs=get;list;update
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferenceservices;llminferenceservices,verbs=get;list;update
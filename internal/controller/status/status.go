@@ -105,6 +105,29 @@ const (
 	ConditionGatewayAPIReady   = "GatewayAPIReady"
 	ConditionLWSReady          = "LWSReady"
 	ConditionSailOperatorReady = "SailOperatorReady"
+	ConditionKEDAReady         = "KEDAReady"
+
+	// Cloud controller manager root CA rotation conditions.
+	ConditionRootCAReady                  = "RootCAReady"
+	RootCAIssuingReason                   = "RootCAIssuing"
+	RootCAReissuingLeafCertificatesReason = "ReissuingLeafCertificates"
+	RootCARotationOverlapReason           = "RotationOverlap"
+
+	// Cloud controller manager dependency upgrade conditions.
+	ConditionUpgradeAvailable           = "UpgradeAvailable"
+	UpgradePendingApprovalReason        = "UpgradePendingApproval"
+	VersionConstraintNotSatisfiedReason = "VersionConstraintNotSatisfied"
+	UpToDateReason                      = "UpToDate"
+
+	// Cloud controller manager pre-flight conditions.
+	ConditionPreflightSucceeded = "PreflightSucceeded"
+	PreflightFailedReason       = "PreflightFailed"
+	PreflightWarningReason      = "PreflightWarning"
+
+	// Generic (self-managed) Kubernetes engine conditions.
+	ConditionStorageClassAvailable = "StorageClassAvailable"
+	StorageClassNotFoundReason     = "StorageClassNotFound"
+	NoDefaultStorageClassReason    = "NoDefaultStorageClass"
 )
 
 const (
@@ -178,6 +201,8 @@ const (
 	GatewayNotReadyMessage = "Gateway is not ready"
 	GatewayReadyMessage    = "Gateway is ready"
 
+	GatewayCertificateNotReadyMessage = "Waiting for the gateway certificate to be issued by cert-manager"
+
 	// MaaS prerequisites messages.
 	MaaSPrerequisitesNotMetReason = "MaaSPrerequisitesNotMet"
 	MaaSPrerequisitesMetReason    = "MaaSPrerequisitesMet"
@@ -0,0 +1,85 @@
+//go:build !nowebhook
+
+package dashboard
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// allFeaturesVisibility offers a converted HardwareProfile in every dashboard area, as the
+// deprecated HardwareProfiles were.
+const allFeaturesVisibility = `["workbench","model-serving"]`
+
+// dashboardHardwareProfileSpec is the spec of the deprecated dashboard.opendatahub.io HardwareProfile.
+type dashboardHardwareProfileSpec struct {
+	DisplayName  string                       `json:"displayName"`
+	Enabled      bool                         `json:"enabled"`
+	Description  string                       `json:"description,omitempty"`
+	Identifiers  []infrav1.HardwareIdentifier `json:"identifiers,omitempty"`
+	NodeSelector map[string]string            `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration          `json:"tolerations,omitempty"`
+}
+
+// ConvertHardwareProfile converts a deprecated dashboard.opendatahub.io HardwareProfile into an
+// infrastructure.opendatahub.io HardwareProfile with the same name and namespace. The display
+// name, description and enablement move to the annotations used by the dashboard, and the node
+// selector and tolerations to node scheduling. The dashboard feature visibility of the source is
+// kept, the profile is offered in every dashboard area otherwise.
+func ConvertHardwareProfile(_ context.Context, source *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	rawSpec, found, err := unstructured.NestedMap(source.Object, "spec")
+	if err != nil || !found {
+		return nil, errors.New("failed to get spec from HardwareProfile")
+	}
+
+	spec := dashboardHardwareProfileSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, &spec); err != nil {
+		return nil, fmt.Errorf("failed to read HardwareProfile spec: %w", err)
+	}
+
+	hwp := &infrav1.HardwareProfile{
+		Spec: infrav1.HardwareProfileSpec{
+			Identifiers: spec.Identifiers,
+		},
+	}
+	hwp.SetName(source.GetName())
+	hwp.SetNamespace(source.GetNamespace())
+	hwp.SetLabels(source.GetLabels())
+	hwp.SetAnnotations(map[string]string{
+		annotations.DashboardFeatureVisibility: cmp.Or(source.GetAnnotations()[annotations.DashboardFeatureVisibility], allFeaturesVisibility),
+		annotations.HardwareProfileDisplayName: spec.DisplayName,
+		annotations.HardwareProfileDescription: spec.Description,
+		annotations.HardwareProfileDisabled:    strconv.FormatBool(!spec.Enabled),
+	})
+
+	if len(spec.NodeSelector) > 0 || len(spec.Tolerations) > 0 {
+		hwp.Spec.SchedulingSpec = &infrav1.SchedulingSpec{
+			SchedulingType: infrav1.NodeScheduling,
+			Node: &infrav1.NodeSchedulingSpec{
+				NodeSelector: spec.NodeSelector,
+				Tolerations:  spec.Tolerations,
+			},
+		}
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hwp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert HardwareProfile to unstructured: %w", err)
+	}
+
+	replacement := &unstructured.Unstructured{Object: obj}
+	replacement.SetGroupVersionKind(gvk.HardwareProfile)
+
+	return replacement, nil
+}
@@ -4,15 +4,18 @@ package dashboard
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/deprecation"
 )
 
-// RegisterWebhooks registers all dashboard deprecation webhooks.
-func RegisterWebhooks(mgr ctrl.Manager) error {
+// RegisterWebhooks registers all dashboard deprecation webhooks. hardwareProfileMode selects how
+// the deprecated dashboard HardwareProfiles are handled.
+func RegisterWebhooks(mgr ctrl.Manager, hardwareProfileMode deprecation.Mode) error {
 	if err := RegisterAcceleratorProfileWebhook(mgr); err != nil {
 		return err
 	}
 
-	if err := RegisterHardwareProfileWebhook(mgr); err != nil {
+	if err := RegisterHardwareProfileWebhook(mgr, hardwareProfileMode); err != nil {
 		return err
 	}
 
@@ -3,6 +3,8 @@
 package dashboard
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -19,9 +21,11 @@ const (
 )
 
 //nolint:lll
-//+kubebuilder:webhook:path=/validate-dashboard-hardwareprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=dashboard.opendatahub.io,resources=hardwareprofiles,verbs=create;update,versions=v1alpha1,name=dashboard-hardwareprofile-validator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-dashboard-hardwareprofile,mutating=false,failurePolicy=fail,sideEffects=NoneOnDryRun,groups=dashboard.opendatahub.io,resources=hardwareprofiles,verbs=create;update,versions=v1alpha1,name=dashboard-hardwareprofile-validator.opendatahub.io,admissionReviewVersions=v1
 
-func NewHardwareProfileWebhook(s *runtime.Scheme) *deprecation.TypeValidator {
+// NewHardwareProfileWebhook returns the webhook of the deprecated dashboard HardwareProfiles. In
+// ConvertMode they are converted into infrastructure HardwareProfiles, otherwise they are denied.
+func NewHardwareProfileWebhook(s *runtime.Scheme, mode deprecation.Mode) *deprecation.TypeValidator {
 	return &deprecation.TypeValidator{
 		Decoder:     admission.NewDecoder(s),
 		Name:        HardwareProfileValidateName,
@@ -29,11 +33,22 @@ func NewHardwareProfileWebhook(s *runtime.Scheme) *deprecation.TypeValidator {
 		TypeMap: deprecation.TypeMap{
 			DeprecatedGVK:  gvk.DashboardHardwareProfile,
 			ReplacementGVK: gvk.HardwareProfile,
+			Mode:           mode,
+			Converter:      ConvertHardwareProfile,
 		},
 	}
 }
-func RegisterHardwareProfileWebhook(mgr ctrl.Manager) error {
-	validator := NewHardwareProfileWebhook(mgr.GetScheme())
+
+func RegisterHardwareProfileWebhook(mgr ctrl.Manager, mode deprecation.Mode) error {
+	if mode == "" {
+		mode = deprecation.DenyMode
+	}
+	if mode != deprecation.DenyMode && mode != deprecation.ConvertMode {
+		return fmt.Errorf("invalid dashboard HardwareProfile webhook mode %q, must be %s or %s", mode, deprecation.DenyMode, deprecation.ConvertMode)
+	}
+
+	validator := NewHardwareProfileWebhook(mgr.GetScheme(), mode)
+	validator.Client = mgr.GetClient()
 
 	mgr.GetWebhookServer().Register(
 		validator.WebhookPath,
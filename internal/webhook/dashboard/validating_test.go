@@ -4,8 +4,10 @@ package dashboard_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
 	"testing"
@@ -16,10 +18,15 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/dashboard"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/deprecation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
@@ -48,7 +55,7 @@ func TestValidator_Unit(t *testing.T) {
 		},
 		{
 			name:      "HardwareProfile",
-			validator: dashboard.NewHardwareProfileWebhook(s),
+			validator: dashboard.NewHardwareProfileWebhook(s, deprecation.DenyMode),
 		},
 	}
 
@@ -153,7 +160,7 @@ func TestValidator_Integration(t *testing.T) {
 
 				if err := registerWebhookTypeValidatorWithBypass(
 					mgr,
-					dashboard.NewHardwareProfileWebhook(mgr.GetScheme()),
+					dashboard.NewHardwareProfileWebhook(mgr.GetScheme(), deprecation.DenyMode),
 					bypassFunc,
 				); err != nil {
 					return err
@@ -263,3 +270,154 @@ func registerWebhookTypeValidatorWithBypass(
 
 	return nil
 }
+
+func TestValidator_Conversion(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cli := fake.NewClientBuilder().WithScheme(s).Build()
+
+	validator := dashboard.NewHardwareProfileWebhook(s, deprecation.ConvertMode)
+	validator.Client = cli
+
+	newSourceRequest := func(operation admissionv1.Operation, dryRun bool, name string, uid k8stypes.UID, spec map[string]any) admission.Request {
+		source := resources.GvkToUnstructured(gvk.DashboardHardwareProfile)
+		source.SetName(name)
+		source.SetNamespace("user")
+		source.SetUID(uid)
+		if spec != nil {
+			source.Object["spec"] = spec
+		}
+
+		raw, err := json.Marshal(source)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: operation,
+				DryRun:    &dryRun,
+				Name:      source.GetName(),
+				Namespace: source.GetNamespace(),
+				Object:    runtime.RawExtension{Raw: raw},
+				Kind: metav1.GroupVersionKind{
+					Group:   gvk.DashboardHardwareProfile.Group,
+					Version: gvk.DashboardHardwareProfile.Version,
+					Kind:    gvk.DashboardHardwareProfile.Kind,
+				},
+			},
+		}
+	}
+
+	newRequest := func(operation admissionv1.Operation, dryRun bool, spec map[string]any) admission.Request {
+		return newSourceRequest(operation, dryRun, "gpu", k8stypes.UID("source-uid"), spec)
+	}
+
+	spec := map[string]any{
+		"displayName": "GPU",
+		"enabled":     true,
+		"identifiers": []any{
+			map[string]any{"displayName": "GPU", "identifier": "nvidia.com/gpu", "minCount": int64(1), "defaultCount": int64(1), "resourceType": "Accelerator"},
+		},
+		"nodeSelector": map[string]any{"gpu": "true"},
+	}
+
+	t.Run("Dry-run create - should allow without creating the replacement", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := validator.Handle(t.Context(), newRequest(admissionv1.Create, true, spec))
+		g.Expect(resp.Allowed).To(BeTrue())
+		g.Expect(resp.Warnings).To(ContainElement(ContainSubstring("is deprecated")))
+
+		err := cli.Get(t.Context(), client.ObjectKey{Name: "gpu", Namespace: "user"}, &infrav1.HardwareProfile{})
+		g.Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("Create - should allow and create the replacement", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := validator.Handle(t.Context(), newRequest(admissionv1.Create, false, spec))
+		g.Expect(resp.Allowed).To(BeTrue())
+		g.Expect(resp.Warnings).To(ContainElement(ContainSubstring("infrastructure.opendatahub.io/HardwareProfile 'gpu'")))
+
+		hwp := &infrav1.HardwareProfile{}
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "gpu", Namespace: "user"}, hwp)).To(Succeed())
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue("opendatahub.io/display-name", "GPU"))
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue("opendatahub.io/disabled", "false"))
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue("opendatahub.io/dashboard-feature-visibility", `["workbench","model-serving"]`))
+		g.Expect(hwp.Spec.Identifiers).To(HaveLen(1))
+		g.Expect(hwp.Spec.SchedulingSpec.Node.NodeSelector).To(HaveKeyWithValue("gpu", "true"))
+		g.Expect(hwp.OwnerReferences).To(ConsistOf(And(
+			HaveField("Kind", gvk.DashboardHardwareProfile.Kind),
+			HaveField("UID", k8stypes.UID("source-uid")),
+		)))
+	})
+
+	t.Run("Update - should allow and update the replacement", func(t *testing.T) {
+		g := NewWithT(t)
+
+		updated := maps.Clone(spec)
+		updated["enabled"] = false
+
+		resp := validator.Handle(t.Context(), newRequest(admissionv1.Update, false, updated))
+		g.Expect(resp.Allowed).To(BeTrue())
+
+		hwp := &infrav1.HardwareProfile{}
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "gpu", Namespace: "user"}, hwp)).To(Succeed())
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue("opendatahub.io/disabled", "true"))
+		g.Expect(hwp.OwnerReferences).To(HaveLen(1))
+	})
+
+	t.Run("Update - should deny when the replacement is not owned by the source", func(t *testing.T) {
+		g := NewWithT(t)
+
+		hwp := &infrav1.HardwareProfile{}
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "gpu", Namespace: "user"}, hwp)).To(Succeed())
+		hwp.OwnerReferences = nil
+		delete(hwp.Annotations, deprecation.ConvertedFromAnnotation)
+		g.Expect(cli.Update(t.Context(), hwp)).To(Succeed())
+
+		resp := validator.Handle(t.Context(), newRequest(admissionv1.Update, false, spec))
+		g.Expect(resp.Allowed).To(BeFalse())
+		g.Expect(resp.Result.Message).To(ContainSubstring("already exists and was not converted from"))
+
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "gpu", Namespace: "user"}, hwp)).To(Succeed())
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue("opendatahub.io/disabled", "true"))
+		g.Expect(hwp.OwnerReferences).To(BeEmpty())
+	})
+
+	t.Run("Create without spec - should deny", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := validator.Handle(t.Context(), newRequest(admissionv1.Create, false, nil))
+		g.Expect(resp.Allowed).To(BeFalse())
+		g.Expect(resp.Result.Message).To(ContainSubstring("cannot be converted"))
+	})
+
+	t.Run("Update - should allow and own the replacement created before the source had a UID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := validator.Handle(t.Context(), newSourceRequest(admissionv1.Create, false, "tpu", "", spec))
+		g.Expect(resp.Allowed).To(BeTrue())
+
+		hwp := &infrav1.HardwareProfile{}
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "tpu", Namespace: "user"}, hwp)).To(Succeed())
+		g.Expect(hwp.OwnerReferences).To(BeEmpty())
+		g.Expect(hwp.Annotations).To(HaveKeyWithValue(deprecation.ConvertedFromAnnotation, "HardwareProfile.dashboard.opendatahub.io/tpu"))
+
+		resp = validator.Handle(t.Context(), newSourceRequest(admissionv1.Update, false, "tpu", "tpu-uid", spec))
+		g.Expect(resp.Allowed).To(BeTrue())
+
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "tpu", Namespace: "user"}, hwp)).To(Succeed())
+		g.Expect(hwp.OwnerReferences).To(ConsistOf(HaveField("UID", k8stypes.UID("tpu-uid"))))
+	})
+}
+
+func TestRegisterHardwareProfileWebhook_InvalidMode(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(dashboard.RegisterHardwareProfileWebhook(nil, "Accept")).To(
+		MatchError(ContainSubstring(`invalid dashboard HardwareProfile webhook mode "Accept"`)),
+	)
+}
@@ -0,0 +1,28 @@
+//go:build !nowebhook
+
+package deprecation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	conversionResultSuccess = "success"
+	conversionResultError   = "error"
+	conversionResultDryRun  = "dry_run"
+)
+
+// conversionsTotal counts the deprecated resources converted into their replacement, to track
+// the migration progress before switching a TypeMap back to deny mode.
+var conversionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "opendatahub_deprecated_api_conversions_total",
+		Help: "Number of deprecated API resources converted into their replacement by the deprecation webhooks.",
+	},
+	[]string{"deprecated_kind", "replacement_kind", "operation", "result"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(conversionsTotal)
+}
@@ -4,13 +4,18 @@ package deprecation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -18,16 +23,49 @@ import (
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
+// Mode defines how CREATE and UPDATE operations on a deprecated API resource are handled.
+type Mode string
+
+const (
+	// DenyMode denies the operation and directs users to the replacement API.
+	DenyMode Mode = "Deny"
+	// ConvertMode accepts the operation and converts the deprecated resource into its replacement.
+	ConvertMode Mode = "Convert"
+)
+
+// ConvertedFromAnnotation records the deprecated resource a replacement was converted from, as
+// <Kind>.<group>/<name>. It identifies the source of replacements created before the source had
+// a UID to be referenced as owner.
+const ConvertedFromAnnotation = "opendatahub.io/converted-from"
+
+// ConverterFunc translates a deprecated resource into its replacement. The returned object must
+// have the replacement GVK, name and namespace set, ownership is set by the TypeValidator.
+type ConverterFunc func(ctx context.Context, source *unstructured.Unstructured) (*unstructured.Unstructured, error)
+
+// errReplacementNotOwned is returned when the replacement of a deprecated resource already
+// exists and was not created from it, so that converting it would overwrite another resource.
+var errReplacementNotOwned = errors.New("replacement exists and is not owned by the deprecated resource")
+
 type TypeMap struct {
 	DeprecatedGVK  schema.GroupVersionKind
 	ReplacementGVK schema.GroupVersionKind
+
+	// Mode selects between denying deprecated resources (the default) and converting them, for
+	// the resources of this TypeMap only. ConvertMode requires a Converter, without one the
+	// TypeMap falls back to DenyMode.
+	Mode      Mode
+	Converter ConverterFunc
 }
 
 // TypeValidator is a generic webhook that denies CREATE and UPDATE operations
 // on deprecated API resources and directs users to the replacement API.
+// In ConvertMode, the deprecated resources are accepted instead and converted into the
+// replacement, which is created or updated and owned by the deprecated resource.
 type TypeValidator struct {
 	TypeMap
 
+	// Client is used to create or update the replacement resources, it is only required in ConvertMode.
+	Client      client.Client
 	Decoder     admission.Decoder
 	Name        string
 	WebhookPath string
@@ -58,6 +96,10 @@ func (v *TypeValidator) Handle(ctx context.Context, req admission.Request) admis
 
 	switch req.Operation {
 	case admissionv1.Create, admissionv1.Update:
+		if v.isConversionEnabled() {
+			return v.convert(ctx, req)
+		}
+
 		msg := fmt.Sprintf("%s/%s is not supported, please use %s/%s",
 			v.DeprecatedGVK.Group,
 			v.DeprecatedGVK.Kind,
@@ -77,3 +119,162 @@ func (v *TypeValidator) isExpectedKind(kind metav1.GroupVersionKind) bool {
 		kind.Version == v.DeprecatedGVK.Version &&
 		kind.Kind == v.DeprecatedGVK.Kind
 }
+
+func (v *TypeValidator) isConversionEnabled() bool {
+	return v.Mode == ConvertMode && v.Converter != nil
+}
+
+// convert translates the deprecated resource of req into its replacement and creates or
+// updates it, so that GitOps repositories and pipelines still emitting the deprecated kind
+// keep working while they migrate. The operation is allowed with a deprecation warning.
+func (v *TypeValidator) convert(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	source := &unstructured.Unstructured{}
+	if err := v.Decoder.Decode(req, source); err != nil {
+		log.Error(err, "failed to decode deprecated resource")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	replacement, err := v.Converter(ctx, source)
+	if err != nil {
+		v.recordConversion(req.Operation, conversionResultError)
+		return admission.Denied(fmt.Sprintf("%s/%s '%s' cannot be converted to %s/%s: %v",
+			v.DeprecatedGVK.Group, v.DeprecatedGVK.Kind, source.GetName(),
+			v.ReplacementGVK.Group, v.ReplacementGVK.Kind, err))
+	}
+	replacement.SetGroupVersionKind(v.ReplacementGVK)
+
+	warning := fmt.Sprintf("%s/%s is deprecated and has been converted into %s/%s '%s', please migrate to %s/%s",
+		v.DeprecatedGVK.Group, v.DeprecatedGVK.Kind,
+		v.ReplacementGVK.Group, v.ReplacementGVK.Kind, replacement.GetName(),
+		v.ReplacementGVK.Group, v.ReplacementGVK.Kind)
+
+	if req.DryRun != nil && *req.DryRun {
+		v.recordConversion(req.Operation, conversionResultDryRun)
+		return admission.Allowed("Conversion skipped in dry-run mode").WithWarnings(warning)
+	}
+
+	if err := v.applyReplacement(ctx, source, replacement); err != nil {
+		if errors.Is(err, errReplacementNotOwned) {
+			v.recordConversion(req.Operation, conversionResultError)
+			return admission.Denied(fmt.Sprintf("%s/%s '%s' already exists and was not converted from %s/%s '%s', it is not overwritten: "+
+				"rename the %s or migrate to %s/%s",
+				v.ReplacementGVK.Group, v.ReplacementGVK.Kind, replacement.GetName(),
+				v.DeprecatedGVK.Group, v.DeprecatedGVK.Kind, source.GetName(),
+				v.DeprecatedGVK.Kind, v.ReplacementGVK.Group, v.ReplacementGVK.Kind))
+		}
+
+		log.Error(err, "failed to apply replacement resource", "name", replacement.GetName(), "namespace", replacement.GetNamespace())
+		v.recordConversion(req.Operation, conversionResultError)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	log.Info("converted deprecated resource", "name", source.GetName(), "namespace", source.GetNamespace(),
+		"replacementKind", v.ReplacementGVK.Kind, "replacementName", replacement.GetName())
+	v.recordConversion(req.Operation, conversionResultSuccess)
+
+	return admission.Allowed(fmt.Sprintf("%s converted into %s", v.DeprecatedGVK.Kind, v.ReplacementGVK.Kind)).WithWarnings(warning)
+}
+
+// applyReplacement creates or updates the replacement resource and owns it back to the source,
+// so that deleting the deprecated resource also deletes its replacement. An existing replacement
+// is only updated when it was converted from the source, it returns errReplacementNotOwned
+// otherwise. The owner reference is added as soon as the source has a UID, which may only be the
+// case on a later UPDATE of the source.
+func (v *TypeValidator) applyReplacement(ctx context.Context, source *unstructured.Unstructured, replacement *unstructured.Unstructured) error {
+	if v.Client == nil {
+		return errors.New("webhook client not initialized")
+	}
+
+	desired := replacement.DeepCopy()
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(v.ReplacementGVK)
+	current.SetName(desired.GetName())
+	current.SetNamespace(desired.GetNamespace())
+
+	_, err := controllerutil.CreateOrUpdate(ctx, v.Client, current, func() error {
+		if current.GetResourceVersion() != "" && !isOwnedBy(current, source) && !isConvertedFrom(current, source) {
+			return errReplacementNotOwned
+		}
+
+		for k, val := range desired.Object {
+			if k == "apiVersion" || k == "kind" || k == "metadata" || k == "status" {
+				continue
+			}
+			current.Object[k] = val
+		}
+
+		labels := current.GetLabels()
+		for k, val := range desired.GetLabels() {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[k] = val
+		}
+		current.SetLabels(labels)
+
+		annotations := current.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		for k, val := range desired.GetAnnotations() {
+			annotations[k] = val
+		}
+		annotations[ConvertedFromAnnotation] = convertedFrom(source)
+		current.SetAnnotations(annotations)
+
+		// Owner references must point to the same namespace, and the UID of a resource being
+		// created is only known when the apiserver filled it before calling the webhook.
+		if source.GetUID() == "" || (source.GetNamespace() != "" && source.GetNamespace() != current.GetNamespace()) {
+			return nil
+		}
+
+		if isOwnedBy(current, source) {
+			return nil
+		}
+		current.SetOwnerReferences(append(current.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: source.GetAPIVersion(),
+			Kind:       source.GetKind(),
+			Name:       source.GetName(),
+			UID:        source.GetUID(),
+		}))
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update %s '%s': %w", v.ReplacementGVK.Kind, desired.GetName(), err)
+	}
+
+	return nil
+}
+
+// isOwnedBy returns true if obj has an owner reference to owner.
+func isOwnedBy(obj metav1.Object, owner metav1.Object) bool {
+	if owner.GetUID() == "" {
+		return false
+	}
+
+	return slices.ContainsFunc(obj.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == owner.GetUID()
+	})
+}
+
+// isConvertedFrom returns true if obj was converted from source.
+func isConvertedFrom(obj metav1.Object, source *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[ConvertedFromAnnotation] == convertedFrom(source)
+}
+
+func convertedFrom(source *unstructured.Unstructured) string {
+	return source.GroupVersionKind().GroupKind().String() + "/" + source.GetName()
+}
+
+func (v *TypeValidator) recordConversion(operation admissionv1.Operation, result string) {
+	conversionsTotal.WithLabelValues(
+		v.DeprecatedGVK.GroupKind().String(),
+		v.ReplacementGVK.GroupKind().String(),
+		string(operation),
+		result,
+	).Inc()
+}
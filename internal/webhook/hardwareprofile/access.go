@@ -0,0 +1,125 @@
+package hardwareprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// ProfileAccessDeniedError is returned when a workload references a HardwareProfile whose
+// access restrictions do not allow the workload namespace or the requesting user.
+type ProfileAccessDeniedError struct {
+	ProfileName       string
+	ProfileNamespace  string
+	WorkloadNamespace string
+	Username          string
+	// NamespaceDenied reports whether the namespace is not allowed, otherwise the user is not
+	// a member of the allowed groups.
+	NamespaceDenied bool
+}
+
+func (e *ProfileAccessDeniedError) Error() string {
+	reason := fmt.Sprintf("user '%s' is not a member of its allowed groups", e.Username)
+	if e.NamespaceDenied {
+		reason = "the namespace is not allowed by the profile"
+	}
+
+	return fmt.Sprintf("hardware profile '%s' in namespace '%s' cannot be used by workloads in namespace '%s': %s",
+		e.ProfileName, e.ProfileNamespace, e.WorkloadNamespace, reason)
+}
+
+// startsReferencingProfile reports whether the workload of req starts referencing the given
+// profile: on CREATE, on initial assignment and when switching profiles. Updates of workloads
+// already referencing the profile, such as those made by controllers, are not checked again.
+func startsReferencingProfile(req *admission.Request, profileName, profileNamespace string) bool {
+	if req.Operation == admissionv1.Create || req.OldObject.Raw == nil {
+		return true
+	}
+
+	oldObj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+		return true
+	}
+
+	oldProfileNamespace := resources.GetAnnotation(oldObj, HardwareProfileNamespaceAnnotation)
+	if oldProfileNamespace == "" {
+		oldProfileNamespace = oldObj.GetNamespace()
+	}
+
+	return resources.GetAnnotation(oldObj, HardwareProfileNameAnnotation) != profileName || oldProfileNamespace != profileNamespace
+}
+
+// checkProfileAccess checks the access restrictions of hwp for the workload obj created or
+// updated by the user of req. The namespace restrictions (Namespaces and NamespaceSelector) and
+// the group restriction must all allow the workload when set. It returns a
+// *ProfileAccessDeniedError when the workload is not allowed to use the profile, or another error
+// when the restrictions cannot be evaluated.
+func (i *Injector) checkProfileAccess(ctx context.Context, req *admission.Request, obj *unstructured.Unstructured, hwp *infrav1.HardwareProfile) error {
+	access := hwp.Spec.Access
+	if access == nil {
+		return nil
+	}
+
+	namespace := obj.GetNamespace()
+	denied := &ProfileAccessDeniedError{
+		ProfileName:       hwp.Name,
+		ProfileNamespace:  hwp.Namespace,
+		WorkloadNamespace: namespace,
+		Username:          req.UserInfo.Username,
+	}
+
+	if len(access.Namespaces) > 0 || access.NamespaceSelector != nil {
+		allowed, err := i.isNamespaceAllowed(ctx, access, namespace, hwp.Name)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			denied.NamespaceDenied = true
+			return denied
+		}
+	}
+
+	if len(access.Groups) > 0 && !slices.ContainsFunc(req.UserInfo.Groups, func(group string) bool {
+		return slices.Contains(access.Groups, group)
+	}) {
+		return denied
+	}
+
+	return nil
+}
+
+// isNamespaceAllowed reports whether namespace is listed in the access restrictions or matches
+// their namespace selector.
+func (i *Injector) isNamespaceAllowed(ctx context.Context, access *infrav1.AccessSpec, namespace string, profileName string) (bool, error) {
+	if slices.Contains(access.Namespaces, namespace) {
+		return true, nil
+	}
+
+	if access.NamespaceSelector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(access.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespace selector in hardware profile '%s': %w", profileName, err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := i.Client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get namespace '%s': %w", namespace, err)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -1,5 +1,3 @@
-//go:build !nowebhook
-
 package hardwareprofile
 
 import (
@@ -30,14 +28,15 @@ import (
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
 // Annotation constants.
 const (
-	HardwareProfileNameAnnotation      = "opendatahub.io/hardware-profile-name"
-	HardwareProfileNamespaceAnnotation = "opendatahub.io/hardware-profile-namespace"
+	HardwareProfileNameAnnotation      = annotations.HardwareProfileName
+	HardwareProfileNamespaceAnnotation = annotations.HardwareProfileNamespace
 )
 
 // Container name constants.
@@ -61,26 +60,44 @@ func (e *NoMatchingContainerError) Error() string {
 
 // WorkloadConfig defines path configuration for different workload types.
 type WorkloadConfig struct {
-	ContainersPath   []string // .spec.identifiers from HWProfile
-	NodeSelectorPath []string // .spec.scheduling.node.nodeSelector from HWProfile
-	TolerationsPath  []string // .spec.scheduling.node.tolerations from HWProfile
+	ContainersPath                []string // .spec.identifiers from HWProfile
+	NodeSelectorPath              []string // .spec.scheduling.node.nodeSelector from HWProfile
+	TolerationsPath               []string // .spec.scheduling.node.tolerations from HWProfile
+	AffinityPath                  []string // .spec.scheduling.node.nodeAffinity from HWProfile, under nodeAffinity
+	TopologySpreadConstraintsPath []string // .spec.scheduling.node.topologySpreadConstraints from HWProfile
+	PriorityClassNamePath         []string // .spec.scheduling.node.priorityClassName from HWProfile
 }
 
 // WorkloadConfigs maps Kubernetes resource kinds to their configuration paths.
 var WorkloadConfigs = map[string]WorkloadConfig{
 	gvk.InferenceServices.Kind: {
-		ContainersPath:   []string{"spec", "predictor", "model"}, // map map[string]interface{}
-		NodeSelectorPath: []string{"spec", "predictor", "nodeSelector"},
-		TolerationsPath:  []string{"spec", "predictor", "tolerations"},
+		ContainersPath:                []string{"spec", "predictor", "model"}, // map map[string]interface{}
+		NodeSelectorPath:              []string{"spec", "predictor", "nodeSelector"},
+		TolerationsPath:               []string{"spec", "predictor", "tolerations"},
+		AffinityPath:                  []string{"spec", "predictor", "affinity"},
+		TopologySpreadConstraintsPath: []string{"spec", "predictor", "topologySpreadConstraints"},
+		PriorityClassNamePath:         []string{"spec", "predictor", "priorityClassName"},
 	},
 	gvk.LLMInferenceServiceV1Alpha1.Kind: {
-		ContainersPath:   []string{"spec", "template", "containers"}, // slice []interface{}
-		NodeSelectorPath: []string{"spec", "template", "nodeSelector"},
-		TolerationsPath:  []string{"spec", "template", "tolerations"},
+		ContainersPath:                []string{"spec", "template", "containers"}, // slice []interface{}
+		NodeSelectorPath:              []string{"spec", "template", "nodeSelector"},
+		TolerationsPath:               []string{"spec", "template", "tolerations"},
+		AffinityPath:                  []string{"spec", "template", "affinity"},
+		TopologySpreadConstraintsPath: []string{"spec", "template", "topologySpreadConstraints"},
+		PriorityClassNamePath:         []string{"spec", "template", "priorityClassName"},
+	},
+	gvk.Notebook.Kind: {
+		ContainersPath:                []string{"spec", "template", "spec", "containers"}, // slice []interface{}
+		NodeSelectorPath:              []string{"spec", "template", "spec", "nodeSelector"},
+		TolerationsPath:               []string{"spec", "template", "spec", "tolerations"},
+		AffinityPath:                  []string{"spec", "template", "spec", "affinity"},
+		TopologySpreadConstraintsPath: []string{"spec", "template", "spec", "topologySpreadConstraints"},
+		PriorityClassNamePath:         []string{"spec", "template", "spec", "priorityClassName"},
 	},
 }
 
-// Notebook HWP admission is owned by workbenches-operator (/workbenches-hardware-profile).
+// Notebook HWP admission is owned by workbenches-operator (/workbenches-hardware-profile);
+// its WorkloadConfig is only used to propagate HardwareProfile changes to Notebooks.
 // ISVC/LLMISVC HWP moved to odh-model-controller (#3777). No kubebuilder markers —
 // OLM must not install platform HWP webhooks for these workload types.
 
@@ -89,6 +106,9 @@ type Injector struct {
 	Client  client.Reader
 	Decoder admission.Decoder
 	Name    string
+	// Targets reads the HardwareProfileTargets declaring additional workload kinds.
+	// When nil, only the built-in kinds are supported.
+	Targets client.Reader
 }
 
 // Assert that Injector implements admission.Handler interface.
@@ -104,7 +124,7 @@ var _ admission.Handler = &Injector{}
 func (i *Injector) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
 
-	// Register webhook path for InferenceServices and LLMInferenceServices.
+	// Register webhook path for InferenceServices, LLMInferenceServices and the kinds declared by HardwareProfileTargets.
 	hookServer.Register("/mutate-hardware-profile", &webhook.Admission{
 		Handler:        i,
 		LogConstructor: webhookutils.NewWebhookLogConstructor(i.Name),
@@ -144,8 +164,13 @@ func (i *Injector) Handle(ctx context.Context, req admission.Request) admission.
 	}
 
 	// Validate that we're processing an expected resource kind
-	if !isExpectedKind(req.Kind) {
-		err := fmt.Errorf("unexpected kind: %s", req.Kind.Kind)
+	expected, err := i.isExpectedKind(ctx, req.Kind)
+	if err != nil {
+		log.Error(err, "failed to look up HardwareProfileTargets")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if !expected {
+		err = fmt.Errorf("unexpected kind: %s", req.Kind.Kind)
 		log.Error(err, "got wrong kind")
 		return admission.Errored(http.StatusBadRequest, err)
 	}
@@ -169,28 +194,33 @@ func (i *Injector) Handle(ctx context.Context, req admission.Request) admission.
 	}
 }
 
-// isExpectedKind checks if the given GroupVersionKind is supported by the webhook.
+// isExpectedKind checks if the given GroupVersionKind is supported by the webhook, either
+// as a built-in kind or as a kind declared by a HardwareProfileTarget.
 //
 // Parameters:
+//   - ctx: Request context for the HardwareProfileTarget lookup
 //   - kind: The GroupVersionKind from the admission request to validate
 //
 // Returns:
 //   - bool: true if the kind is supported by the webhook, false otherwise
-func isExpectedKind(kind metav1.GroupVersionKind) bool {
-	// expectedGVKs contains the list of resource types that the hardware profile webhook should handle.
-	expectedGVKs := []schema.GroupVersionKind{
-		gvk.InferenceServices,           // serving.kserve.io/v1beta1/InferenceService
-		gvk.LLMInferenceServiceV1Alpha1, // serving.kserve.io/v1alpha1/LLMInferenceService
-		gvk.LLMInferenceServiceV1Alpha2, // serving.kserve.io/v1alpha2/LLMInferenceService
-	}
-
+//   - error: Any error encountered while looking up the HardwareProfileTargets
+func (i *Injector) isExpectedKind(ctx context.Context, kind metav1.GroupVersionKind) (bool, error) {
 	requestGVK := schema.GroupVersionKind{
 		Group:   kind.Group,
 		Version: kind.Version,
 		Kind:    kind.Kind,
 	}
 
-	return slices.Contains(expectedGVKs, requestGVK)
+	if slices.Contains(builtinKinds, requestGVK) {
+		return true, nil
+	}
+
+	target, err := i.fetchTarget(ctx, requestGVK)
+	if err != nil {
+		return false, err
+	}
+
+	return target != nil, nil
 }
 
 // performHardwareProfileInjection handles the core logic for hardware profile injection.
@@ -201,11 +231,12 @@ func isExpectedKind(kind metav1.GroupVersionKind) bool {
 //  1. Check for hardware profile annotations on the object
 //  2. Determine the namespace for the hardware profile lookup
 //  3. Fetch the HardwareProfile resource from the Kubernetes API
-//  4. Validate that the hardware profile has meaningful configuration
-//  5. Set the hardware profile namespace annotation if not present
-//  6. Detect if the hardware profile changed (on UPDATE operations)
-//  7. Apply hardware profile specifications to the workload
-//  8. Return the modified object as a patch response
+//  4. Check the profile access restrictions when the workload starts referencing it
+//  5. Validate that the hardware profile has meaningful configuration
+//  6. Set the hardware profile namespace annotation if not present
+//  7. Detect if the hardware profile changed (on UPDATE operations)
+//  8. Apply hardware profile specifications to the workload
+//  9. Return the modified object as a patch response
 //
 // Annotation Handling:
 //   - opendatahub.io/hardware-profile-name: Required annotation specifying the profile name
@@ -219,6 +250,7 @@ func isExpectedKind(kind metav1.GroupVersionKind) bool {
 // Error Conditions:
 //   - Returns HTTP 400 for object decoding failures or missing profile namespace
 //   - Returns HTTP 400 for non-existent hardware profiles
+//   - Denies workloads whose namespace and user are not allowed by the profile access restrictions
 //   - Returns HTTP 500 for internal errors during profile application or object marshaling
 //
 // Parameters:
@@ -265,6 +297,22 @@ func (i *Injector) performHardwareProfileInjection(ctx context.Context, req *adm
 		}
 	}
 
+	// Check the access restrictions of the profile when the workload starts referencing it
+	if startsReferencingProfile(req, profileName, profileNamespace) {
+		if err := i.checkProfileAccess(ctx, req, obj, hwp); err != nil {
+			var accessErr *ProfileAccessDeniedError
+			if errors.As(err, &accessErr) {
+				log.Info("denying workload referencing a restricted hardware profile",
+					"workload", obj.GetName(), "kind", obj.GetKind(), "namespace", obj.GetNamespace(),
+					"hardwareProfile", hwp.Name, "user", req.UserInfo.Username)
+				i.emitValidationErrorEvent(ctx, obj, err, hwp.Name)
+				return admission.Denied(err.Error())
+			}
+			log.Error(err, "Failed to check hardware profile access", "profile", profileName, "namespace", profileNamespace)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+	}
+
 	// Only set the annotation if it wasn't already set
 	if resources.GetAnnotation(obj, HardwareProfileNamespaceAnnotation) == "" {
 		resources.SetAnnotation(obj, HardwareProfileNamespaceAnnotation, profileNamespace)
@@ -422,6 +470,7 @@ func (i *Injector) detectProfileChange(req *admission.Request, newProfileName, n
 // Container name requirements:
 //   - LLMInferenceServices: Must have a container named "main"
 //   - InferenceServices: Not validated (uses predictor model path, not containers)
+//   - Kinds declared by HardwareProfileTargets: Not validated (all containers are used)
 //
 // CRITICAL: This function should return NoMatchingContainerError for name mismatches,
 // but must be defensive about structural errors. The caller handles NoMatchingContainerError
@@ -430,6 +479,10 @@ func (i *Injector) detectProfileChange(req *admission.Request, newProfileName, n
 // Returns:
 //   - error: NoMatchingContainerError if validation fails, other errors for structural issues, nil otherwise
 func (i *Injector) validateContainerNames(obj *unstructured.Unstructured) error {
+	if !slices.Contains(builtinKinds, obj.GroupVersionKind()) {
+		return nil
+	}
+
 	config, err := GetWorkloadConfig(obj.GetKind())
 	if err != nil {
 		// Unsupported kind - return error but caller will log and continue
@@ -590,7 +643,7 @@ func (i *Injector) emitValidationErrorEvent(ctx context.Context, obj *unstructur
 
 // handleHWPRemoval handles the case where an HWP annotation is removed from a workload.
 // It fetches the old HWP using the old object's annotations and removes only the
-// tolerations and nodeSelector entries that match the HWP's settings.
+// scheduling settings that match the HWP's settings.
 //
 // Returns:
 //   - *admission.Response: Response with patches if HWP was removed and cleanup performed, nil otherwise
@@ -639,7 +692,7 @@ func (i *Injector) handleHWPRemoval(ctx context.Context, req *admission.Request,
 	}
 
 	// Clean up HWP-applied settings
-	if err := i.removeHWPSettings(obj, oldHWP); err != nil {
+	if err := i.removeHWPSettings(ctx, obj, oldHWP); err != nil {
 		log.Error(err, "Failed to remove HWP settings")
 		resp := admission.Errored(http.StatusInternalServerError, err)
 		return &resp
@@ -660,27 +713,19 @@ func (i *Injector) handleHWPRemoval(ctx context.Context, req *admission.Request,
 	return &resp
 }
 
-// removeHWPSettings removes tolerations, nodeSelector, and Kueue label entries that were applied by the HWP.
-// It compares tolerations/nodeSelector on the workload with those defined in the HWP
+// removeHWPSettings removes node scheduling settings and the Kueue label that were applied by the HWP.
+// It compares the settings on the workload with those defined in the HWP
 // and removes only the matching ones, preserving manually-added settings.
-func (i *Injector) removeHWPSettings(obj *unstructured.Unstructured, hwp *infrav1.HardwareProfile) error {
-	config, err := GetWorkloadConfig(obj.GetKind())
+func (i *Injector) removeHWPSettings(ctx context.Context, obj *unstructured.Unstructured, hwp *infrav1.HardwareProfile) error {
+	templates, err := i.workloadTemplates(ctx, obj)
 	if err != nil {
 		return err
 	}
 
-	// Remove HWP-applied tolerations
 	if hwp.Spec.SchedulingSpec != nil && hwp.Spec.SchedulingSpec.Node != nil {
-		if len(hwp.Spec.SchedulingSpec.Node.Tolerations) > 0 {
-			if err := i.removeHWPTolerations(obj, config.TolerationsPath, hwp.Spec.SchedulingSpec.Node.Tolerations); err != nil {
-				return fmt.Errorf("failed to remove HWP tolerations: %w", err)
-			}
-		}
-
-		// Remove HWP-applied nodeSelector
-		if len(hwp.Spec.SchedulingSpec.Node.NodeSelector) > 0 {
-			if err := i.removeHWPNodeSelector(obj, config.NodeSelectorPath, hwp.Spec.SchedulingSpec.Node.NodeSelector); err != nil {
-				return fmt.Errorf("failed to remove HWP nodeSelector: %w", err)
+		for _, template := range templates {
+			if err := i.removeHWPNodeScheduling(template, hwp.Spec.SchedulingSpec.Node); err != nil {
+				return err
 			}
 		}
 	}
@@ -693,6 +738,49 @@ func (i *Injector) removeHWPSettings(obj *unstructured.Unstructured, hwp *infrav
 	return nil
 }
 
+// removeHWPNodeScheduling removes the node scheduling settings of nodeSpec from a workload template.
+func (i *Injector) removeHWPNodeScheduling(template workloadTemplate, nodeSpec *infrav1.NodeSchedulingSpec) error {
+	obj, config := template.obj, template.config
+
+	// Remove HWP-applied tolerations
+	if len(nodeSpec.Tolerations) > 0 {
+		if err := i.removeHWPTolerations(obj, config.TolerationsPath, nodeSpec.Tolerations); err != nil {
+			return fmt.Errorf("failed to remove HWP tolerations: %w", err)
+		}
+	}
+
+	// Remove HWP-applied nodeSelector
+	if len(nodeSpec.NodeSelector) > 0 {
+		if err := i.removeHWPNodeSelector(obj, config.NodeSelectorPath, nodeSpec.NodeSelector); err != nil {
+			return fmt.Errorf("failed to remove HWP nodeSelector: %w", err)
+		}
+	}
+
+	// Remove HWP-applied node affinity terms
+	if nodeSpec.NodeAffinity != nil {
+		if err := removeHWPNodeAffinity(obj, config.AffinityPath, nodeSpec.NodeAffinity); err != nil {
+			return fmt.Errorf("failed to remove HWP node affinity: %w", err)
+		}
+	}
+
+	// Remove HWP-applied topology spread constraints
+	if len(nodeSpec.TopologySpreadConstraints) > 0 {
+		if err := removeHWPTopologySpreadConstraints(obj, config.TopologySpreadConstraintsPath, nodeSpec.TopologySpreadConstraints); err != nil {
+			return fmt.Errorf("failed to remove HWP topologySpreadConstraints: %w", err)
+		}
+	}
+
+	// Remove HWP-applied priority class, unless the user changed it
+	if nodeSpec.PriorityClassName != "" {
+		existing, _, _ := unstructured.NestedString(obj.Object, config.PriorityClassNamePath...)
+		if existing == nodeSpec.PriorityClassName {
+			unstructured.RemoveNestedField(obj.Object, config.PriorityClassNamePath...)
+		}
+	}
+
+	return nil
+}
+
 // removeHWPTolerations removes tolerations from the workload that match the HWP's tolerations.
 func (i *Injector) removeHWPTolerations(obj *unstructured.Unstructured, tolerationsPath []string, hwpTolerations []corev1.Toleration) error {
 	existingTolerations, found, err := unstructured.NestedSlice(obj.Object, tolerationsPath...)
@@ -818,7 +906,7 @@ func (i *Injector) fetchHardwareProfile(ctx context.Context, namespace, name str
 //
 // Scheduling Configuration:
 //   - Applies Kueue LocalQueue labels for queue-based scheduling
-//   - Applies node scheduling constraints (nodeSelector, tolerations)
+//   - Applies node scheduling constraints (nodeSelector, tolerations, nodeAffinity, topologySpreadConstraints, priorityClassName)
 //   - When profileChanged is true, clears existing scheduling configuration before applying new settings
 //   - When profileChanged is false, merges tolerations to preserve manually-added ones
 //
@@ -836,6 +924,11 @@ func (i *Injector) applyHardwareProfileToWorkload(ctx context.Context, obj *unst
 
 	var warnings []string
 
+	templates, err := i.workloadTemplates(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	// When the hardware profile changes, clear existing scheduling configuration first
 	// This ensures stale tolerations/nodeSelector from the old profile are removed
 	if profileChanged {
@@ -845,12 +938,16 @@ func (i *Injector) applyHardwareProfileToWorkload(ctx context.Context, obj *unst
 		// Remove Kueue label
 		resources.RemoveLabel(obj, cluster.KueueQueueNameLabel)
 
-		// Remove nodeSelector and tolerations
-		if config, err := GetWorkloadConfig(obj.GetKind()); err == nil {
-			unstructured.RemoveNestedField(obj.Object, config.NodeSelectorPath...)
-			unstructured.RemoveNestedField(obj.Object, config.TolerationsPath...)
-		} else {
-			return nil, fmt.Errorf("failed to clear scheduling fields - unsupported workload kind: %s: %w", obj.GetKind(), err)
+		// Remove nodeSelector, tolerations, node affinity, topology spread constraints and priority class
+		for _, template := range templates {
+			config := template.config
+			unstructured.RemoveNestedField(template.obj.Object, config.NodeSelectorPath...)
+			unstructured.RemoveNestedField(template.obj.Object, config.TolerationsPath...)
+			if err := setNodeAffinity(template.obj, config.AffinityPath, nil); err != nil {
+				return nil, fmt.Errorf("failed to clear node affinity: %w", err)
+			}
+			unstructured.RemoveNestedField(template.obj.Object, config.TopologySpreadConstraintsPath...)
+			unstructured.RemoveNestedField(template.obj.Object, config.PriorityClassNamePath...)
 		}
 	}
 
@@ -858,8 +955,10 @@ func (i *Injector) applyHardwareProfileToWorkload(ctx context.Context, obj *unst
 
 	// Apply resource requirements to containers (only if there are identifiers)
 	if len(hwp.Spec.Identifiers) > 0 {
-		if err := i.applyResourceRequirementsToWorkload(ctx, obj, hwp); err != nil {
-			return nil, fmt.Errorf("failed to apply resource requirements: %w", err)
+		for _, template := range templates {
+			if err := i.applyResourceRequirementsToWorkload(ctx, template, hwp); err != nil {
+				return nil, fmt.Errorf("failed to apply resource requirements: %w", err)
+			}
 		}
 	}
 
@@ -886,11 +985,13 @@ func (i *Injector) applyHardwareProfileToWorkload(ctx context.Context, obj *unst
 
 		// Apply Node scheduling configuration if .spec.schedulingSpec.node is set
 		if hwp.Spec.SchedulingSpec.Node != nil {
-			nodeWarnings, err := i.applyNodeSchedulingConfiguration(obj, hwp.Spec.SchedulingSpec.Node, profileChanged, hwp.Name)
-			if err != nil {
-				return nil, fmt.Errorf("failed to apply node scheduling configuration: %w", err)
+			for _, template := range templates {
+				nodeWarnings, err := i.applyNodeSchedulingConfiguration(template, hwp.Spec.SchedulingSpec.Node, profileChanged, hwp.Name)
+				if err != nil {
+					return nil, fmt.Errorf("failed to apply node scheduling configuration: %w", err)
+				}
+				warnings = append(warnings, nodeWarnings...)
 			}
-			warnings = append(warnings, nodeWarnings...)
 		}
 	}
 
@@ -918,21 +1019,18 @@ func GetWorkloadConfig(kind string) (WorkloadConfig, error) {
 }
 
 // applyResourceRequirementsToWorkload applies resource requirements (cpu, memory, counts) to all containers
-// in a workload resource. This method handles the container-level resource injection
+// in a workload template. This method handles the container-level resource injection
 // for both standard and custom resource types.
 //
 // Parameters:
-//   - obj: The unstructured workload object containing containers to modify
+//   - template: The workload template containing containers to modify
 //   - hwp: The HardwareProfile resource containing resource identifiers to apply
 //
 // Returns:
 //   - error: Any error encountered during resource requirement application, nil on success
 
-func (i *Injector) applyResourceRequirementsToWorkload(ctx context.Context, obj *unstructured.Unstructured, hwp *infrav1.HardwareProfile) error {
-	config, err := GetWorkloadConfig(obj.GetKind())
-	if err != nil {
-		return err
-	}
+func (i *Injector) applyResourceRequirementsToWorkload(ctx context.Context, template workloadTemplate, hwp *infrav1.HardwareProfile) error {
+	obj, config := template.obj, template.config
 	// Handle different workload types explicitly
 	switch obj.GetKind() {
 	case gvk.InferenceServices.Kind:
@@ -942,8 +1040,8 @@ func (i *Injector) applyResourceRequirementsToWorkload(ctx context.Context, obj
 		// For LLMInferenceServices, apply resources only to the main container
 		return i.applyResourceRequirementsToContainers(ctx, obj, hwp, config.ContainersPath, llmInferenceServiceMainContainerIndices(obj, config.ContainersPath))
 	default:
-		// This should never happen since isExpectedKind() should catch unsupported kinds earlier
-		return fmt.Errorf("unsupported workload kind: %s", obj.GetKind())
+		// For pod templates declared by HardwareProfileTargets, apply resources to all containers
+		return i.applyResourceRequirementsToContainers(ctx, obj, hwp, config.ContainersPath, nil)
 	}
 }
 
@@ -1180,33 +1278,33 @@ func (i *Injector) applyIdentifiersToLimits(
 }
 
 // applyNodeSchedulingConfiguration applies node scheduling constraints to the workload.
-// This method handles the application of nodeSelector and tolerations from the hardware
+// This method handles the application of the node scheduling settings from the hardware
 // profile to ensure workloads are scheduled on appropriate nodes.
 //
-// The method applies two types of node scheduling constraints:
+// The method applies five types of node scheduling constraints:
 //  1. NodeSelector: Key-value pairs that must match node labels
 //  2. Tolerations: Specifications that allow scheduling on nodes with matching taints
+//  3. NodeAffinity: Required and preferred node selector terms
+//  4. TopologySpreadConstraints: How pods are spread across topology domains
+//  5. PriorityClassName: The PriorityClass of the workload pods
 //
 // Configuration Application:
-//   - Both nodeSelector and tolerations follow the same behavior based on profileChanged:
+//   - All configurations follow the same behavior based on profileChanged:
 //   - When profileChanged is true: Replace existing values (clearing already done)
 //   - When profileChanged is false: Merge with existing ones to preserve manually-added values
-//   - Both configurations are applied only if present in the hardware profile
+//   - Configurations are applied only if present in the hardware profile
 //
 // Parameters:
-//   - obj: The unstructured workload object to modify
+//   - template: The workload template to modify
 //   - nodeSpec: The NodeSchedulingSpec resource containing node scheduling specifications
 //   - profileChanged: Whether the hardware profile changed (determines merge vs replace behavior)
 //   - hwpName: The name of the HardwareProfile (for warning messages)
 //
 // Returns:
-//   - []string: Warnings about nodeSelector values that will be overwritten
+//   - []string: Warnings about nodeSelector, topologySpreadConstraints and priorityClassName values that will be overwritten
 //   - error: Any error encountered during node scheduling configuration application
-func (i *Injector) applyNodeSchedulingConfiguration(obj *unstructured.Unstructured, nodeSpec *infrav1.NodeSchedulingSpec, profileChanged bool, hwpName string) ([]string, error) {
-	config, err := GetWorkloadConfig(obj.GetKind())
-	if err != nil {
-		return nil, fmt.Errorf("unsupported workload kind for node scheduling: %s", obj.GetKind())
-	}
+func (i *Injector) applyNodeSchedulingConfiguration(template workloadTemplate, nodeSpec *infrav1.NodeSchedulingSpec, profileChanged bool, hwpName string) ([]string, error) {
+	obj, config := template.obj, template.config
 
 	var warnings []string
 
@@ -1259,6 +1357,41 @@ func (i *Injector) applyNodeSchedulingConfiguration(obj *unstructured.Unstructur
 		}
 	}
 
+	// Apply node affinity if present
+	// Merging is idempotent and, when profile changed, the node affinity was already cleared
+	if nodeSpec.NodeAffinity != nil {
+		if err := mergeNodeAffinity(obj, config.AffinityPath, nodeSpec.NodeAffinity); err != nil {
+			return nil, fmt.Errorf("failed to merge node affinity: %w", err)
+		}
+	}
+
+	// Apply topology spread constraints if present
+	if len(nodeSpec.TopologySpreadConstraints) > 0 {
+		constraintWarnings, err := mergeTopologySpreadConstraints(obj, config.TopologySpreadConstraintsPath, nodeSpec.TopologySpreadConstraints, hwpName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge topologySpreadConstraints: %w", err)
+		}
+		if !profileChanged {
+			warnings = append(warnings, constraintWarnings...)
+		}
+	}
+
+	// Apply priority class if present
+	if nodeSpec.PriorityClassName != "" {
+		existing, _, err := unstructured.NestedString(obj.Object, config.PriorityClassNamePath...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get existing priorityClassName: %w", err)
+		}
+		if !profileChanged && existing != "" && existing != nodeSpec.PriorityClassName {
+			warnings = append(warnings, fmt.Sprintf(
+				"priorityClassName '%s' will be overwritten by HardwareProfile '%s' which has value '%s'",
+				existing, hwpName, nodeSpec.PriorityClassName))
+		}
+		if err := unstructured.SetNestedField(obj.Object, nodeSpec.PriorityClassName, config.PriorityClassNamePath...); err != nil {
+			return nil, fmt.Errorf("failed to set priorityClassName: %w", err)
+		}
+	}
+
 	return warnings, nil
 }
 
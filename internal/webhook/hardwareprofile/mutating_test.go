@@ -2152,3 +2152,299 @@ func TestHardwareProfile_NoKueueWarningWhenLabelUnchanged(t *testing.T) {
 	// Should NOT have any warnings since user didn't modify HWP-managed Kueue label
 	g.Expect(resp.Warnings).Should(BeEmpty(), "Should have no warnings when user doesn't modify HWP Kueue label")
 }
+
+// TestHardwareProfile_AffinityTopologyAndPriorityClass tests that node affinity, topology spread
+// constraints and priority class are applied, and removed when switching profiles.
+func TestHardwareProfile_AffinityTopologyAndPriorityClass(t *testing.T) {
+	t.Parallel()
+	sch, ctx := setupTestEnvironment(t)
+
+	hwpWithAffinity := envtestutil.NewHardwareProfile("hwp-with-affinity", testNamespace,
+		envtestutil.WithHardwareProfileSpec(infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node: &infrav1.NodeSchedulingSpec{
+					NodeAffinity: &corev1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+							Weight: 10,
+							Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: "nvidia.com/gpu.product", Operator: corev1.NodeSelectorOpIn, Values: []string{"A100"}},
+							}},
+						}},
+					},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+					}},
+					PriorityClassName: "training-high",
+				},
+			},
+		}),
+	)
+	hwpEmpty := envtestutil.NewHardwareProfile("hwp-empty", testNamespace)
+
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(hwpWithAffinity, hwpEmpty).Build()
+	injector := createWebhookInjector(cli, sch)
+
+	llmisvcGVR := metav1.GroupVersionResource{
+		Group:    gvk.LLMInferenceServiceV1Alpha1.Group,
+		Version:  gvk.LLMInferenceServiceV1Alpha1.Version,
+		Resource: "llminferenceservices",
+	}
+
+	hasPatch := func(patches []jsonpatch.JsonPatchOperation, op string, field string) bool {
+		for _, patch := range patches {
+			if patch.Operation == op && strings.Contains(patch.Path, field) {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("applies the settings on create", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		workload := envtestutil.NewLLMInferenceService(testLLMInferenceService, testNamespace, envtestutil.WithHardwareProfile("hwp-with-affinity"))
+		req := envtestutil.NewAdmissionRequest(t, admissionv1.Create, workload, gvk.LLMInferenceServiceV1Alpha1, llmisvcGVR)
+
+		resp := injector.Handle(ctx, req)
+		g.Expect(resp.Allowed).Should(BeTrue())
+		g.Expect(hasPatch(resp.Patches, webhookutils.PatchOpAdd, "affinity")).Should(BeTrue())
+		g.Expect(hasPatch(resp.Patches, webhookutils.PatchOpAdd, "topologySpreadConstraints")).Should(BeTrue())
+		g.Expect(hasPatch(resp.Patches, webhookutils.PatchOpAdd, "priorityClassName")).Should(BeTrue())
+	})
+
+	t.Run("removes the settings when switching profiles", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		applied := func(profile string) *unstructured.Unstructured {
+			obj, ok := envtestutil.NewLLMInferenceService(testLLMInferenceService, testNamespace, envtestutil.WithHardwareProfile(profile)).(*unstructured.Unstructured)
+			g.Expect(ok).Should(BeTrue())
+			_ = unstructured.SetNestedMap(obj.Object, map[string]any{
+				"nodeAffinity": map[string]any{
+					"preferredDuringSchedulingIgnoredDuringExecution": []any{map[string]any{
+						"weight": int64(10),
+						"preference": map[string]any{"matchExpressions": []any{map[string]any{
+							"key": "nvidia.com/gpu.product", "operator": "In", "values": []any{"A100"},
+						}}},
+					}},
+				},
+			}, "spec", "template", "affinity")
+			_ = unstructured.SetNestedField(obj.Object, "training-high", "spec", "template", "priorityClassName")
+			return obj
+		}
+
+		newObjBytes, err := json.Marshal(applied("hwp-empty"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		oldObjBytes, err := json.Marshal(applied("hwp-with-affinity"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		req := admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       "test-uid",
+				Kind:      metav1.GroupVersionKind{Group: gvk.LLMInferenceServiceV1Alpha1.Group, Version: gvk.LLMInferenceServiceV1Alpha1.Version, Kind: gvk.LLMInferenceServiceV1Alpha1.Kind},
+				Resource:  llmisvcGVR,
+				Namespace: testNamespace,
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: newObjBytes},
+				OldObject: runtime.RawExtension{Raw: oldObjBytes},
+			},
+		}
+
+		resp := injector.Handle(ctx, req)
+		g.Expect(resp.Allowed).Should(BeTrue())
+		g.Expect(hasPatch(resp.Patches, webhookutils.PatchOpRemove, "affinity")).Should(BeTrue(), "Should remove the previous profile node affinity")
+		g.Expect(hasPatch(resp.Patches, webhookutils.PatchOpRemove, "priorityClassName")).Should(BeTrue(), "Should remove the previous profile priority class")
+	})
+}
+
+// TestHardwareProfile_HardwareProfileTarget tests the injection into a kind declared by a HardwareProfileTarget.
+func TestHardwareProfile_HardwareProfileTarget(t *testing.T) {
+	t.Parallel()
+	sch, ctx := setupTestEnvironment(t)
+
+	hwp := envtestutil.NewHardwareProfile(testHardwareProfile, testNamespace,
+		envtestutil.WithHardwareProfileSpec(infrav1.HardwareProfileSpec{
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node: &infrav1.NodeSchedulingSpec{
+					NodeSelector: map[string]string{"accelerator": "a100"},
+				},
+			},
+		}),
+	)
+	target := &infrav1.HardwareProfileTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployments"},
+		Spec: infrav1.HardwareProfileTargetSpec{
+			Group:   gvk.Deployment.Group,
+			Version: gvk.Deployment.Version,
+			Kind:    gvk.Deployment.Kind,
+			PodTemplates: []infrav1.HardwareProfilePodTemplate{
+				{Name: "main", PodSpecPath: "spec.template.spec"},
+			},
+		},
+	}
+
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "app"}},
+		}}},
+	}}
+	deployment.SetGroupVersionKind(gvk.Deployment)
+	deployment.SetName("app")
+	deployment.SetNamespace(testNamespace)
+	deployment.SetAnnotations(map[string]string{hardwareprofile.HardwareProfileNameAnnotation: testHardwareProfile})
+
+	deploymentGVR := metav1.GroupVersionResource{Group: gvk.Deployment.Group, Version: gvk.Deployment.Version, Resource: "deployments"}
+
+	t.Run("injects into the declared pod template", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(hwp, target).Build()
+		injector := createWebhookInjector(cli, sch)
+		injector.Targets = cli
+
+		resp := injector.Handle(ctx, envtestutil.NewAdmissionRequest(t, admissionv1.Create, deployment, gvk.Deployment, deploymentGVR))
+		g.Expect(resp.Allowed).Should(BeTrue())
+		g.Expect(resp.Patches).Should(ContainElement(HaveField("Path", "/spec/template/spec/nodeSelector")))
+	})
+
+	t.Run("rejects kinds without a target", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(hwp).Build()
+		injector := createWebhookInjector(cli, sch)
+		injector.Targets = cli
+
+		resp := injector.Handle(ctx, envtestutil.NewAdmissionRequest(t, admissionv1.Create, deployment, gvk.Deployment, deploymentGVR))
+		g.Expect(resp.Allowed).Should(BeFalse())
+		g.Expect(resp.Result.Code).Should(Equal(int32(400)))
+	})
+}
+
+// TestHardwareProfile_AccessRestrictions tests the namespace and group restrictions on profile usage.
+func TestHardwareProfile_AccessRestrictions(t *testing.T) {
+	t.Parallel()
+	sch, ctx := setupTestEnvironment(t)
+
+	restricted := func(access infrav1.AccessSpec) *infrav1.HardwareProfile {
+		return envtestutil.NewHardwareProfile(testHardwareProfile, hwpNamespace,
+			envtestutil.WithHardwareProfileSpec(infrav1.HardwareProfileSpec{
+				Identifiers: []infrav1.HardwareIdentifier{
+					{DisplayName: "GPU", Identifier: "nvidia.com/gpu", DefaultCount: intstr.FromInt32(1), ResourceType: "Accelerator"},
+				},
+				Access: &access,
+			}),
+		)
+	}
+
+	workload := envtestutil.NewLLMInferenceService(testLLMInferenceService, testNamespace,
+		envtestutil.WithHardwareProfile(testHardwareProfile),
+		envtestutil.WithHardwareProfileNamespace(hwpNamespace),
+	)
+	llmisvcGVR := metav1.GroupVersionResource{Group: gvk.LLMInferenceServiceV1Alpha1.Group, Version: gvk.LLMInferenceServiceV1Alpha1.Version, Resource: "llminferenceservices"}
+
+	testCases := []struct {
+		name    string
+		access  infrav1.AccessSpec
+		groups  []string
+		objects []client.Object
+		allowed bool
+	}{
+		{
+			name:    "denies namespaces not allowed",
+			access:  infrav1.AccessSpec{Namespaces: []string{"team-a"}},
+			allowed: false,
+		},
+		{
+			name:    "allows listed namespaces",
+			access:  infrav1.AccessSpec{Namespaces: []string{testNamespace}},
+			allowed: true,
+		},
+		{
+			name:    "allows members of allowed groups",
+			access:  infrav1.AccessSpec{Groups: []string{"gpu-users"}},
+			groups:  []string{"system:authenticated", "gpu-users"},
+			allowed: true,
+		},
+		{
+			name:    "denies users outside the allowed groups",
+			access:  infrav1.AccessSpec{Groups: []string{"gpu-users"}},
+			groups:  []string{"system:authenticated"},
+			allowed: false,
+		},
+		{
+			name:    "denies members of allowed groups in namespaces not allowed",
+			access:  infrav1.AccessSpec{Namespaces: []string{"team-a"}, Groups: []string{"gpu-users"}},
+			groups:  []string{"system:authenticated", "gpu-users"},
+			allowed: false,
+		},
+		{
+			name:    "denies users outside the allowed groups in allowed namespaces",
+			access:  infrav1.AccessSpec{Namespaces: []string{testNamespace}, Groups: []string{"gpu-users"}},
+			groups:  []string{"system:authenticated"},
+			allowed: false,
+		},
+		{
+			name:    "allows members of allowed groups in allowed namespaces",
+			access:  infrav1.AccessSpec{Namespaces: []string{testNamespace}, Groups: []string{"gpu-users"}},
+			groups:  []string{"system:authenticated", "gpu-users"},
+			allowed: true,
+		},
+		{
+			name:    "allows namespaces matching the selector",
+			access:  infrav1.AccessSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+			objects: []client.Object{envtestutil.NewNamespace(testNamespace, map[string]string{"team": "a"})},
+			allowed: true,
+		},
+		{
+			name:    "denies namespaces not matching the selector",
+			access:  infrav1.AccessSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+			objects: []client.Object{envtestutil.NewNamespace(testNamespace, map[string]string{"team": "b"})},
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(append(tc.objects, restricted(tc.access))...).Build()
+			injector := createWebhookInjector(cli, sch)
+
+			req := envtestutil.NewAdmissionRequest(t, admissionv1.Create, workload, gvk.LLMInferenceServiceV1Alpha1, llmisvcGVR)
+			req.UserInfo.Username = "alice"
+			req.UserInfo.Groups = tc.groups
+
+			resp := injector.Handle(ctx, req)
+			g.Expect(resp.Allowed).Should(Equal(tc.allowed))
+			if !tc.allowed {
+				g.Expect(resp.Result.Code).Should(Equal(int32(403)))
+				g.Expect(resp.Result.Message).Should(ContainSubstring("cannot be used by workloads in namespace '%s'", testNamespace))
+			}
+		})
+	}
+
+	t.Run("does not check updates of workloads already referencing the profile", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(restricted(infrav1.AccessSpec{Namespaces: []string{"team-a"}})).Build()
+		injector := createWebhookInjector(cli, sch)
+
+		objBytes, err := json.Marshal(workload)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		req := envtestutil.NewAdmissionRequest(t, admissionv1.Update, workload, gvk.LLMInferenceServiceV1Alpha1, llmisvcGVR)
+		req.OldObject = runtime.RawExtension{Raw: objBytes}
+
+		resp := injector.Handle(ctx, req)
+		g.Expect(resp.Allowed).Should(BeTrue())
+	})
+}
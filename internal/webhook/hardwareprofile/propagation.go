@@ -0,0 +1,147 @@
+package hardwareprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// ReapplyHardwareProfile replaces in obj the settings of previous, the spec of hwp last
+// applied to the workloads, with the current settings of hwp. It is used to propagate
+// HardwareProfile changes to the existing workloads. Settings changed by users,
+// such as resource counts different from the previous default counts, are preserved.
+func (i *Injector) ReapplyHardwareProfile(ctx context.Context, obj *unstructured.Unstructured, previous *infrav1.HardwareProfileSpec, hwp *infrav1.HardwareProfile) error {
+	if err := i.validateContainerNames(obj); err != nil {
+		return err
+	}
+
+	previousHWP := &infrav1.HardwareProfile{ObjectMeta: hwp.ObjectMeta, Spec: *previous}
+	if err := i.removeHWPSettings(ctx, obj, previousHWP); err != nil {
+		return err
+	}
+
+	if err := i.removeHWPIdentifiers(ctx, obj, previous.Identifiers); err != nil {
+		return err
+	}
+
+	_, err := i.applyHardwareProfileToWorkload(ctx, obj, hwp, false)
+
+	return err
+}
+
+// removeHWPIdentifiers removes from the containers of obj the resource requests and limits
+// equal to the default counts of identifiers, i.e. those injected by the profile.
+func (i *Injector) removeHWPIdentifiers(ctx context.Context, obj *unstructured.Unstructured, identifiers []infrav1.HardwareIdentifier) error {
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	templates, err := i.workloadTemplates(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		if err := removeIdentifiersFromTemplate(template, identifiers); err != nil {
+			return fmt.Errorf("failed to remove HWP resource requirements: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeIdentifiersFromTemplate removes the resources of identifiers from the containers
+// of template the profile applies them to.
+func removeIdentifiersFromTemplate(template workloadTemplate, identifiers []infrav1.HardwareIdentifier) error {
+	obj, containersPath := template.obj, template.config.ContainersPath
+
+	// For InferenceServices, resources are applied to the model object
+	if obj.GetKind() == gvk.InferenceServices.Kind {
+		model, found, err := unstructured.NestedMap(obj.Object, containersPath...)
+		if err != nil || !found {
+			return err
+		}
+		if err := removeIdentifiersFromContainer(model, identifiers); err != nil {
+			return err
+		}
+
+		return unstructured.SetNestedMap(obj.Object, model, containersPath...)
+	}
+
+	containers, found, err := unstructured.NestedSlice(obj.Object, containersPath...)
+	if err != nil || !found {
+		return err
+	}
+
+	// For LLMInferenceServices, resources are applied to the main container only
+	var indices []int
+	if obj.GetKind() == gvk.LLMInferenceServiceV1Alpha1.Kind {
+		indices = llmInferenceServiceMainContainerIndices(obj, containersPath)
+	}
+
+	for idx, container := range containers {
+		if indices != nil && !slices.Contains(indices, idx) {
+			continue
+		}
+
+		containerMap, ok := container.(map[string]any)
+		if !ok {
+			return errors.New("container is not a map[string]interface{}")
+		}
+		if err := removeIdentifiersFromContainer(containerMap, identifiers); err != nil {
+			return fmt.Errorf("failed to remove resources from container %d: %w", idx, err)
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, containersPath...)
+}
+
+// removeIdentifiersFromContainer removes the requests and limits of container equal to the
+// default counts of identifiers, and the resources sections left empty.
+func removeIdentifiersFromContainer(container map[string]any, identifiers []infrav1.HardwareIdentifier) error {
+	for _, section := range []string{"requests", "limits"} {
+		values, found, err := unstructured.NestedMap(container, "resources", section)
+		if err != nil {
+			return fmt.Errorf("failed to get resource %s: %w", section, err)
+		}
+		if !found {
+			continue
+		}
+
+		for _, identifier := range identifiers {
+			value, exists := values[identifier.Identifier]
+			if !exists {
+				continue
+			}
+
+			applied, err := convertIntOrStringToQuantity(identifier.DefaultCount)
+			if err != nil {
+				return fmt.Errorf("failed to convert resource quantity for %s: %w", identifier.Identifier, err)
+			}
+
+			quantity, err := resource.ParseQuantity(fmt.Sprint(value))
+			if err == nil && quantity.Cmp(applied) == 0 {
+				delete(values, identifier.Identifier)
+			}
+		}
+
+		if len(values) == 0 {
+			unstructured.RemoveNestedField(container, "resources", section)
+		} else if err := unstructured.SetNestedMap(container, values, "resources", section); err != nil {
+			return err
+		}
+	}
+
+	if res, found, _ := unstructured.NestedMap(container, "resources"); found && len(res) == 0 {
+		unstructured.RemoveNestedField(container, "resources")
+	}
+
+	return nil
+}
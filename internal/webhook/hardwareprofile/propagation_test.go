@@ -0,0 +1,91 @@
+//go:build !nowebhook
+
+//nolint:testpackage // white-box tests for the unexported injection helpers
+package hardwareprofile
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func gpuProfileSpec(count int32, taint string) infrav1.HardwareProfileSpec {
+	return infrav1.HardwareProfileSpec{
+		Identifiers: []infrav1.HardwareIdentifier{
+			{Identifier: "nvidia.com/gpu", DefaultCount: intstr.FromInt32(count), ResourceType: "Accelerator"},
+		},
+		SchedulingSpec: &infrav1.SchedulingSpec{
+			SchedulingType: infrav1.NodeScheduling,
+			Node: &infrav1.NodeSchedulingSpec{
+				Tolerations: []corev1.Toleration{{Key: taint, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+			},
+		},
+	}
+}
+
+func inferenceService(name, profile, gpus string) *unstructured.Unstructured {
+	obj := resources.GvkToUnstructured(gvk.InferenceServices)
+	obj.SetName(name)
+	obj.SetNamespace("user")
+	obj.SetAnnotations(map[string]string{HardwareProfileNameAnnotation: profile})
+	obj.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"model": map[string]any{
+				"name": "model",
+				"resources": map[string]any{
+					"requests": map[string]any{"nvidia.com/gpu": gpus},
+					"limits":   map[string]any{"nvidia.com/gpu": gpus},
+				},
+			},
+			"tolerations": []any{
+				map[string]any{"key": "old", "operator": "Exists", "effect": "NoSchedule"},
+				map[string]any{"key": "user", "operator": "Exists", "effect": "NoSchedule"},
+			},
+		},
+	}
+
+	return obj
+}
+
+func TestReapplyHardwareProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	previous := gpuProfileSpec(1, "old")
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "user"},
+		Spec:       gpuProfileSpec(2, "new"),
+	}
+
+	injector := &Injector{}
+
+	obj := inferenceService("injected", "gpu", "1")
+	g.Expect(injector.ReapplyHardwareProfile(t.Context(), obj, &previous, hwp)).To(Succeed())
+
+	requests, _, err := unstructured.NestedStringMap(obj.Object, "spec", "predictor", "model", "resources", "requests")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests).To(HaveKeyWithValue("nvidia.com/gpu", "2"))
+
+	tolerations, _, err := unstructured.NestedSlice(obj.Object, "spec", "predictor", "tolerations")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tolerations).To(ConsistOf(
+		HaveKeyWithValue("key", "new"),
+		HaveKeyWithValue("key", "user"),
+	))
+
+	// Counts changed by users are preserved.
+	obj = inferenceService("customized", "gpu", "4")
+	g.Expect(injector.ReapplyHardwareProfile(t.Context(), obj, &previous, hwp)).To(Succeed())
+
+	limits, _, err := unstructured.NestedStringMap(obj.Object, "spec", "predictor", "model", "resources", "limits")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(limits).To(HaveKeyWithValue("nvidia.com/gpu", "4"))
+}
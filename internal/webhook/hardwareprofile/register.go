@@ -15,13 +15,11 @@ import (
 // Returns:
 //   - error: Any error encountered during webhook registration.
 func RegisterWebhooks(mgr ctrl.Manager) error {
-	if err := (&Injector{
+	injector := &Injector{
 		Client:  mgr.GetAPIReader(),
 		Decoder: admission.NewDecoder(mgr.GetScheme()),
 		Name:    "hardwareprofile-injector",
-	}).SetupWithManager(mgr); err != nil {
-		return err
+		Targets: mgr.GetClient(),
 	}
-
-	return nil
+	return injector.SetupWithManager(mgr)
 }
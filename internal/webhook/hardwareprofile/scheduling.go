@@ -0,0 +1,325 @@
+package hardwareprofile
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// mergeNodeAffinity merges HardwareProfile node affinity with the existing node affinity on the workload.
+//
+// Required node selector terms are ANDed with the workload ones: each workload term is combined
+// with each HardwareProfile term, so that pods only land on nodes matching both. Preferred terms
+// are added to the workload ones. Terms previously applied by the same HardwareProfile are removed
+// first, which makes the merge idempotent across updates.
+//
+// Parameters:
+//   - obj: The unstructured workload object containing existing affinity
+//   - affinityPath: The path to the affinity field in the workload
+//   - hwpAffinity: The node affinity from the HardwareProfile to apply
+//
+// Returns:
+//   - error: Any error encountered during the merge operation
+func mergeNodeAffinity(obj *unstructured.Unstructured, affinityPath []string, hwpAffinity *corev1.NodeAffinity) error {
+	existing, err := getNodeAffinity(obj, affinityPath)
+	if err != nil {
+		return err
+	}
+
+	merged := subtractNodeAffinity(existing, hwpAffinity)
+
+	if hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		len(hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) > 0 {
+		hwpTerms := hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+
+		if merged.RequiredDuringSchedulingIgnoredDuringExecution == nil ||
+			len(merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+			merged.RequiredDuringSchedulingIgnoredDuringExecution = hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution.DeepCopy()
+		} else {
+			existingTerms := merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			terms := make([]corev1.NodeSelectorTerm, 0, len(existingTerms)*len(hwpTerms))
+			for _, term := range existingTerms {
+				for _, hwpTerm := range hwpTerms {
+					terms = append(terms, joinNodeSelectorTerms(term, hwpTerm))
+				}
+			}
+			merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+		}
+	}
+
+	// HardwareProfile preferred terms come first, like tolerations
+	merged.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		slices.Clone(hwpAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		merged.PreferredDuringSchedulingIgnoredDuringExecution...,
+	)
+
+	return setNodeAffinity(obj, affinityPath, merged)
+}
+
+// removeHWPNodeAffinity removes the node affinity terms that were applied by the HardwareProfile,
+// preserving the ones added manually.
+func removeHWPNodeAffinity(obj *unstructured.Unstructured, affinityPath []string, hwpAffinity *corev1.NodeAffinity) error {
+	existing, err := getNodeAffinity(obj, affinityPath)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil // No node affinity to remove
+	}
+
+	return setNodeAffinity(obj, affinityPath, subtractNodeAffinity(existing, hwpAffinity))
+}
+
+// subtractNodeAffinity returns a copy of existing without the terms of hwpAffinity.
+// Required terms that contain a HardwareProfile term get its requirements removed,
+// and are dropped when nothing else remains. Preferred terms equal to a HardwareProfile
+// term are dropped.
+func subtractNodeAffinity(existing *corev1.NodeAffinity, hwpAffinity *corev1.NodeAffinity) *corev1.NodeAffinity {
+	if existing == nil {
+		return &corev1.NodeAffinity{}
+	}
+
+	result := existing.DeepCopy()
+
+	if result.RequiredDuringSchedulingIgnoredDuringExecution != nil && hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		hwpTerms := hwpAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+
+		var terms []corev1.NodeSelectorTerm
+		for _, term := range result.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			for _, hwpTerm := range hwpTerms {
+				if containsNodeSelectorTerm(term, hwpTerm) {
+					term = corev1.NodeSelectorTerm{
+						MatchExpressions: withoutRequirements(term.MatchExpressions, hwpTerm.MatchExpressions),
+						MatchFields:      withoutRequirements(term.MatchFields, hwpTerm.MatchFields),
+					}
+					break
+				}
+			}
+
+			// Combined terms collapse to the same workload term once the HardwareProfile part is removed
+			if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 ||
+				slices.ContainsFunc(terms, func(t corev1.NodeSelectorTerm) bool { return equality.Semantic.DeepEqual(t, term) }) {
+				continue
+			}
+			terms = append(terms, term)
+		}
+
+		if len(terms) == 0 {
+			result.RequiredDuringSchedulingIgnoredDuringExecution = nil
+		} else {
+			result.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+		}
+	}
+
+	result.PreferredDuringSchedulingIgnoredDuringExecution = slices.DeleteFunc(
+		result.PreferredDuringSchedulingIgnoredDuringExecution,
+		func(term corev1.PreferredSchedulingTerm) bool {
+			return slices.ContainsFunc(hwpAffinity.PreferredDuringSchedulingIgnoredDuringExecution, func(hwpTerm corev1.PreferredSchedulingTerm) bool {
+				return equality.Semantic.DeepEqual(term, hwpTerm)
+			})
+		},
+	)
+
+	return result
+}
+
+// joinNodeSelectorTerms returns a term matching the nodes that match both term and other.
+func joinNodeSelectorTerms(term corev1.NodeSelectorTerm, other corev1.NodeSelectorTerm) corev1.NodeSelectorTerm {
+	joined := *term.DeepCopy()
+	for _, req := range other.MatchExpressions {
+		if !containsRequirement(joined.MatchExpressions, req) {
+			joined.MatchExpressions = append(joined.MatchExpressions, *req.DeepCopy())
+		}
+	}
+	for _, req := range other.MatchFields {
+		if !containsRequirement(joined.MatchFields, req) {
+			joined.MatchFields = append(joined.MatchFields, *req.DeepCopy())
+		}
+	}
+
+	return joined
+}
+
+// containsNodeSelectorTerm reports whether term has all the requirements of other.
+func containsNodeSelectorTerm(term corev1.NodeSelectorTerm, other corev1.NodeSelectorTerm) bool {
+	for _, req := range other.MatchExpressions {
+		if !containsRequirement(term.MatchExpressions, req) {
+			return false
+		}
+	}
+	for _, req := range other.MatchFields {
+		if !containsRequirement(term.MatchFields, req) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsRequirement(reqs []corev1.NodeSelectorRequirement, req corev1.NodeSelectorRequirement) bool {
+	return slices.ContainsFunc(reqs, func(r corev1.NodeSelectorRequirement) bool {
+		return equality.Semantic.DeepEqual(r, req)
+	})
+}
+
+func withoutRequirements(reqs []corev1.NodeSelectorRequirement, remove []corev1.NodeSelectorRequirement) []corev1.NodeSelectorRequirement {
+	return slices.DeleteFunc(slices.Clone(reqs), func(r corev1.NodeSelectorRequirement) bool {
+		return containsRequirement(remove, r)
+	})
+}
+
+// getNodeAffinity returns the node affinity of the workload, or nil if it has none.
+func getNodeAffinity(obj *unstructured.Unstructured, affinityPath []string) (*corev1.NodeAffinity, error) {
+	content, found, err := unstructured.NestedMap(obj.Object, append(slices.Clone(affinityPath), "nodeAffinity")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing node affinity: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	nodeAffinity := &corev1.NodeAffinity{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, nodeAffinity); err != nil {
+		return nil, fmt.Errorf("failed to convert existing node affinity: %w", err)
+	}
+
+	return nodeAffinity, nil
+}
+
+// setNodeAffinity sets the node affinity of the workload. An empty or nil node affinity
+// is removed, together with the affinity field when nothing else remains in it.
+func setNodeAffinity(obj *unstructured.Unstructured, affinityPath []string, nodeAffinity *corev1.NodeAffinity) error {
+	nodeAffinityPath := append(slices.Clone(affinityPath), "nodeAffinity")
+
+	if nodeAffinity == nil ||
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil && len(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution) == 0 {
+		unstructured.RemoveNestedField(obj.Object, nodeAffinityPath...)
+
+		if affinity, found, _ := unstructured.NestedMap(obj.Object, affinityPath...); found && len(affinity) == 0 {
+			unstructured.RemoveNestedField(obj.Object, affinityPath...)
+		}
+
+		return nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(nodeAffinity)
+	if err != nil {
+		return fmt.Errorf("failed to convert node affinity to unstructured: %w", err)
+	}
+
+	return unstructured.SetNestedMap(obj.Object, content, nodeAffinityPath...)
+}
+
+// mergeTopologySpreadConstraints merges HardwareProfile topology spread constraints with the existing
+// ones on the workload. As Kubernetes only allows one constraint per topologyKey and whenUnsatisfiable,
+// HardwareProfile constraints replace existing ones with the same pair, and a warning is returned
+// when the replaced constraint differs.
+//
+// Parameters:
+//   - obj: The unstructured workload object containing existing topology spread constraints
+//   - constraintsPath: The path to the topologySpreadConstraints field in the workload
+//   - hwpConstraints: The topology spread constraints from the HardwareProfile to apply
+//   - hwpName: The name of the HardwareProfile (for warning messages)
+//
+// Returns:
+//   - []string: Warnings about topology spread constraints that will be overwritten
+//   - error: Any error encountered during the merge operation
+func mergeTopologySpreadConstraints(
+	obj *unstructured.Unstructured,
+	constraintsPath []string,
+	hwpConstraints []corev1.TopologySpreadConstraint,
+	hwpName string,
+) ([]string, error) {
+	existingConstraints, _, err := unstructured.NestedSlice(obj.Object, constraintsPath...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing topologySpreadConstraints: %w", err)
+	}
+
+	var warnings []string
+
+	// Start with HWP constraints (they take precedence)
+	merged := make([]any, 0, len(hwpConstraints)+len(existingConstraints))
+	for _, constraint := range hwpConstraints {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&constraint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert topologySpreadConstraints to unstructured: %w", err)
+		}
+		merged = append(merged, content)
+	}
+
+	// Add existing constraints that don't conflict with HWP ones
+	for _, existing := range existingConstraints {
+		existingMap, ok := existing.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		constraint := corev1.TopologySpreadConstraint{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(existingMap, &constraint); err != nil {
+			return nil, fmt.Errorf("failed to convert existing topologySpreadConstraints: %w", err)
+		}
+
+		i := slices.IndexFunc(hwpConstraints, func(c corev1.TopologySpreadConstraint) bool {
+			return c.TopologyKey == constraint.TopologyKey && c.WhenUnsatisfiable == constraint.WhenUnsatisfiable
+		})
+		if i < 0 {
+			merged = append(merged, existing)
+			continue
+		}
+
+		if !equality.Semantic.DeepEqual(hwpConstraints[i], constraint) {
+			warnings = append(warnings, fmt.Sprintf(
+				"topologySpreadConstraint for topologyKey '%s' and whenUnsatisfiable '%s' will be overwritten by HardwareProfile '%s'",
+				constraint.TopologyKey, constraint.WhenUnsatisfiable, hwpName))
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(obj.Object, merged, constraintsPath...); err != nil {
+		return nil, fmt.Errorf("failed to set merged topologySpreadConstraints: %w", err)
+	}
+
+	return warnings, nil
+}
+
+// removeHWPTopologySpreadConstraints removes topology spread constraints from the workload that match
+// the HWP's ones, preserving the ones added or modified manually.
+func removeHWPTopologySpreadConstraints(obj *unstructured.Unstructured, constraintsPath []string, hwpConstraints []corev1.TopologySpreadConstraint) error {
+	existingConstraints, found, err := unstructured.NestedSlice(obj.Object, constraintsPath...)
+	if err != nil {
+		return err
+	}
+	if !found || len(existingConstraints) == 0 {
+		return nil // No constraints to remove
+	}
+
+	remaining := make([]any, 0, len(existingConstraints))
+	for _, existing := range existingConstraints {
+		existingMap, ok := existing.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		constraint := corev1.TopologySpreadConstraint{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(existingMap, &constraint); err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(hwpConstraints, func(c corev1.TopologySpreadConstraint) bool {
+			return equality.Semantic.DeepEqual(c, constraint)
+		}) {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	// Update or remove topologySpreadConstraints field
+	if len(remaining) == 0 {
+		unstructured.RemoveNestedField(obj.Object, constraintsPath...)
+		return nil
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, remaining, constraintsPath...)
+}
@@ -0,0 +1,146 @@
+//go:build !nowebhook
+
+//nolint:testpackage // white-box tests for unexported scheduling merge helpers
+package hardwareprofile
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	. "github.com/onsi/gomega"
+)
+
+var affinityPath = []string{"spec", "template", "affinity"}
+
+func requirement(key string, values ...string) corev1.NodeSelectorRequirement {
+	return corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: values}
+}
+
+func term(reqs ...corev1.NodeSelectorRequirement) corev1.NodeSelectorTerm {
+	return corev1.NodeSelectorTerm{MatchExpressions: reqs}
+}
+
+func required(terms ...corev1.NodeSelectorTerm) *corev1.NodeSelector {
+	return &corev1.NodeSelector{NodeSelectorTerms: terms}
+}
+
+func workloadWithNodeAffinity(t *testing.T, nodeAffinity *corev1.NodeAffinity) *unstructured.Unstructured {
+	t.Helper()
+
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	if nodeAffinity != nil {
+		NewWithT(t).Expect(setNodeAffinity(obj, affinityPath, nodeAffinity)).To(Succeed())
+	}
+
+	return obj
+}
+
+func TestMergeNodeAffinity(t *testing.T) {
+	hwpAffinity := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: required(
+			term(requirement("accelerator", "a100")),
+			term(requirement("accelerator", "h100")),
+		),
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+			{Weight: 10, Preference: term(requirement("zone", "a"))},
+		},
+	}
+	userPreferred := corev1.PreferredSchedulingTerm{Weight: 1, Preference: term(requirement("disk", "ssd"))}
+
+	t.Run("sets the HardwareProfile affinity on a workload without one", func(t *testing.T) {
+		g := NewWithT(t)
+		obj := workloadWithNodeAffinity(t, nil)
+
+		g.Expect(mergeNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+
+		merged, err := getNodeAffinity(obj, affinityPath)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(merged).To(Equal(hwpAffinity))
+	})
+
+	t.Run("ANDs required terms and is idempotent", func(t *testing.T) {
+		g := NewWithT(t)
+		obj := workloadWithNodeAffinity(t, &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required(term(requirement("arch", "amd64"))),
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{userPreferred},
+		})
+
+		for range 2 {
+			g.Expect(mergeNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+		}
+
+		merged, err := getNodeAffinity(obj, affinityPath)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]corev1.NodeSelectorTerm{
+			term(requirement("arch", "amd64"), requirement("accelerator", "a100")),
+			term(requirement("arch", "amd64"), requirement("accelerator", "h100")),
+		}))
+		g.Expect(merged.PreferredDuringSchedulingIgnoredDuringExecution).To(Equal([]corev1.PreferredSchedulingTerm{
+			hwpAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0],
+			userPreferred,
+		}))
+	})
+
+	t.Run("removal restores the workload affinity", func(t *testing.T) {
+		g := NewWithT(t)
+		userAffinity := &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required(term(requirement("arch", "amd64"))),
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{userPreferred},
+		}
+		obj := workloadWithNodeAffinity(t, userAffinity)
+
+		g.Expect(mergeNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+		g.Expect(removeHWPNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+
+		restored, err := getNodeAffinity(obj, affinityPath)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(restored).To(Equal(userAffinity))
+	})
+
+	t.Run("removal drops the affinity field when nothing remains", func(t *testing.T) {
+		g := NewWithT(t)
+		obj := workloadWithNodeAffinity(t, nil)
+
+		g.Expect(mergeNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+		g.Expect(removeHWPNodeAffinity(obj, affinityPath, hwpAffinity)).To(Succeed())
+
+		_, found, err := unstructured.NestedFieldNoCopy(obj.Object, affinityPath...)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(found).To(BeFalse())
+	})
+}
+
+func TestMergeTopologySpreadConstraints(t *testing.T) {
+	path := []string{"spec", "template", "topologySpreadConstraints"}
+	hwpConstraint := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+	}
+
+	g := NewWithT(t)
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	g.Expect(unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{"maxSkew": int64(3), "topologyKey": "topology.kubernetes.io/zone", "whenUnsatisfiable": "DoNotSchedule"},
+		map[string]any{"maxSkew": int64(1), "topologyKey": "kubernetes.io/hostname", "whenUnsatisfiable": "ScheduleAnyway"},
+	}, path...)).To(Succeed())
+
+	warnings, err := mergeTopologySpreadConstraints(obj, path, []corev1.TopologySpreadConstraint{hwpConstraint}, "gpu")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(HaveLen(1))
+
+	constraints, _, err := unstructured.NestedSlice(obj.Object, path...)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(constraints).To(HaveLen(2))
+	g.Expect(constraints[0]).To(HaveKeyWithValue("maxSkew", int64(1)))
+	g.Expect(constraints[1]).To(HaveKeyWithValue("topologyKey", "kubernetes.io/hostname"))
+
+	g.Expect(removeHWPTopologySpreadConstraints(obj, path, []corev1.TopologySpreadConstraint{hwpConstraint})).To(Succeed())
+
+	constraints, _, err = unstructured.NestedSlice(obj.Object, path...)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(constraints).To(HaveLen(1))
+	g.Expect(constraints[0]).To(HaveKeyWithValue("topologyKey", "kubernetes.io/hostname"))
+}
@@ -0,0 +1,168 @@
+package hardwareprofile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// Default pod spec relative paths of HardwareProfileTarget pod templates.
+const (
+	defaultContainersPath   = "containers"
+	defaultNodeSelectorPath = "nodeSelector"
+	defaultTolerationsPath  = "tolerations"
+)
+
+// wildcardSegment matches every element of a list or every value of a map in a pod spec path.
+const wildcardSegment = "*"
+
+// builtinKinds contains the resource types the hardware profile webhook handles
+// without a HardwareProfileTarget, configured by WorkloadConfigs.
+var builtinKinds = []schema.GroupVersionKind{
+	gvk.InferenceServices,           // serving.kserve.io/v1beta1/InferenceService
+	gvk.LLMInferenceServiceV1Alpha1, // serving.kserve.io/v1alpha1/LLMInferenceService
+	gvk.LLMInferenceServiceV1Alpha2, // serving.kserve.io/v1alpha2/LLMInferenceService
+}
+
+// reappliedKinds contains the resource types the hardware profile is reapplied to when
+// HardwareProfile changes are propagated, configured by WorkloadConfigs. Notebooks are
+// admitted by workbenches-operator, but their profile settings are propagated the same way.
+var reappliedKinds = append(slices.Clone(builtinKinds), gvk.Notebook)
+
+// workloadTemplate is a part of a workload the hardware profile is applied to, with the
+// paths of its fields relative to obj. For built-in kinds obj is the workload itself; for
+// HardwareProfileTargets it wraps a pod spec nested in the workload, so that changes made
+// to it are made to the workload.
+type workloadTemplate struct {
+	obj    *unstructured.Unstructured
+	config WorkloadConfig
+}
+
+// fetchTarget returns the HardwareProfileTarget declaring the given kind, or nil if there is none.
+// When several targets declare the same kind, the first one by name is used.
+func (i *Injector) fetchTarget(ctx context.Context, kind schema.GroupVersionKind) (*infrav1.HardwareProfileTarget, error) {
+	if i.Targets == nil {
+		return nil, nil
+	}
+
+	targets := &infrav1.HardwareProfileTargetList{}
+	if err := i.Targets.List(ctx, targets); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list HardwareProfileTargets: %w", err)
+	}
+
+	slices.SortFunc(targets.Items, func(a, b infrav1.HardwareProfileTarget) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	for idx := range targets.Items {
+		if targets.Items[idx].Spec.GroupVersionKind() == kind {
+			return &targets.Items[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// workloadTemplates returns the parts of obj the hardware profile is applied to.
+func (i *Injector) workloadTemplates(ctx context.Context, obj *unstructured.Unstructured) ([]workloadTemplate, error) {
+	if slices.Contains(reappliedKinds, obj.GroupVersionKind()) {
+		config, err := GetWorkloadConfig(obj.GetKind())
+		if err != nil {
+			return nil, err
+		}
+
+		return []workloadTemplate{{obj: obj, config: config}}, nil
+	}
+
+	target, err := i.fetchTarget(ctx, obj.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("unsupported workload kind: %s", obj.GetKind())
+	}
+
+	return targetTemplates(obj, target), nil
+}
+
+// targetTemplates returns a workloadTemplate for each pod spec of obj matched by the pod templates of target.
+func targetTemplates(obj *unstructured.Unstructured, target *infrav1.HardwareProfileTarget) []workloadTemplate {
+	var templates []workloadTemplate
+
+	for _, podTemplate := range target.Spec.PodTemplates {
+		config := WorkloadConfig{
+			ContainersPath:                splitPath(cmp.Or(podTemplate.ContainersPath, defaultContainersPath)),
+			NodeSelectorPath:              splitPath(cmp.Or(podTemplate.NodeSelectorPath, defaultNodeSelectorPath)),
+			TolerationsPath:               splitPath(cmp.Or(podTemplate.TolerationsPath, defaultTolerationsPath)),
+			AffinityPath:                  []string{"affinity"},
+			TopologySpreadConstraintsPath: []string{"topologySpreadConstraints"},
+			PriorityClassNamePath:         []string{"priorityClassName"},
+		}
+
+		for _, podSpec := range expandPath(obj.Object, splitPath(podTemplate.PodSpecPath)) {
+			templates = append(templates, workloadTemplate{
+				obj:    &unstructured.Unstructured{Object: podSpec},
+				config: config,
+			})
+		}
+	}
+
+	return templates
+}
+
+// expandPath returns the maps found at path within value, where a wildcard segment
+// matches every element of a list or every value of a map. Map values are visited
+// in key order. The returned maps are not copies.
+func expandPath(value any, path []string) []map[string]any {
+	if len(path) == 0 {
+		if m, ok := value.(map[string]any); ok {
+			return []map[string]any{m}
+		}
+
+		return nil
+	}
+
+	segment, rest := path[0], path[1:]
+
+	var result []map[string]any
+	switch v := value.(type) {
+	case map[string]any:
+		if segment != wildcardSegment {
+			return expandPath(v[segment], rest)
+		}
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			result = append(result, expandPath(v[key], rest)...)
+		}
+	case []any:
+		if segment != wildcardSegment {
+			return nil
+		}
+		for _, element := range v {
+			result = append(result, expandPath(element, rest)...)
+		}
+	}
+
+	return result
+}
+
+func splitPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, ".")
+}
@@ -0,0 +1,145 @@
+//go:build !nowebhook
+
+//nolint:testpackage // white-box tests for unexported pod template helpers
+package hardwareprofile
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+
+	. "github.com/onsi/gomega"
+)
+
+func podSpec(containers ...string) map[string]any {
+	list := make([]any, 0, len(containers))
+	for _, name := range containers {
+		list = append(list, map[string]any{"name": name})
+	}
+
+	return map[string]any{"template": map[string]any{"spec": map[string]any{"containers": list}}}
+}
+
+func rayCluster() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"headGroupSpec": podSpec("ray-head"),
+			"workerGroupSpecs": []any{
+				podSpec("ray-worker"),
+				podSpec("ray-worker", "sidecar"),
+			},
+		},
+	}}
+	obj.SetAPIVersion("ray.io/v1")
+	obj.SetKind("RayCluster")
+	obj.SetName("ray")
+	obj.SetNamespace("user")
+
+	return obj
+}
+
+func rayClusterTarget() *infrav1.HardwareProfileTarget {
+	return &infrav1.HardwareProfileTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayclusters"},
+		Spec: infrav1.HardwareProfileTargetSpec{
+			Group:   "ray.io",
+			Version: "v1",
+			Kind:    "RayCluster",
+			PodTemplates: []infrav1.HardwareProfilePodTemplate{
+				{Name: "head", PodSpecPath: "spec.headGroupSpec.template.spec"},
+				{Name: "workers", PodSpecPath: "spec.workerGroupSpecs.*.template.spec"},
+			},
+		},
+	}
+}
+
+func TestExpandPath(t *testing.T) {
+	g := NewWithT(t)
+	obj := rayCluster()
+
+	g.Expect(expandPath(obj.Object, splitPath("spec.headGroupSpec.template.spec"))).To(HaveLen(1))
+	g.Expect(expandPath(obj.Object, splitPath("spec.workerGroupSpecs.*.template.spec"))).To(HaveLen(2))
+	g.Expect(expandPath(obj.Object, splitPath("spec.*.template.spec"))).To(HaveLen(1))
+	g.Expect(expandPath(obj.Object, splitPath("spec.workerGroupSpecs.template.spec"))).To(BeEmpty())
+	g.Expect(expandPath(obj.Object, splitPath("spec.missing.*"))).To(BeEmpty())
+}
+
+func TestApplyHardwareProfileToTarget(t *testing.T) {
+	g := NewWithT(t)
+
+	sch := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+
+	injector := &Injector{
+		Targets: fake.NewClientBuilder().WithScheme(sch).WithObjects(rayClusterTarget()).Build(),
+	}
+
+	toleration := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "user"},
+		Spec: infrav1.HardwareProfileSpec{
+			Identifiers: []infrav1.HardwareIdentifier{
+				{Identifier: "nvidia.com/gpu", DefaultCount: intstr.FromInt32(1), ResourceType: "Accelerator"},
+			},
+			SchedulingSpec: &infrav1.SchedulingSpec{
+				SchedulingType: infrav1.NodeScheduling,
+				Node: &infrav1.NodeSchedulingSpec{
+					NodeSelector: map[string]string{"accelerator": "a100"},
+					Tolerations:  []corev1.Toleration{toleration},
+				},
+			},
+		},
+	}
+
+	obj := rayCluster()
+	_, err := injector.applyHardwareProfileToWorkload(t.Context(), obj, hwp, false)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	podSpecs := append(
+		expandPath(obj.Object, splitPath("spec.headGroupSpec.template.spec")),
+		expandPath(obj.Object, splitPath("spec.workerGroupSpecs.*.template.spec"))...,
+	)
+	g.Expect(podSpecs).To(HaveLen(3))
+
+	for _, spec := range podSpecs {
+		g.Expect(spec).To(HaveKeyWithValue("nodeSelector", map[string]any{"accelerator": "a100"}))
+		g.Expect(spec).To(HaveKey("tolerations"))
+
+		containers, _, err := unstructured.NestedSlice(spec, "containers")
+		g.Expect(err).NotTo(HaveOccurred())
+		for _, container := range containers {
+			containerMap, ok := container.(map[string]any)
+			g.Expect(ok).To(BeTrue())
+
+			limits, _, err := unstructured.NestedStringMap(containerMap, "resources", "limits")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(limits).To(HaveKeyWithValue("nvidia.com/gpu", "1"))
+		}
+	}
+
+	g.Expect(injector.removeHWPSettings(t.Context(), obj, hwp)).To(Succeed())
+
+	for _, spec := range podSpecs {
+		g.Expect(spec).NotTo(HaveKey("nodeSelector"))
+		g.Expect(spec).NotTo(HaveKey("tolerations"))
+	}
+}
+
+func TestWorkloadTemplatesWithoutTarget(t *testing.T) {
+	g := NewWithT(t)
+
+	sch := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+
+	injector := &Injector{Targets: fake.NewClientBuilder().WithScheme(sch).Build()}
+
+	_, err := injector.workloadTemplates(t.Context(), rayCluster())
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported workload kind: RayCluster")))
+}
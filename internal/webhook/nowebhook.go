@@ -2,9 +2,13 @@
 
 package webhook
 
-import ctrl "sigs.k8s.io/controller-runtime"
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/operatorconfig"
+)
 
 // RegisterWebhooks is a no-op stub for builds without webhooks.
-func RegisterAllWebhooks(mgr ctrl.Manager) error {
+func RegisterAllWebhooks(mgr ctrl.Manager, settings operatorconfig.OperatorSettings) error {
 	return nil
 }
@@ -75,6 +75,10 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 				webhookutils.ConnectionTypeProtocolURI.String(),
 				webhookutils.ConnectionTypeProtocolS3.String(),
 				webhookutils.ConnectionTypeProtocolOCI.String(),
+				webhookutils.ConnectionTypeProtocolGCS.String(),
+				webhookutils.ConnectionTypeProtocolAzure.String(),
+				webhookutils.ConnectionTypeProtocolHF.String(),
+				webhookutils.ConnectionTypeProtocolPVC.String(),
 			},
 			annotations.ConnectionTypeRef: {
 				webhookutils.ConnectionTypeRefURI.String(),
@@ -105,7 +109,12 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 
 		switch action {
 		case webhookutils.ConnectionActionInject:
-			// Create ServiceAccount only for S3 connections in non-dry-run mode
+			// Reject invalid connection secrets before any injection
+			warnings, resp := w.Webhook.ConnectionPreflight(ctx, newConn, req.Namespace)
+			if resp != nil {
+				return *resp
+			}
+			// Create ServiceAccount only for connections with credentials in non-dry-run mode
 			isDryRun := req.DryRun != nil && *req.DryRun
 			if err := webhookutils.ServiceAccountCreation(ctx, w.Webhook.Client, newConn.SecretName, newConn.Type, req.Namespace, isDryRun); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -119,10 +128,10 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 
 			// Write updated object back to k8s if injection was performed
 			if injectionPerformed {
-				return w.Webhook.CreatePatchResponse(req, obj)
+				return w.Webhook.CreatePatchResponse(req, obj).WithWarnings(warnings...)
 			}
 
-			return admission.Allowed(fmt.Sprintf("No connection injection performed for %s in namespace %s", req.Kind.Kind, req.Namespace))
+			return admission.Allowed(fmt.Sprintf("No connection injection performed for %s in namespace %s", req.Kind.Kind, req.Namespace)).WithWarnings(warnings...)
 
 		case webhookutils.ConnectionActionRemove:
 			// Perform cleanup when annotation is removed, we do not delete SA but only remove injection part
@@ -145,6 +154,12 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 				"oldSecret", oldConn.SecretName, "newSecret", newConn.SecretName,
 				"oldPath", oldConn.Path, "newPath", newConn.Path)
 
+			// Reject invalid connection secrets before any cleanup or injection
+			warnings, resp := w.Webhook.ConnectionPreflight(ctx, newConn, req.Namespace)
+			if resp != nil {
+				return *resp
+			}
+
 			var cleanupPerformed bool
 			cleanupPerformed, err = w.performConnectionCleanup(ctx, req, obj, oldConn)
 			if err != nil {
@@ -156,7 +171,7 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 			if cleanupPerformed {
 				log.V(1).Info("Successfully cleaned up old connection type")
 			}
-			// Create ServiceAccount only for S3 connections in non-dry-run mode
+			// Create ServiceAccount only for connections with credentials in non-dry-run mode
 			isDryRun := req.DryRun != nil && *req.DryRun
 			if err := webhookutils.ServiceAccountCreation(ctx, w.Webhook.Client, newConn.SecretName, newConn.Type, req.Namespace, isDryRun); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -171,10 +186,10 @@ func (w *ISVCConnectionWebhook) Handle(ctx context.Context, req admission.Reques
 
 			// Write updated object back to k8s if any changes were made (cleanup or injection)
 			if cleanupPerformed || injectionPerformed {
-				return w.Webhook.CreatePatchResponse(req, obj)
+				return w.Webhook.CreatePatchResponse(req, obj).WithWarnings(warnings...)
 			}
 
-			return admission.Allowed(fmt.Sprintf("No connection changes performed for %s in namespace %s", req.Kind.Kind, req.Namespace))
+			return admission.Allowed(fmt.Sprintf("No connection changes performed for %s in namespace %s", req.Kind.Kind, req.Namespace)).WithWarnings(warnings...)
 
 		case webhookutils.ConnectionActionNone:
 			// No change needed
@@ -238,6 +253,26 @@ func (w *ISVCConnectionWebhook) performConnectionInjection(
 		log.V(1).Info("Successfully injected S3 .spec.predictor.model.storage", "secretName", connInfo.SecretName)
 		return true, nil
 
+	case webhookutils.ConnectionTypeProtocolGCS.String(), webhookutils.ConnectionTypeProtocolAzure.String(),
+		webhookutils.ConnectionTypeProtocolHF.String(), webhookutils.ConnectionTypeProtocolPVC.String():
+		// inject ServiceAccount for connections with credentials, pvc is mounted without any
+		if webhookutils.UsesServiceAccount(connInfo.Type) {
+			if err := w.Webhook.InjectServiceAccountName(decodedObj, IsvcConfigs.ServiceAccountNamePath, connInfo.SecretName+"-sa"); err != nil {
+				return false, fmt.Errorf("failed to inject .spec.predictor.serviceAccountName: %w", err)
+			}
+			log.V(1).Info("Successfully injected .spec.predictor.serviceAccountName", "ServiceAccountName", connInfo.SecretName+"-sa")
+		}
+
+		storageURI, err := w.Webhook.BuildStorageURI(ctx, connInfo, req.Namespace)
+		if err != nil {
+			return false, fmt.Errorf("failed to inject %s .spec.predictor.model.storageUri: %w", connInfo.Type, err)
+		}
+		if err := webhookutils.SetNestedValue(decodedObj.Object, storageURI, IsvcConfigs.StorageUriPath); err != nil {
+			return false, fmt.Errorf("failed to set .spec.predictor.model.storageUri: %w", err)
+		}
+		log.V(1).Info("Successfully injected .spec.predictor.model.storageUri", "connectionType", connInfo.Type, "secretName", connInfo.SecretName)
+		return true, nil
+
 	default: // this should not enter since ValidateConnectionAnnotation ensures valid types, but keep it for safety
 		log.V(1).Info("Unknown connection type, skipping injection", "connectionType", connInfo.Type)
 		return false, nil
@@ -312,6 +347,18 @@ func (w *ISVCConnectionWebhook) performConnectionCleanup(
 		}
 		log.V(1).Info("Successfully cleaned up S3 .spec.predictor.model.storage", "name", req.Name, "namespace", req.Namespace)
 
+	case webhookutils.ConnectionTypeProtocolGCS.String(), webhookutils.ConnectionTypeProtocolAzure.String(),
+		webhookutils.ConnectionTypeProtocolHF.String(), webhookutils.ConnectionTypeProtocolPVC.String():
+		if webhookutils.UsesServiceAccount(connInfo.Type) {
+			if err := w.Webhook.RemoveServiceAccountName(decodedObj, IsvcConfigs.ServiceAccountNamePath, connInfo.SecretName+"-sa"); err != nil {
+				return false, fmt.Errorf("failed to cleanup .spec.predictor.serviceAccountName: %w", err)
+			}
+		}
+		if err := w.cleanupURIStorageUri(decodedObj); err != nil {
+			return false, fmt.Errorf("failed to cleanup .spec.predictor.model.storageUri: %w", err)
+		}
+		log.V(1).Info("Successfully cleaned up .spec.predictor.model.storageUri", "connectionType", connInfo.Type, "name", req.Name, "namespace", req.Namespace)
+
 	default:
 		// No specific cleanup needed for unknown connection types
 		log.V(1).Info("No specific cleanup needed for connection type", "connectionType", connInfo.Type)
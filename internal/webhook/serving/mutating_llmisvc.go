@@ -73,6 +73,10 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 				webhookutils.ConnectionTypeProtocolURI.String(), // this is going to work for both uri:// and hf://
 				webhookutils.ConnectionTypeProtocolS3.String(),
 				webhookutils.ConnectionTypeProtocolOCI.String(),
+				webhookutils.ConnectionTypeProtocolGCS.String(),
+				webhookutils.ConnectionTypeProtocolAzure.String(),
+				webhookutils.ConnectionTypeProtocolHF.String(),
+				webhookutils.ConnectionTypeProtocolPVC.String(),
 			},
 			annotations.ConnectionTypeRef: {
 				webhookutils.ConnectionTypeRefURI.String(), // this is going to work for both uri:// and hf://
@@ -102,7 +106,12 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 
 		switch action {
 		case webhookutils.ConnectionActionInject:
-			// Create ServiceAccount only for S3 connections in non-dry-run mode
+			// Reject invalid connection secrets before any injection
+			warnings, resp := w.Webhook.ConnectionPreflight(ctx, newConn, req.Namespace)
+			if resp != nil {
+				return *resp
+			}
+			// Create ServiceAccount only for connections with credentials in non-dry-run mode
 			isDryRun := req.DryRun != nil && *req.DryRun
 			if err := webhookutils.ServiceAccountCreation(ctx, w.Webhook.Client, newConn.SecretName, newConn.Type, req.Namespace, isDryRun); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -116,10 +125,10 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 
 			// Write updated object back to k8s if injection was performed
 			if injectionPerformed {
-				return w.Webhook.CreatePatchResponse(req, obj)
+				return w.Webhook.CreatePatchResponse(req, obj).WithWarnings(warnings...)
 			}
 
-			return admission.Allowed(fmt.Sprintf("No connection injection performed for %s in namespace %s", req.Kind.Kind, req.Namespace))
+			return admission.Allowed(fmt.Sprintf("No connection injection performed for %s in namespace %s", req.Kind.Kind, req.Namespace)).WithWarnings(warnings...)
 
 		case webhookutils.ConnectionActionRemove:
 			// Perform cleanup when annotation is removed, we do not delete SA but only remove injection part
@@ -142,6 +151,12 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 				"oldSecret", oldConn.SecretName, "newSecret", newConn.SecretName,
 				"oldPath", oldConn.Path, "newPath", newConn.Path)
 
+			// Reject invalid connection secrets before any cleanup or injection
+			warnings, resp := w.Webhook.ConnectionPreflight(ctx, newConn, req.Namespace)
+			if resp != nil {
+				return *resp
+			}
+
 			cleanupPerformed, err := w.performConnectionCleanup(ctx, req, obj, oldConn)
 			if err != nil {
 				log.Error(err, "Failed to cleanup old connection type")
@@ -152,7 +167,7 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 			if cleanupPerformed {
 				log.V(1).Info("Successfully cleaned up old connection type")
 			}
-			// Create ServiceAccount only for S3 connections in non-dry-run mode
+			// Create ServiceAccount only for connections with credentials in non-dry-run mode
 			isDryRun := req.DryRun != nil && *req.DryRun
 			if err := webhookutils.ServiceAccountCreation(ctx, w.Webhook.Client, newConn.SecretName, newConn.Type, req.Namespace, isDryRun); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -167,10 +182,10 @@ func (w *LLMISVCConnectionWebhook) Handle(ctx context.Context, req admission.Req
 
 			// Write updated object back to k8s if any changes were made (cleanup or injection)
 			if cleanupPerformed || injectionPerformed {
-				return w.Webhook.CreatePatchResponse(req, obj)
+				return w.Webhook.CreatePatchResponse(req, obj).WithWarnings(warnings...)
 			}
 
-			return admission.Allowed(fmt.Sprintf("No connection changes performed for %s in namespace %s", req.Kind.Kind, req.Namespace))
+			return admission.Allowed(fmt.Sprintf("No connection changes performed for %s in namespace %s", req.Kind.Kind, req.Namespace)).WithWarnings(warnings...)
 
 		case webhookutils.ConnectionActionNone:
 			// No action needed
@@ -232,6 +247,22 @@ func (w *LLMISVCConnectionWebhook) performConnectionInjection(
 			return false, fmt.Errorf("failed to build S3 URI: %w", err)
 		}
 
+	case webhookutils.ConnectionTypeProtocolGCS.String(), webhookutils.ConnectionTypeProtocolAzure.String(),
+		webhookutils.ConnectionTypeProtocolHF.String(), webhookutils.ConnectionTypeProtocolPVC.String():
+		// inject ServiceAccount for connections with credentials, pvc is mounted without any
+		if webhookutils.UsesServiceAccount(connInfo.Type) {
+			if err := w.Webhook.InjectServiceAccountName(decodedObj, LlmisvcConfigs.ServiceAccountNamePath, connInfo.SecretName+"-sa"); err != nil {
+				return false, fmt.Errorf("failed to inject .spec.template.serviceAccountName: %w", err)
+			}
+			log.V(1).Info("Successfully injected .spec.template.serviceAccountName", "ServiceAccountName", connInfo.SecretName+"-sa")
+		}
+
+		var err error
+		uriValue, err = w.Webhook.BuildStorageURI(ctx, connInfo, req.Namespace)
+		if err != nil {
+			return false, fmt.Errorf("failed to build %s URI: %w", connInfo.Type, err)
+		}
+
 	default: // this should not enter since ValidateConnectionAnnotation ensures valid types, but keep it for safety
 		log.V(1).Info("Unknown connection type, skipping injection", "connectionType", connInfo.Type)
 		return false, nil
@@ -277,7 +308,8 @@ func (w *LLMISVCConnectionWebhook) performConnectionCleanup(
 		}
 		cleanupPerformed = true
 
-	case webhookutils.ConnectionTypeProtocolS3.String(), webhookutils.ConnectionTypeRefS3.String(): // TODO: we will need handle hf:// from ConnectionTypeURI
+	case webhookutils.ConnectionTypeProtocolS3.String(), webhookutils.ConnectionTypeRefS3.String(),
+		webhookutils.ConnectionTypeProtocolGCS.String(), webhookutils.ConnectionTypeProtocolAzure.String(), webhookutils.ConnectionTypeProtocolHF.String():
 		// remove ServiceAccountName injection, if we need it in replacement, we will add it back later.
 		if err := w.Webhook.RemoveServiceAccountName(decodedObj, LlmisvcConfigs.ServiceAccountNamePath, connInfo.SecretName+"-sa"); err != nil {
 			return false, fmt.Errorf("failed to cleanup .spec.template.serviceAccountName: %w", err)
@@ -296,7 +328,7 @@ func (w *LLMISVCConnectionWebhook) performConnectionCleanup(
 		}
 	}
 
-	// for uri, s3, and oci: clean up model URI
+	// for all connection types: clean up model URI
 	if hasLLMISVCUri(decodedObj) {
 		if _, err := w.cleanupModelUri(decodedObj); err != nil {
 			return false, fmt.Errorf("failed to cleanup URI .spec.model.uri: %w", err)
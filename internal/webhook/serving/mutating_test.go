@@ -68,6 +68,7 @@ type TestCase struct {
 	operation          admissionv1.Operation
 	expectedAllowed    bool
 	expectedMessage    string
+	expectedWarning    string
 	expectedPatchCheck func([]jsonpatch.JsonPatchOperation) bool
 }
 
@@ -235,6 +236,10 @@ func runISVCTestCase(t *testing.T, tc TestCase) { //nolint:dupl
 		g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectedMessage))
 	}
 
+	if tc.expectedWarning != "" {
+		g.Expect(resp.Warnings).To(ContainElement(ContainSubstring(tc.expectedWarning)))
+	}
+
 	if tc.expectedPatchCheck != nil {
 		g.Expect(tc.expectedPatchCheck(resp.Patches)).To(BeTrue())
 	}
@@ -332,6 +337,10 @@ func runLLMISVCTestCase(t *testing.T, tc TestCase) { //nolint:dupl
 		g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectedMessage))
 	}
 
+	if tc.expectedWarning != "" {
+		g.Expect(resp.Warnings).To(ContainElement(ContainSubstring(tc.expectedWarning)))
+	}
+
 	if tc.expectedPatchCheck != nil {
 		g.Expect(tc.expectedPatchCheck(resp.Patches)).To(BeTrue())
 	}
@@ -369,6 +378,49 @@ func TestServiceAccountNamePatching(t *testing.T) {
 		}
 		runISVCTestCase(t, tc)
 	})
+	t.Run("serviceAccountName is not injected on create with PVC", func(t *testing.T) {
+		tc := TestCase{
+			name:            "serviceAccountName not injected on create with PVC",
+			secretType:      webhookutils.ConnectionTypeProtocolPVC.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"PVC_NAME": []byte("model-pvc")},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+			expectedPatchCheck: func(patches []jsonpatch.JsonPatchOperation) bool {
+				// PVC connections are mounted and should not have ServiceAccount injection
+				return !hasServiceAccountNamePatch()(patches)
+			},
+		}
+		runISVCTestCase(t, tc)
+	})
+	for _, connectionType := range []webhookutils.ConnectionType{
+		webhookutils.ConnectionTypeProtocolGCS,
+		webhookutils.ConnectionTypeProtocolAzure,
+		webhookutils.ConnectionTypeProtocolHF,
+	} {
+		t.Run("serviceAccountName is injected on create with "+connectionType.String(), func(t *testing.T) {
+			tc := TestCase{
+				name:            "serviceAccountName injected on create with " + connectionType.String(),
+				secretType:      connectionType.String(),
+				secretNamespace: testNamespace,
+				secretData: map[string][]byte{
+					"GCS_BUCKET":              []byte("my-bucket"),
+					"AZURE_STORAGE_ACCOUNT":   []byte("myaccount"),
+					"AZURE_STORAGE_CONTAINER": []byte("models"),
+				},
+				annotations:     map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+				predictorSpec:   map[string]any{"model": map[string]any{}},
+				operation:       admissionv1.Create,
+				expectedAllowed: true,
+				expectedPatchCheck: func(patches []jsonpatch.JsonPatchOperation) bool {
+					return hasServiceAccountNamePatch()(patches)
+				},
+			}
+			runISVCTestCase(t, tc)
+		})
+	}
 	t.Run("serviceAccountName is injected on create with S3", func(t *testing.T) {
 		tc := TestCase{
 			name:            "serviceAccountName injected on create with S3",
@@ -576,6 +628,40 @@ func TestISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed:    true,
 			expectedPatchCheck: hasStorageKeyPatch(testSecret),
 		},
+		{
+			name:            "annotation as S3 type with only the access key ID, ISVC should not be allowed to create",
+			secretType:      webhookutils.ConnectionTypeProtocolS3.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("access-key")},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data keys 'AWS_ACCESS_KEY_ID', 'AWS_SECRET_ACCESS_KEY' must be set together, only 'AWS_ACCESS_KEY_ID' is set",
+		},
+		{
+			name:            "annotation as S3 type with only the secret access key, ISVC should not be allowed to create",
+			secretType:      webhookutils.ConnectionTypeProtocolS3.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"AWS_SECRET_ACCESS_KEY": []byte("secret-key")},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "only 'AWS_SECRET_ACCESS_KEY' is set",
+		},
+		{
+			name:               "annotation as S3 type with a misspelled key, ISVC creation allowed with a warning",
+			secretType:         webhookutils.ConnectionTypeProtocolS3.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"AWS_S3_BUKCET": []byte("my-bucket")},
+			annotations:        map[string]string{annotations.Connection: testSecret},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedWarning:    "data key 'AWS_S3_BUKCET' which is not used by this connection type, did you mean 'AWS_S3_BUCKET'?",
+			expectedPatchCheck: hasStorageKeyPatch(testSecret),
+		},
 		{
 			name:               "annotation as S3 type with connection-path, ISVC creation allowed with path injection",
 			secretType:         webhookutils.ConnectionTypeProtocolS3.String(),
@@ -597,6 +683,86 @@ func TestISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed: false,
 			expectedMessage: "failed to inject host to .spec.predictor.model.storageUri",
 		},
+		{
+			name:               "annotation as GCS type with connection-path, ISVC creation allowed with gs storageUri injection",
+			secretType:         webhookutils.ConnectionTypeProtocolGCS.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"GCS_BUCKET": []byte("my-bucket")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("gs://my-bucket/models/llama-7b"),
+		},
+		{
+			name:            "annotation as GCS type without GCS_BUCKET set in secret, ISVC should not be allowed to create",
+			secretType:      webhookutils.ConnectionTypeProtocolGCS.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{},
+			annotations:     map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "secret does not contain 'GCS_BUCKET' data key",
+		},
+		{
+			name:            "annotation as Azure type with connection-path, ISVC creation allowed with blob storageUri injection",
+			secretType:      webhookutils.ConnectionTypeProtocolAzure.String(),
+			secretNamespace: testNamespace,
+			secretData: map[string][]byte{
+				"AZURE_STORAGE_ACCOUNT":   []byte("myaccount"),
+				"AZURE_STORAGE_CONTAINER": []byte("models"),
+			},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("https://myaccount.blob.core.windows.net/models/llama-7b"),
+		},
+		{
+			name:               "annotation as HF type with connection-path, ISVC creation allowed with hf storageUri injection",
+			secretType:         webhookutils.ConnectionTypeProtocolHF.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"HF_TOKEN": []byte("token")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "meta-llama/Llama-3.1-8B"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("hf://meta-llama/Llama-3.1-8B"),
+		},
+		{
+			name:            "annotation as HF type without connection-path, ISVC should not be allowed to create",
+			secretType:      webhookutils.ConnectionTypeProtocolHF.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"HF_TOKEN": []byte("token")},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "connection info does not have path",
+		},
+		{
+			name:               "annotation as PVC type with connection-path, ISVC creation allowed with pvc storageUri injection",
+			secretType:         webhookutils.ConnectionTypeProtocolPVC.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"PVC_NAME": []byte("model-pvc")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("pvc://model-pvc/models/llama-7b"),
+		},
+		{
+			name:               "annotation as PVC type without connection-path, ISVC creation allowed with pvc storageUri of the whole volume",
+			secretType:         webhookutils.ConnectionTypeProtocolPVC.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"PVC_NAME": []byte("model-pvc")},
+			annotations:        map[string]string{annotations.Connection: testSecret},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("pvc://model-pvc"),
+		},
 		// type cases for update
 		{
 			name:               "annotation as S3 type with existing storageUri, ISVC update allowed with replacement",
@@ -717,6 +883,20 @@ func TestISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed:    true,
 			expectedPatchCheck: hasStorageUriPatch("s3://new-bucket/new-model"),
 		},
+		{
+			name:               "annotation as GCS type with connection-path changed, ISVC should update storageUri with new path",
+			secretType:         webhookutils.ConnectionTypeProtocolGCS.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"GCS_BUCKET": []byte("my-bucket")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/new-path"},
+			predictorSpec:      map[string]any{"model": map[string]any{"storageUri": "gs://my-bucket/models/old-path"}},
+			oldAnnotations:     map[string]string{annotations.Connection: testSecretOld, annotations.ConnectionPath: "models/old-path"},
+			oldPredictorSpec:   map[string]any{"model": map[string]any{"storageUri": "gs://my-bucket/models/old-path"}},
+			oldSecretType:      webhookutils.ConnectionTypeProtocolGCS.String(),
+			operation:          admissionv1.Update,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriPatch("gs://my-bucket/models/new-path"),
+		},
 
 		// Cleanup tests when annotation is removed
 		{
@@ -784,6 +964,50 @@ func TestISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed:    true,
 			expectedPatchCheck: hasS3CleanupPatches(),
 		},
+		{
+			name:            "annotation removed for HF type, storageUri and serviceAccountName are cleanup",
+			secretType:      "",
+			secretNamespace: testNamespace,
+			annotations:     map[string]string{}, // no annotation
+			predictorSpec: map[string]any{
+				"serviceAccountName": testSecret + "-sa",
+				"model": map[string]any{
+					"storageUri": "hf://meta-llama/Llama-3.1-8B",
+				},
+			},
+			oldAnnotations: map[string]string{annotations.Connection: testSecret},
+			oldPredictorSpec: map[string]any{
+				"serviceAccountName": testSecret + "-sa",
+				"model": map[string]any{
+					"storageUri": "hf://meta-llama/Llama-3.1-8B",
+				},
+			},
+			oldSecretType:      webhookutils.ConnectionTypeProtocolHF.String(),
+			operation:          admissionv1.Update,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageConnectionCleanupPatches(),
+		},
+		{
+			name:            "annotation removed for PVC type, storageUri is cleanup",
+			secretType:      "",
+			secretNamespace: testNamespace,
+			annotations:     map[string]string{}, // no annotation
+			predictorSpec: map[string]any{
+				"model": map[string]any{
+					"storageUri": "pvc://model-pvc/models/llama-7b",
+				},
+			},
+			oldAnnotations: map[string]string{annotations.Connection: testSecret},
+			oldPredictorSpec: map[string]any{
+				"model": map[string]any{
+					"storageUri": "pvc://model-pvc/models/llama-7b",
+				},
+			},
+			oldSecretType:      webhookutils.ConnectionTypeProtocolPVC.String(),
+			operation:          admissionv1.Update,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasStorageUriCleanupPatch(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -878,6 +1102,64 @@ func TestLLMISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed:    true,
 			expectedPatchCheck: hasUriPath("hf://facebook/model"),
 		},
+		{
+			name:               "annotation as GCS type, LLMISVC creation allowed with gs URI injection",
+			secretType:         webhookutils.ConnectionTypeProtocolGCS.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"GCS_BUCKET": []byte("my-bucket")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasUriPath("gs://my-bucket/models/llama-7b"),
+		},
+		{
+			name:            "annotation as Azure type, LLMISVC creation allowed with blob URI injection",
+			secretType:      webhookutils.ConnectionTypeProtocolAzure.String(),
+			secretNamespace: testNamespace,
+			secretData: map[string][]byte{
+				"AZURE_STORAGE_ACCOUNT":   []byte("myaccount"),
+				"AZURE_STORAGE_CONTAINER": []byte("models"),
+			},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasUriPath("https://myaccount.blob.core.windows.net/models/llama-7b"),
+		},
+		{
+			name:            "annotation as Azure type without AZURE_STORAGE_CONTAINER set in secret, LLMISVC should not be allowed to create",
+			secretType:      webhookutils.ConnectionTypeProtocolAzure.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"AZURE_STORAGE_ACCOUNT": []byte("myaccount")},
+			annotations:     map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "llama-7b"},
+			predictorSpec:   map[string]any{"model": map[string]any{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "secret does not contain 'AZURE_STORAGE_CONTAINER' data key",
+		},
+		{
+			name:               "annotation as HF type without model section, LLMISVC creation allowed with model creation and hf URI injection",
+			secretType:         webhookutils.ConnectionTypeProtocolHF.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"HF_TOKEN": []byte("token")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "meta-llama/Llama-3.1-8B"},
+			predictorSpec:      map[string]any{}, // No model section at all
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasUriPath("hf://meta-llama/Llama-3.1-8B"),
+		},
+		{
+			name:               "annotation as PVC type, LLMISVC creation allowed with pvc URI injection",
+			secretType:         webhookutils.ConnectionTypeProtocolPVC.String(),
+			secretNamespace:    testNamespace,
+			secretData:         map[string][]byte{"PVC_NAME": []byte("model-pvc")},
+			annotations:        map[string]string{annotations.Connection: testSecret, annotations.ConnectionPath: "models/llama-7b"},
+			predictorSpec:      map[string]any{"model": map[string]any{}},
+			operation:          admissionv1.Create,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasUriPath("pvc://model-pvc/models/llama-7b"),
+		},
 		// type cases for update
 		{
 			name:               "annotation as URI type with new host value, LLMISVC should overwrite with new value in the patch",
@@ -981,6 +1263,54 @@ func TestLLMISVCConnectionWebhook(t *testing.T) {
 			expectedAllowed:    true,
 			expectedPatchCheck: hasLLMISVCImagePullSecretsCleanupPatch(),
 		},
+		{
+			name:            "annotation removed for GCS type, serviceAccountName is cleanup",
+			secretType:      "",
+			secretNamespace: testNamespace,
+			annotations:     map[string]string{}, // no annotation
+			predictorSpec: map[string]any{
+				"template": map[string]any{
+					"serviceAccountName": testSecret + "-sa",
+				},
+				"model": map[string]any{
+					"uri": "gs://my-bucket/models/llama-7b",
+				},
+			},
+			oldAnnotations: map[string]string{annotations.Connection: testSecret},
+			oldPredictorSpec: map[string]any{
+				"template": map[string]any{
+					"serviceAccountName": testSecret + "-sa",
+				},
+				"model": map[string]any{
+					"uri": "gs://my-bucket/models/llama-7b",
+				},
+			},
+			oldSecretType:      webhookutils.ConnectionTypeProtocolGCS.String(),
+			operation:          admissionv1.Update,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasLLMISVCServiceAccountCleanupPatch(),
+		},
+		{
+			name:            "annotation removed for PVC type, uri is cleanup",
+			secretType:      "",
+			secretNamespace: testNamespace,
+			annotations:     map[string]string{}, // no annotation
+			predictorSpec: map[string]any{
+				"model": map[string]any{
+					"uri": "pvc://model-pvc/models/llama-7b",
+				},
+			},
+			oldAnnotations: map[string]string{annotations.Connection: testSecret},
+			oldPredictorSpec: map[string]any{
+				"model": map[string]any{
+					"uri": "pvc://model-pvc/models/llama-7b",
+				},
+			},
+			oldSecretType:      webhookutils.ConnectionTypeProtocolPVC.String(),
+			operation:          admissionv1.Update,
+			expectedAllowed:    true,
+			expectedPatchCheck: hasLLMISVCUriCleanupPatch(),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -153,6 +153,24 @@ func hasS3CleanupPatches() func([]jsonpatch.JsonPatchOperation) bool {
 	}
 }
 
+// gcs, azure, hf for isvc.
+func hasStorageConnectionCleanupPatches() func([]jsonpatch.JsonPatchOperation) bool {
+	return func(patches []jsonpatch.JsonPatchOperation) bool {
+		hasStorageUriCleanup := false
+		hasServiceAccountCleanup := false
+
+		for _, patch := range patches {
+			if patch.Path == isvcStorageUriPath && patch.Operation == OperationRemove {
+				hasStorageUriCleanup = true
+			}
+			if patch.Path == isvcServiceAccountPath && patch.Operation == OperationRemove {
+				hasServiceAccountCleanup = true
+			}
+		}
+		return hasStorageUriCleanup && hasServiceAccountCleanup
+	}
+}
+
 // serviceaccount for isvc.
 func hasServiceAccountNamePatch() func([]jsonpatch.JsonPatchOperation) bool {
 	return func(patches []jsonpatch.JsonPatchOperation) bool {
@@ -31,6 +31,12 @@ func RegisterWebhooks(mgr ctrl.Manager) error {
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
+	if err := (&ConnectionSecretWebhook{
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+		Name:    "connection-secret",
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
 
 	return nil
 }
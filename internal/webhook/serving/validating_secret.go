@@ -0,0 +1,85 @@
+//go:build !nowebhook
+
+package serving
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+// ConnectionSecretWebhook validates the data of connection Secrets against the schema of their
+// connection type, so that misconfigured connections are caught when the Secret is created or
+// updated instead of when a workload starts using it.
+//
+// Its ValidatingWebhookConfiguration is config/webhook/connection_secret_webhook.yaml, whose
+// match conditions only send the Secrets annotated with a connection type.
+type ConnectionSecretWebhook struct {
+	Decoder admission.Decoder
+	Name    string
+}
+
+var _ admission.Handler = &ConnectionSecretWebhook{}
+
+func (w *ConnectionSecretWebhook) SetupWithManager(mgr ctrl.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/platform-connection-secret", &webhook.Admission{
+		Handler:        w,
+		LogConstructor: webhookutils.NewWebhookLogConstructor(w.Name),
+	})
+	return nil
+}
+
+func (w *ConnectionSecretWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if w.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		secret := &corev1.Secret{}
+		if err := w.Decoder.Decode(req, secret); err != nil {
+			log.Error(err, "failed to decode Secret")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if !secret.GetDeletionTimestamp().IsZero() {
+			return admission.Allowed("Object marked for deletion, skipping connection validation")
+		}
+
+		connectionType := resources.GetAnnotation(secret, annotations.ConnectionTypeProtocol)
+		if connectionType == "" {
+			//nolint:staticcheck // SA1019: ConnectionTypeRef is deprecated but still supported for backward compatibility
+			connectionType = resources.GetAnnotation(secret, annotations.ConnectionTypeRef)
+		}
+
+		if !webhookutils.HasConnectionSecretSchema(connectionType) {
+			return admission.Allowed(fmt.Sprintf("Secret %s is not a known connection type", secret.Name))
+		}
+
+		if err := webhookutils.ValidateConnectionSecret(secret, connectionType); err != nil {
+			return admission.Denied(err.Error())
+		}
+
+		return admission.Allowed("Connection secret validation passed").
+			WithWarnings(webhookutils.ConnectionSecretWarnings(secret, connectionType)...)
+
+	default:
+		return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed in namespace %s", req.Operation, req.Kind.Kind, req.Namespace))
+	}
+}
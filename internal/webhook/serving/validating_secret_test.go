@@ -0,0 +1,222 @@
+package serving_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/serving"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectionSecretWebhook(t *testing.T) {
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		data            map[string][]byte
+		operation       admissionv1.Operation
+		expectedAllowed bool
+		expectedMessage string
+		expectedWarning string
+	}{
+		{
+			name:            "secret without connection type is allowed",
+			annotations:     map[string]string{},
+			data:            map[string][]byte{"key": []byte("value")},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:            "secret with unknown connection type is allowed",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: "other-type"},
+			data:            map[string][]byte{"key": []byte("value")},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:        "valid S3 connection secret is allowed",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-key"),
+				"AWS_S3_ENDPOINT":       []byte("https://s3.amazonaws.com"),
+				"AWS_DEFAULT_REGION":    []byte("us-east-1"),
+				"AWS_S3_BUCKET":         []byte("my-bucket"),
+			},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:            "S3 connection secret without credentials is allowed",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data:            map[string][]byte{"AWS_S3_BUCKET": []byte("public-bucket")},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:            "S3 connection secret with only the access key ID is denied",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data:            map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("access-key"), "AWS_S3_BUCKET": []byte("my-bucket")},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data keys 'AWS_ACCESS_KEY_ID', 'AWS_SECRET_ACCESS_KEY' must be set together, only 'AWS_ACCESS_KEY_ID' is set",
+		},
+		{
+			name:            "S3 connection secret with only the secret access key is denied",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data:            map[string][]byte{"AWS_SECRET_ACCESS_KEY": []byte("secret-key")},
+			operation:       admissionv1.Update,
+			expectedAllowed: false,
+			expectedMessage: "only 'AWS_SECRET_ACCESS_KEY' is set",
+		},
+		{
+			name:        "S3 connection secret with a misspelled key is allowed with a warning",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-key"),
+				"AWS_S3_ENDPONT":        []byte("https://s3.amazonaws.com"),
+			},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+			expectedWarning: "data key 'AWS_S3_ENDPONT' which is not used by this connection type, did you mean 'AWS_S3_ENDPOINT'?",
+		},
+		{
+			name:        "OCI connection secret with an unknown key is allowed with a warning",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolOCI.String()},
+			data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`),
+				"OCI_HOST":                 []byte("quay.io"),
+				"notes":                    []byte("pull only"),
+			},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+			expectedWarning: "data key 'notes' which is not used by this connection type",
+		},
+		{
+			name:        "S3 connection secret with a line break in the credentials is denied",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key\n"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-key"),
+			},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data key 'AWS_ACCESS_KEY_ID' must not contain whitespace or line breaks",
+		},
+		{
+			name:            "Azure connection secret with misspelled keys is denied listing all missing keys",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolAzure.String()},
+			data:            map[string][]byte{"AZURE_ACCOUNT": []byte("myaccount"), "AZURE_CONTAINER": []byte("models")},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "missing required data key 'AZURE_STORAGE_ACCOUNT'; missing required data key 'AZURE_STORAGE_CONTAINER'",
+		},
+		{
+			name:        "S3 connection secret with endpoint without scheme is denied",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-key"),
+				"AWS_S3_ENDPOINT":       []byte("s3.amazonaws.com"),
+			},
+			operation:       admissionv1.Update,
+			expectedAllowed: false,
+			expectedMessage: "data key 'AWS_S3_ENDPOINT' must be an http or https URL with a host",
+		},
+		{
+			name:        "S3 connection secret with invalid region is denied",
+			annotations: map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-key"),
+				"AWS_DEFAULT_REGION":    []byte("US East 1"),
+			},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data key 'AWS_DEFAULT_REGION' must be a region such as 'us-east-1'",
+		},
+		{
+			name:            "URI connection secret without scheme is denied",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolURI.String()},
+			data:            map[string][]byte{"URI": []byte("example.com/model")},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data key 'URI' must be a URI with a scheme and a host",
+		},
+		{
+			name:            "deprecated OCI connection secret with invalid docker config is denied",
+			annotations:     map[string]string{annotations.ConnectionTypeRef: webhookutils.ConnectionTypeRefOCI.String()}, //nolint:staticcheck // SA1019: ConnectionTypeRef is deprecated but still tested for backward compatibility
+			data:            map[string][]byte{corev1.DockerConfigJsonKey: []byte("not-json")},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "must be a docker config JSON with an 'auths' section",
+		},
+		{
+			name:            "PVC connection secret with invalid claim name is denied",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolPVC.String()},
+			data:            map[string][]byte{"PVC_NAME": []byte("Model_PVC")},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "data key 'PVC_NAME' must be a valid resource name",
+		},
+		{
+			name:            "deleting an invalid connection secret is allowed",
+			annotations:     map[string]string{annotations.ConnectionTypeProtocol: webhookutils.ConnectionTypeProtocolS3.String()},
+			data:            map[string][]byte{},
+			operation:       admissionv1.Delete,
+			expectedAllowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sch, ctx := setupTestEnvironment(t)
+
+			secret := &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        testSecret,
+					Namespace:   testNamespace,
+					Annotations: tc.annotations,
+				},
+				Data: tc.data,
+			}
+			secretRaw, err := json.Marshal(secret)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			webhook := &serving.ConnectionSecretWebhook{
+				Decoder: admission.NewDecoder(sch),
+				Name:    "connection-secret-test",
+			}
+
+			resp := webhook.Handle(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Namespace: testNamespace,
+					Object:    runtime.RawExtension{Raw: secretRaw},
+					Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Secret"},
+				},
+			})
+
+			g.Expect(resp.Allowed).To(Equal(tc.expectedAllowed))
+			if tc.expectedMessage != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectedMessage))
+			}
+			if tc.expectedWarning != "" {
+				g.Expect(resp.Warnings).To(ContainElement(ContainSubstring(tc.expectedWarning)))
+			} else {
+				g.Expect(resp.Warnings).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/dashboard"
 	dscv1webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/v1"
 	dscv2webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/deprecation"
 	dsciv1webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/dscinitialization/v1"
 	dsciv2webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/dscinitialization/v2"
 	hardwareprofilewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
 	monitoringwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/operatorconfig"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/flags"
 )
 
@@ -31,7 +33,11 @@ type webhookEntry struct {
 // RegisterAllWebhooks registers all webhook setup functions with the given manager.
 // Webhooks whose suppression flag is set are skipped.
 // Returns the first error encountered during registration, or nil if all succeed.
-func RegisterAllWebhooks(mgr ctrl.Manager) error {
+func RegisterAllWebhooks(mgr ctrl.Manager, settings operatorconfig.OperatorSettings) error {
+	registerDashboard := func(mgr ctrl.Manager) error {
+		return dashboard.RegisterWebhooks(mgr, deprecation.Mode(settings.DashboardHardwareProfileMode))
+	}
+
 	entries := []webhookEntry{
 		{name: "dsc-v1", register: dscv1webhook.RegisterWebhooks, disabled: func() bool { return !flags.IsDSCEnabled() }},
 		{name: "dsc-v2", register: dscv2webhook.RegisterWebhooks, disabled: func() bool { return !flags.IsDSCEnabled() }},
@@ -43,7 +49,7 @@ func RegisterAllWebhooks(mgr ctrl.Manager) error {
 		{name: "monitoring", register: monitoringwebhook.RegisterWebhooks, disabled: func() bool {
 			return !sr.IsEnabled(serviceApi.MonitoringServiceName) && !mr.IsEnabled(serviceApi.MonitoringServiceName)
 		}},
-		{name: "dashboard", register: dashboard.RegisterWebhooks, disabled: func() bool { return !mr.IsEnabled(componentApi.DashboardComponentName) }},
+		{name: "dashboard", register: registerDashboard, disabled: func() bool { return !mr.IsEnabled(componentApi.DashboardComponentName) }},
 	}
 
 	for _, e := range entries {
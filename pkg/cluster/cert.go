@@ -265,6 +265,7 @@ func IsGatewayCertificateSecret(ctx context.Context, cli client.Client, obj clie
 
 	default:
 		// no need action on selfsigned as operator create it which has the ownerreference on it with reconcile.
+		// CertManager issued secrets are tracked through the owned Certificate status instead.
 		return false
 	}
 }
@@ -145,6 +145,12 @@ var (
 		Kind:    "HardwareProfile",
 	}
 
+	HardwareProfileTarget = schema.GroupVersionKind{
+		Group:   infrav1.GroupVersion.Group,
+		Version: infrav1.GroupVersion.Version,
+		Kind:    "HardwareProfileTarget",
+	}
+
 	FeatureTracker = schema.GroupVersionKind{
 		Group:   featuresv1.GroupVersion.Group,
 		Version: featuresv1.GroupVersion.Version,
@@ -565,12 +571,24 @@ var (
 		Kind:    "IstioRevision",
 	}
 
+	KedaController = schema.GroupVersionKind{
+		Group:   "keda.sh",
+		Version: "v1alpha1",
+		Kind:    "KedaController",
+	}
+
 	GatewayConfig = schema.GroupVersionKind{
 		Group:   serviceApi.GroupVersion.Group,
 		Version: serviceApi.GroupVersion.Version,
 		Kind:    serviceApi.GatewayConfigKind,
 	}
 
+	PlatformRoute = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.PlatformRouteKind,
+	}
+
 	GatewayClass = schema.GroupVersionKind{
 		Group:   gwapiv1.GroupVersion.Group,
 		Version: gwapiv1.GroupVersion.Version,
@@ -1035,6 +1053,12 @@ var (
 		Kind:    "CoreWeaveKubernetesEngine",
 	}
 
+	GenericKubernetesEngine = schema.GroupVersionKind{
+		Group:   "infrastructure.opendatahub.io",
+		Version: "v1alpha1",
+		Kind:    "GenericKubernetesEngine",
+	}
+
 	AWSKubernetesEngine = schema.GroupVersionKind{
 		Group:   "infrastructure.opendatahub.io",
 		Version: "v1alpha1",
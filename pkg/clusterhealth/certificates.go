@@ -0,0 +1,90 @@
+package clusterhealth
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultCertificateExpiryDays is the expiry window used when Config.CertificateExpiryDays is zero.
+const DefaultCertificateExpiryDays = 7
+
+// runCertificatesSection checks the kubernetes.io/tls secrets in the given namespaces and reports
+// certificates that are unparsable, expired, or expire within expiryDays of now.
+func runCertificatesSection(ctx context.Context, c client.Client, namespaces []string, now time.Time, expiryDays int) SectionResult[CertificatesSection] {
+	var out SectionResult[CertificatesSection]
+	out.Data.Certificates = []CertificateInfo{}
+
+	if expiryDays <= 0 {
+		expiryDays = DefaultCertificateExpiryDays
+	}
+
+	var errs []string
+	for _, namespace := range namespaces {
+		infos, err := listCertificatesInNamespace(ctx, c, namespace, now, expiryDays)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", namespace, err))
+			continue
+		}
+		out.Data.Certificates = append(out.Data.Certificates, infos...)
+	}
+
+	var unhealthy []string
+	for _, info := range out.Data.Certificates {
+		if info.UnhealthyReason != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s/%s (%s)", info.Namespace, info.Name, info.UnhealthyReason))
+		}
+	}
+	if len(unhealthy) > 0 {
+		errs = append(errs, fmt.Sprintf("certificates expiring or invalid: %s", strings.Join(unhealthy, ", ")))
+	}
+	if len(errs) > 0 {
+		out.Error = strings.Join(errs, "; ")
+	}
+	return out
+}
+
+func listCertificatesInNamespace(ctx context.Context, c client.Client, namespace string, now time.Time, expiryDays int) ([]CertificateInfo, error) {
+	list := &corev1.SecretList{}
+	if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	infos := make([]CertificateInfo, 0, len(list.Items))
+	for i := range list.Items {
+		secret := &list.Items[i]
+		if secret.Type != corev1.SecretTypeTLS {
+			continue
+		}
+		infos = append(infos, secretToCertificateInfo(secret, now, expiryDays))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+func secretToCertificateInfo(secret *corev1.Secret, now time.Time, expiryDays int) CertificateInfo {
+	info := CertificateInfo{Namespace: secret.Namespace, Name: secret.Name}
+
+	certs, err := parsePEMCertificates(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		info.UnhealthyReason = fmt.Sprintf("invalid %s: %v", corev1.TLSCertKey, err)
+		return info
+	}
+	leaf := certs[0]
+	info.Subject = leaf.Subject.CommonName
+	info.NotAfter = leaf.NotAfter
+	info.DaysToExpiry = int(math.Floor(leaf.NotAfter.Sub(now).Hours() / 24))
+
+	switch {
+	case !now.Before(leaf.NotAfter):
+		info.UnhealthyReason = fmt.Sprintf("expired %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	case info.DaysToExpiry < expiryDays:
+		info.UnhealthyReason = fmt.Sprintf("expires in %d days", info.DaysToExpiry)
+	}
+	return info
+}
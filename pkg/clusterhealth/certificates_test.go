@@ -0,0 +1,206 @@
+//nolint:testpackage // White-box tests require access to unexported functions (e.g. runCertificatesSection).
+package clusterhealth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testCA is a self-signed CA used to issue serving certificates in tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate CA key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse CA certificate: %v", err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM leaf certificate for cn signed by the CA, valid until notAfter.
+func (ca *testCA) issue(t *testing.T, cn string, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func tlsSecret(namespace, name string, cert []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: []byte("unused")},
+	}
+}
+
+func TestRunCertificatesSection(t *testing.T) {
+	ca := newTestCA(t)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		objects       []client.Object
+		expiryDays    int
+		wantCerts     int
+		wantErr       bool
+		wantErrSubstr string
+		wantDays      map[string]int
+	}{
+		{
+			name:      "no secrets",
+			wantCerts: 0,
+		},
+		{
+			name: "valid certificate",
+			objects: []client.Object{
+				tlsSecret("op-ns", "webhook-cert", ca.issue(t, "webhook", now.Add(30*24*time.Hour))),
+			},
+			wantCerts: 1,
+			wantDays:  map[string]int{"webhook-cert": 30},
+		},
+		{
+			name: "expiring within default window",
+			objects: []client.Object{
+				tlsSecret("op-ns", "webhook-cert", ca.issue(t, "webhook", now.Add(3*24*time.Hour))),
+			},
+			wantCerts:     1,
+			wantErr:       true,
+			wantErrSubstr: "op-ns/webhook-cert (expires in 3 days)",
+			wantDays:      map[string]int{"webhook-cert": 3},
+		},
+		{
+			name: "custom expiry window",
+			objects: []client.Object{
+				tlsSecret("apps-ns", "dashboard-tls", ca.issue(t, "dashboard", now.Add(20*24*time.Hour))),
+			},
+			expiryDays:    30,
+			wantCerts:     1,
+			wantErr:       true,
+			wantErrSubstr: "expires in 20 days",
+		},
+		{
+			name: "expired",
+			objects: []client.Object{
+				tlsSecret("apps-ns", "dashboard-tls", ca.issue(t, "dashboard", now.Add(-36*time.Hour))),
+			},
+			wantCerts:     1,
+			wantErr:       true,
+			wantErrSubstr: "apps-ns/dashboard-tls (expired",
+			wantDays:      map[string]int{"dashboard-tls": -2},
+		},
+		{
+			name: "invalid certificate data",
+			objects: []client.Object{
+				tlsSecret("op-ns", "broken", []byte("not a certificate")),
+			},
+			wantCerts:     1,
+			wantErr:       true,
+			wantErrSubstr: "op-ns/broken (invalid tls.crt",
+		},
+		{
+			name: "non-TLS and other-namespace secrets ignored",
+			objects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "op-ns", Name: "opaque"}, Type: corev1.SecretTypeOpaque},
+				tlsSecret("other-ns", "expired", ca.issue(t, "other", now.Add(-time.Hour))),
+			},
+			wantCerts: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.objects...).Build()
+			got := runCertificatesSection(context.Background(), cl, []string{"op-ns", "apps-ns"}, now, tt.expiryDays)
+
+			if len(got.Data.Certificates) != tt.wantCerts {
+				t.Fatalf("Certificates length = %d, want %d (%+v)", len(got.Data.Certificates), tt.wantCerts, got.Data.Certificates)
+			}
+			if (got.Error != "") != tt.wantErr {
+				t.Errorf("Error = %q, wantErr %v", got.Error, tt.wantErr)
+			}
+			if tt.wantErrSubstr != "" && !strings.Contains(got.Error, tt.wantErrSubstr) {
+				t.Errorf("Error = %q, want substring %q", got.Error, tt.wantErrSubstr)
+			}
+			for _, c := range got.Data.Certificates {
+				if want, ok := tt.wantDays[c.Name]; ok && c.DaysToExpiry != want {
+					t.Errorf("%s DaysToExpiry = %d, want %d", c.Name, c.DaysToExpiry, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRun_CertificatesSection_Namespaces(t *testing.T) {
+	ca := newTestCA(t)
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		tlsSecret("op-ns", "operator-cert", ca.issue(t, "operator", time.Now().Add(60*24*time.Hour))),
+		tlsSecret("apps-ns", "apps-cert", ca.issue(t, "apps", time.Now().Add(60*24*time.Hour))),
+		tlsSecret("monitoring-ns", "monitoring-cert", ca.issue(t, "monitoring", time.Now().Add(time.Hour))),
+	).Build()
+
+	report, err := Run(context.Background(), Config{
+		Client:       cl,
+		Operator:     OperatorConfig{Namespace: "op-ns", Name: "op"},
+		Namespaces:   NamespaceConfig{Apps: "apps-ns", Monitoring: "monitoring-ns"},
+		OnlySections: []string{SectionCertificates},
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.Certificates.Error != "" {
+		t.Errorf("Certificates.Error = %q, want empty (monitoring namespace is not checked)", report.Certificates.Error)
+	}
+	if len(report.Certificates.Data.Certificates) != 2 {
+		t.Errorf("Certificates = %+v, want operator and apps certificates", report.Certificates.Data.Certificates)
+	}
+}
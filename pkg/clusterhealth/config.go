@@ -21,6 +21,21 @@ type Config struct {
 	OnlySections []string
 	// Layers limits which sections to run. Empty or nil = run all.
 	Layers []string
+	// Registry holds the sections to run. Nil uses DefaultRegistry().
+	Registry *Registry
+	// CRDGroupSuffixes selects the CRDs checked by the CRDs section by spec.group suffix.
+	// Empty or nil = DefaultCRDGroupSuffixes.
+	CRDGroupSuffixes []string
+	// CertificateExpiryDays reports TLS certificates expiring within this many days as unhealthy.
+	// Zero = DefaultCertificateExpiryDays.
+	CertificateExpiryDays int
+}
+
+func (c *Config) registry() *Registry {
+	if c.Registry != nil {
+		return c.Registry
+	}
+	return DefaultRegistry()
 }
 
 // OperatorConfig configures which operator deployment and namespace to check.
@@ -39,6 +54,20 @@ type NamespaceConfig struct {
 	Extra      []string
 }
 
+// webhookNamespaces returns the namespaces whose webhook Services are checked: the operator
+// namespace and all configured namespaces.
+func (c *Config) webhookNamespaces() []string {
+	ns := c.Namespaces
+	ns.Extra = append([]string{c.Operator.Namespace}, ns.Extra...)
+	return ns.List()
+}
+
+// certificateNamespaces returns the namespaces whose TLS secrets are checked: the operator
+// and applications namespaces.
+func (c *Config) certificateNamespaces() []string {
+	return NamespaceConfig{Apps: c.Namespaces.Apps, Extra: []string{c.Operator.Namespace}}.List()
+}
+
 // List returns the deduplicated list of namespaces to scan, skipping empty ones.
 // When Apps and Monitoring point to the same namespace (common in ODH), the
 // namespace appears only once to avoid duplicate API calls and metric lines.
@@ -0,0 +1,133 @@
+package clusterhealth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CustomResourceDefinitionGVK is listed as unstructured so this package does not depend on apiextensions-apiserver.
+var CustomResourceDefinitionGVK = schema.GroupVersionKind{
+	Group:   "apiextensions.k8s.io",
+	Version: "v1",
+	Kind:    "CustomResourceDefinition",
+}
+
+// DefaultCRDGroupSuffixes selects the platform CRDs checked by the CRDs section when
+// Config.CRDGroupSuffixes is empty.
+var DefaultCRDGroupSuffixes = []string{"opendatahub.io"}
+
+// CRD conditions that must be True for a CRD to be served.
+var requiredCRDConditions = []string{"Established", "NamesAccepted"}
+
+// runCRDsSection checks the CRDs whose spec.group matches one of groupSuffixes: Established and
+// NamesAccepted must be True, and every status.storedVersions entry must still be in spec.versions
+// (otherwise objects stored at that version can no longer be read or migrated).
+func runCRDsSection(ctx context.Context, c client.Client, groupSuffixes []string) SectionResult[CRDsSection] {
+	var out SectionResult[CRDsSection]
+	out.Data.CRDs = []CRDInfo{}
+
+	if len(groupSuffixes) == 0 {
+		groupSuffixes = DefaultCRDGroupSuffixes
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(CustomResourceDefinitionGVK.GroupVersion().WithKind(CustomResourceDefinitionGVK.Kind + "List"))
+	if err := c.List(ctx, list); err != nil {
+		out.Error = fmt.Sprintf("list CustomResourceDefinitions: %v", err)
+		return out
+	}
+
+	var unhealthy []string
+	for i := range list.Items {
+		crd := &list.Items[i]
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if !hasAnySuffix(group, groupSuffixes) {
+			continue
+		}
+		info := crdToInfo(crd)
+		out.Data.CRDs = append(out.Data.CRDs, info)
+		if info.UnhealthyReason != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", info.Name, info.UnhealthyReason))
+		}
+	}
+	sort.Slice(out.Data.CRDs, func(i, j int) bool { return out.Data.CRDs[i].Name < out.Data.CRDs[j].Name })
+
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		out.Error = fmt.Sprintf("unhealthy CRDs: %s", strings.Join(unhealthy, ", "))
+	}
+	return out
+}
+
+func crdToInfo(crd *unstructured.Unstructured) CRDInfo {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	storedVersions, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	info := CRDInfo{
+		Name:           crd.GetName(),
+		Group:          group,
+		StoredVersions: storedVersions,
+	}
+
+	var reasons []string
+
+	specVersions := make(map[string]bool)
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, raw := range versions {
+		v, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := v["name"].(string)
+		specVersions[name] = true
+		if served, _ := v["served"].(bool); served {
+			info.ServedVersions = append(info.ServedVersions, name)
+		}
+	}
+
+	conditions, err := parseConditionsFromUnstructured(crd.Object)
+	if err != nil {
+		reasons = append(reasons, fmt.Sprintf("malformed status.conditions: %v", err))
+	}
+	for _, cond := range conditions {
+		for _, required := range requiredCRDConditions {
+			if cond.Type == required {
+				info.Conditions = append(info.Conditions, cond)
+			}
+		}
+	}
+	for _, required := range requiredCRDConditions {
+		status := "Unknown"
+		for _, cond := range info.Conditions {
+			if cond.Type == required {
+				status = cond.Status
+			}
+		}
+		if !conditionStatusIsTrue(status) {
+			reasons = append(reasons, fmt.Sprintf("%s=%s", required, status))
+		}
+	}
+
+	for _, v := range storedVersions {
+		if !specVersions[v] {
+			reasons = append(reasons, fmt.Sprintf("stored version %s not in spec.versions", v))
+		}
+	}
+
+	info.UnhealthyReason = strings.Join(reasons, "; ")
+	return info
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if s == suffix || strings.HasSuffix(s, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+//nolint:testpackage // White-box tests require access to unexported functions (e.g. runCRDsSection).
+package clusterhealth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testCRD returns an unstructured CRD for group with the given spec versions, stored versions and
+// Established/NamesAccepted statuses (empty status omits the condition).
+func testCRD(name, group string, specVersions, storedVersions []string, established, namesAccepted string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(CustomResourceDefinitionGVK)
+	crd.SetName(name)
+
+	versions := make([]any, 0, len(specVersions))
+	for _, v := range specVersions {
+		versions = append(versions, map[string]any{"name": v, "served": true, "storage": v == specVersions[0]})
+	}
+	stored := make([]any, 0, len(storedVersions))
+	for _, v := range storedVersions {
+		stored = append(stored, v)
+	}
+	var conditions []any
+	if established != "" {
+		conditions = append(conditions, map[string]any{"type": "Established", "status": established})
+	}
+	if namesAccepted != "" {
+		conditions = append(conditions, map[string]any{"type": "NamesAccepted", "status": namesAccepted, "message": "names conflict"})
+	}
+
+	crd.Object["spec"] = map[string]any{"group": group, "versions": versions}
+	crd.Object["status"] = map[string]any{"conditions": conditions, "storedVersions": stored}
+	return crd
+}
+
+func TestRunCRDsSection(t *testing.T) {
+	tests := []struct {
+		name          string
+		objects       []client.Object
+		groupSuffixes []string
+		wantCRDs      []string
+		wantErrSubstr string // empty means the section must be healthy
+	}{
+		{
+			name:     "no CRDs",
+			wantCRDs: []string{},
+		},
+		{
+			name: "healthy platform CRDs, other groups ignored",
+			objects: []client.Object{
+				testCRD("dashboards.components.platform.opendatahub.io", "components.platform.opendatahub.io", []string{"v1alpha1"}, []string{"v1alpha1"}, "True", "True"),
+				testCRD("datascienceclusters.datasciencecluster.opendatahub.io", "datasciencecluster.opendatahub.io", []string{"v2", "v1"}, []string{"v1", "v2"}, "True", "True"),
+				testCRD("certificates.cert-manager.io", "cert-manager.io", []string{"v1"}, []string{"v1"}, "False", "False"),
+				testCRD("things.notopendatahub.io", "notopendatahub.io", []string{"v1"}, []string{"v1"}, "False", "True"),
+			},
+			wantCRDs: []string{"dashboards.components.platform.opendatahub.io", "datascienceclusters.datasciencecluster.opendatahub.io"},
+		},
+		{
+			name: "not established",
+			objects: []client.Object{
+				testCRD("kserves.components.platform.opendatahub.io", "components.platform.opendatahub.io", []string{"v1alpha1"}, []string{"v1alpha1"}, "False", "True"),
+			},
+			wantCRDs:      []string{"kserves.components.platform.opendatahub.io"},
+			wantErrSubstr: "kserves.components.platform.opendatahub.io (Established=False)",
+		},
+		{
+			name: "names not accepted",
+			objects: []client.Object{
+				testCRD("kserves.components.platform.opendatahub.io", "components.platform.opendatahub.io", []string{"v1alpha1"}, []string{"v1alpha1"}, "True", "False"),
+			},
+			wantCRDs:      []string{"kserves.components.platform.opendatahub.io"},
+			wantErrSubstr: "NamesAccepted=False",
+		},
+		{
+			name: "missing conditions",
+			objects: []client.Object{
+				testCRD("kserves.components.platform.opendatahub.io", "components.platform.opendatahub.io", []string{"v1alpha1"}, []string{"v1alpha1"}, "", ""),
+			},
+			wantCRDs:      []string{"kserves.components.platform.opendatahub.io"},
+			wantErrSubstr: "Established=Unknown; NamesAccepted=Unknown",
+		},
+		{
+			name: "stored version removed from spec",
+			objects: []client.Object{
+				testCRD("dscinitializations.dscinitialization.opendatahub.io", "dscinitialization.opendatahub.io", []string{"v2"}, []string{"v1", "v2"}, "True", "True"),
+			},
+			wantCRDs:      []string{"dscinitializations.dscinitialization.opendatahub.io"},
+			wantErrSubstr: "stored version v1 not in spec.versions",
+		},
+		{
+			name: "custom group suffixes",
+			objects: []client.Object{
+				testCRD("dashboards.components.platform.opendatahub.io", "components.platform.opendatahub.io", []string{"v1alpha1"}, []string{"v1alpha1"}, "True", "True"),
+				testCRD("clusterqueues.kueue.x-k8s.io", "kueue.x-k8s.io", []string{"v1beta1"}, []string{"v1beta1"}, "False", "True"),
+			},
+			groupSuffixes: []string{"kueue.x-k8s.io"},
+			wantCRDs:      []string{"clusterqueues.kueue.x-k8s.io"},
+			wantErrSubstr: "clusterqueues.kueue.x-k8s.io (Established=False)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.objects...).Build()
+			got := runCRDsSection(context.Background(), cl, tt.groupSuffixes)
+
+			names := make([]string, 0, len(got.Data.CRDs))
+			for _, crd := range got.Data.CRDs {
+				names = append(names, crd.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantCRDs, ",") {
+				t.Errorf("CRDs = %v, want %v", names, tt.wantCRDs)
+			}
+			if tt.wantErrSubstr == "" && got.Error != "" {
+				t.Errorf("Error = %q, want empty", got.Error)
+			}
+			if tt.wantErrSubstr != "" && !strings.Contains(got.Error, tt.wantErrSubstr) {
+				t.Errorf("Error = %q, want substring %q", got.Error, tt.wantErrSubstr)
+			}
+		})
+	}
+}
+
+func TestRun_CRDsSection_StoredVersions(t *testing.T) {
+	crd := testCRD("datascienceclusters.datasciencecluster.opendatahub.io", "datasciencecluster.opendatahub.io", []string{"v2", "v1"}, []string{"v1", "v2"}, "True", "True")
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(crd).Build()
+
+	report, err := Run(context.Background(), Config{Client: cl, OnlySections: []string{SectionCRDs}})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !report.Healthy() {
+		t.Errorf("Healthy() = false, want true; CRDs.Error = %q", report.CRDs.Error)
+	}
+	if len(report.CRDs.Data.CRDs) != 1 {
+		t.Fatalf("CRDs length = %d, want 1", len(report.CRDs.Data.CRDs))
+	}
+	info := report.CRDs.Data.CRDs[0]
+	if strings.Join(info.StoredVersions, ",") != "v1,v2" || strings.Join(info.ServedVersions, ",") != "v2,v1" {
+		t.Errorf("CRD versions = stored %v served %v, want stored [v1 v2] served [v2 v1]", info.StoredVersions, info.ServedVersions)
+	}
+	if !strings.Contains(report.PrettyPrint(true), "datascienceclusters.datasciencecluster.opendatahub.io stored=v1,v2") {
+		t.Errorf("PrettyPrint(true) missing CRD details:\n%s", report.PrettyPrint(true))
+	}
+}
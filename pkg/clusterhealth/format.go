@@ -19,20 +19,8 @@ type sectionRow struct {
 	Summary string
 }
 
-// sectionDisplayOrder is the fixed order for pretty-print; each entry is (section key, display name).
-var sectionDisplayOrder = []struct{ key, display string }{
-	{SectionNodes, "Nodes"},
-	{SectionDeployments, "Deployments"},
-	{SectionPods, "Pods"},
-	{SectionEvents, "Events"},
-	{SectionQuotas, "Quotas"},
-	{SectionOperator, "Operator"},
-	{SectionDSCI, "DSCI"},
-	{SectionDSC, "DSC"},
-}
-
 // PrettyPrint returns a human-readable table of section results.
-// When Report.SectionsRun is set (e.g. from -sections or -layer), only those sections are shown; otherwise all eight are shown.
+// When Report.SectionsRun is set (e.g. from -sections or -layer), only those sections are shown; otherwise all registered sections are shown.
 // If long is true, appends a details block listing conditions and other per-item data (like ls -l).
 func (r *Report) PrettyPrint(long bool) string {
 	out := r.formatTable(r.sectionRows())
@@ -42,72 +30,24 @@ func (r *Report) PrettyPrint(long bool) string {
 	return out
 }
 
-// sectionRows returns section rows in display order, optionally filtered to SectionsRun.
+// sectionRows returns section rows in registry order, optionally filtered to SectionsRun.
 func (r *Report) sectionRows() []sectionRow {
-	runSet := make(map[string]bool)
-	for _, k := range r.SectionsRun {
-		runSet[k] = true
-	}
-	rows := make([]sectionRow, 0, len(sectionDisplayOrder))
-	for _, s := range sectionDisplayOrder {
-		if len(runSet) > 0 && !runSet[s.key] {
+	runSet := sliceToSet(r.SectionsRun)
+	sections := r.sections()
+	rows := make([]sectionRow, 0, len(sections))
+	for _, s := range sections {
+		if len(runSet) > 0 && !runSet[s.Name()] {
 			continue
 		}
 		rows = append(rows, sectionRow{
-			Section: s.display,
-			Status:  r.sectionStatusForKey(s.key),
-			Summary: r.sectionSummaryForKey(s.key),
+			Section: s.DisplayName(),
+			Status:  sectionStatus(s.Error(r)),
+			Summary: s.Summary(r),
 		})
 	}
 	return rows
 }
 
-func (r *Report) sectionStatusForKey(key string) string {
-	switch key {
-	case SectionNodes:
-		return sectionStatus(r.Nodes.Error)
-	case SectionDeployments:
-		return sectionStatus(r.Deployments.Error)
-	case SectionPods:
-		return sectionStatus(r.Pods.Error)
-	case SectionEvents:
-		return sectionStatus(r.Events.Error)
-	case SectionQuotas:
-		return sectionStatus(r.Quotas.Error)
-	case SectionOperator:
-		return sectionStatus(r.Operator.Error)
-	case SectionDSCI:
-		return sectionStatus(r.DSCI.Error)
-	case SectionDSC:
-		return sectionStatus(r.DSC.Error)
-	default:
-		return statusOK
-	}
-}
-
-func (r *Report) sectionSummaryForKey(key string) string {
-	switch key {
-	case SectionNodes:
-		return r.summaryNodes()
-	case SectionDeployments:
-		return r.summaryDeployments()
-	case SectionPods:
-		return r.summaryPods()
-	case SectionEvents:
-		return r.summaryEvents()
-	case SectionQuotas:
-		return r.summaryQuotas()
-	case SectionOperator:
-		return r.summaryOperator()
-	case SectionDSCI:
-		return r.summaryDSCI()
-	case SectionDSC:
-		return r.summaryDSC()
-	default:
-		return ""
-	}
-}
-
 func sectionStatus(err string) string {
 	if err == "" {
 		return statusOK
@@ -279,6 +219,47 @@ func (r *Report) summaryDSC() string {
 	return fmt.Sprintf("%s, %d conditions", name, n)
 }
 
+func (r *Report) summaryWebhooks() string {
+	if r.Webhooks.Error != "" {
+		return truncate(r.Webhooks.Error, 60)
+	}
+	n := len(r.Webhooks.Data.Webhooks)
+	if n == 0 {
+		return "no platform webhooks"
+	}
+	configurations := make(map[string]bool)
+	for _, wh := range r.Webhooks.Data.Webhooks {
+		configurations[wh.Kind+"/"+wh.Configuration] = true
+	}
+	return fmt.Sprintf("%d webhooks in %d configurations", n, len(configurations))
+}
+
+func (r *Report) summaryCRDs() string {
+	if r.CRDs.Error != "" {
+		return truncate(r.CRDs.Error, 60)
+	}
+	n := len(r.CRDs.Data.CRDs)
+	if n == 0 {
+		return "no platform CRDs"
+	}
+	return fmt.Sprintf("%d CRDs", n)
+}
+
+func (r *Report) summaryCertificates() string {
+	if r.Certificates.Error != "" {
+		return truncate(r.Certificates.Error, 60)
+	}
+	certs := r.Certificates.Data.Certificates
+	if len(certs) == 0 {
+		return "no TLS secrets"
+	}
+	soonest := certs[0].DaysToExpiry
+	for _, c := range certs[1:] {
+		soonest = min(soonest, c.DaysToExpiry)
+	}
+	return fmt.Sprintf("%d certificates, next expiry in %d days", len(certs), soonest)
+}
+
 func truncate(s string, maxLen int) string {
 	s = strings.TrimSpace(s)
 	if len(s) <= maxLen {
@@ -322,19 +303,16 @@ func (r *Report) formatTable(rows []sectionRow) string {
 
 // longDetails returns an expanded view of each section's data (conditions, items) when -l is used.
 func (r *Report) longDetails() string {
-	runSet := make(map[string]bool)
-	for _, k := range r.SectionsRun {
-		runSet[k] = true
-	}
+	runSet := sliceToSet(r.SectionsRun)
 	var b strings.Builder
 	b.WriteString("--- Details (-l) ---\n")
-	for _, s := range sectionDisplayOrder {
-		if len(runSet) > 0 && !runSet[s.key] {
+	for _, s := range r.sections() {
+		if len(runSet) > 0 && !runSet[s.Name()] {
 			continue
 		}
-		block := r.longDetailsForSection(s.key)
+		block := s.LongDetails(r)
 		if block != "" {
-			b.WriteString(s.display)
+			b.WriteString(s.DisplayName())
 			b.WriteString(":\n")
 			b.WriteString(block)
 			b.WriteString("\n")
@@ -343,29 +321,6 @@ func (r *Report) longDetails() string {
 	return b.String()
 }
 
-func (r *Report) longDetailsForSection(key string) string {
-	switch key {
-	case SectionNodes:
-		return r.longDetailsNodes()
-	case SectionDeployments:
-		return r.longDetailsDeployments()
-	case SectionPods:
-		return r.longDetailsPods()
-	case SectionEvents:
-		return r.longDetailsEvents()
-	case SectionQuotas:
-		return r.longDetailsQuotas()
-	case SectionOperator:
-		return r.longDetailsOperator()
-	case SectionDSCI:
-		return r.longDetailsCRConditions(r.DSCI.Data.Name, r.DSCI.Data.Conditions)
-	case SectionDSC:
-		return r.longDetailsCRConditions(r.DSC.Data.Name, r.DSC.Data.Conditions)
-	default:
-		return ""
-	}
-}
-
 func (r *Report) longDetailsNodes() string {
 	if r.Nodes.Error != "" {
 		return "  " + r.Nodes.Error + "\n"
@@ -491,6 +446,66 @@ func (r *Report) longDetailsOperator() string {
 	return b.String()
 }
 
+func (r *Report) longDetailsWebhooks() string {
+	if r.Webhooks.Error != "" && len(r.Webhooks.Data.Webhooks) == 0 {
+		return "  " + r.Webhooks.Error + "\n"
+	}
+	var b strings.Builder
+	for _, wh := range r.Webhooks.Data.Webhooks {
+		b.WriteString("  ")
+		b.WriteString(wh.Configuration)
+		b.WriteString("/")
+		b.WriteString(wh.Name)
+		fmt.Fprintf(&b, " %s endpoints=%d failurePolicy=%s", wh.Service, wh.EndpointsReady, wh.FailurePolicy)
+		if wh.UnhealthyReason != "" {
+			b.WriteString(": ")
+			b.WriteString(wh.UnhealthyReason)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (r *Report) longDetailsCRDs() string {
+	if r.CRDs.Error != "" && len(r.CRDs.Data.CRDs) == 0 {
+		return "  " + r.CRDs.Error + "\n"
+	}
+	var b strings.Builder
+	for _, crd := range r.CRDs.Data.CRDs {
+		b.WriteString("  ")
+		b.WriteString(crd.Name)
+		b.WriteString(" stored=")
+		b.WriteString(strings.Join(crd.StoredVersions, ","))
+		if crd.UnhealthyReason != "" {
+			b.WriteString(": ")
+			b.WriteString(crd.UnhealthyReason)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (r *Report) longDetailsCertificates() string {
+	if r.Certificates.Error != "" && len(r.Certificates.Data.Certificates) == 0 {
+		return "  " + r.Certificates.Error + "\n"
+	}
+	var b strings.Builder
+	for _, c := range r.Certificates.Data.Certificates {
+		b.WriteString("  ")
+		b.WriteString(c.Namespace)
+		b.WriteString("/")
+		b.WriteString(c.Name)
+		if c.UnhealthyReason != "" {
+			b.WriteString(": ")
+			b.WriteString(c.UnhealthyReason)
+		} else {
+			fmt.Fprintf(&b, " expires in %d days", c.DaysToExpiry)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 func (r *Report) longDetailsCRConditions(name string, conditions []ConditionSummary) string {
 	if name == "" {
 		return ""
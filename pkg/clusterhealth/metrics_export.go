@@ -20,11 +20,11 @@ func (r *Report) PrometheusExport() []string {
 	// Preallocate with rough capacity estimate
 	lines := make([]string, 0, 100)
 
-	lines = append(lines, r.exportNodes(ts)...)
-	lines = append(lines, r.exportDeployments(ts)...)
-	lines = append(lines, r.exportPods(ts)...)
-	lines = append(lines, r.exportQuotas(ts)...)
-	lines = append(lines, r.exportHealth(ts)...)
+	sections := r.sections()
+	for _, s := range sections {
+		lines = append(lines, s.Metrics(r, ts)...)
+	}
+	lines = append(lines, r.exportHealth(sections, ts)...)
 
 	return lines
 }
@@ -182,24 +182,39 @@ func (r *Report) exportQuotas(ts int64) []string {
 	return lines
 }
 
-func (r *Report) exportHealth(ts int64) []string {
+func (r *Report) exportWebhooks(ts int64) []string {
+	lines := make([]string, 0, len(r.Webhooks.Data.Webhooks))
+	for _, wh := range r.Webhooks.Data.Webhooks {
+		labels := promLabels{"kind": wh.Kind, "configuration": wh.Configuration, "webhook": wh.Name, "service": wh.Service}
+		lines = append(lines, promLine("webhook_service_endpoints_ready", labels, float64(wh.EndpointsReady), ts))
+	}
+	return lines
+}
+
+func (r *Report) exportCertificates(ts int64) []string {
+	var lines []string
+	for _, c := range r.Certificates.Data.Certificates {
+		if c.NotAfter.IsZero() {
+			continue
+		}
+		labels := promLabels{"namespace": c.Namespace, "secret": c.Name}
+		lines = append(lines, promLine("tls_secret_not_after_timestamp_seconds", labels, float64(c.NotAfter.Unix()), ts))
+	}
+	return lines
+}
+
+func (r *Report) exportHealth(sections []Section, ts int64) []string {
 	healthy := 0.0
 	if r.Healthy() {
 		healthy = 1.0
 	}
-	// Preallocate: 1 for cluster_healthy + 8 sections
-	lines := make([]string, 0, 9)
+	// Preallocate: 1 for cluster_healthy + one per section
+	lines := make([]string, 0, len(sections)+1)
 	lines = append(lines, promLine("cluster_healthy", nil, healthy, ts))
 
-	sectionErrors := map[string]string{
-		SectionNodes:       r.Nodes.Error,
-		SectionDeployments: r.Deployments.Error,
-		SectionPods:        r.Pods.Error,
-		SectionEvents:      r.Events.Error,
-		SectionQuotas:      r.Quotas.Error,
-		SectionOperator:    r.Operator.Error,
-		SectionDSCI:        r.DSCI.Error,
-		SectionDSC:         r.DSC.Error,
+	sectionErrors := make(map[string]string, len(sections))
+	for _, s := range sections {
+		sectionErrors[s.Name()] = s.Error(r)
 	}
 	for _, section := range sortedMapKeys(sectionErrors) {
 		val := 1.0
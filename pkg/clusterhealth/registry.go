@@ -0,0 +1,480 @@
+package clusterhealth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Section is one health check of the Report. Run iterates the sections of a Registry in
+// registration order; PrettyPrint, PrometheusExport and Healthy use the same sections to
+// render, export and evaluate the report.
+type Section interface {
+	// Name is the unique section key used in Config.OnlySections, Report.SectionsRun and metrics.
+	Name() string
+	// DisplayName is the name shown in the PrettyPrint table.
+	DisplayName() string
+	// Layers lists the layers (see Layer constants) that include this section.
+	Layers() []string
+	// Run executes the check and records its result in the report.
+	Run(ctx context.Context, cfg Config, report *Report)
+	// Error returns the section error recorded in the report; empty means healthy.
+	Error(report *Report) string
+	// Summary returns the one-line summary shown in the PrettyPrint table.
+	Summary(report *Report) string
+	// LongDetails returns the block shown by PrettyPrint(true); empty omits the section.
+	LongDetails(report *Report) string
+	// Metrics returns Prometheus exposition lines for the section, stamped with ts (Unix millis).
+	Metrics(report *Report, ts int64) []string
+	// Counts returns the number of items the section checked and how many of them are unhealthy.
+	Counts(report *Report) (total, unhealthy int)
+}
+
+// Registry is an ordered set of sections. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	sections []Section
+}
+
+// NewRegistry returns an empty registry. Use NewDefaultRegistry to start from the built-in sections.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// NewDefaultRegistry returns a new registry holding the built-in sections (nodes, deployments,
+// pods, events, quotas, operator, dsci, dsc, webhooks, crds, certificates). Sections registered
+// in it do not affect the package-level default registry.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	for _, s := range builtinSections() {
+		r.MustRegister(s)
+	}
+	return r
+}
+
+// Register appends a section to the registry. It returns an error when the section is nil,
+// has an empty name, or its name is already registered.
+func (r *Registry) Register(s Section) error {
+	if s == nil {
+		return errors.New("clusterhealth: cannot register nil section")
+	}
+	name := s.Name()
+	if strings.TrimSpace(name) == "" {
+		return errors.New("clusterhealth: section name is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.sections {
+		if existing.Name() == name {
+			return fmt.Errorf("clusterhealth: section %q already registered", name)
+		}
+	}
+	r.sections = append(r.sections, s)
+	return nil
+}
+
+// MustRegister is like Register but panics on error. Intended for init() functions.
+func (r *Registry) MustRegister(s Section) {
+	if err := r.Register(s); err != nil {
+		panic(err)
+	}
+}
+
+// Sections returns the registered sections in registration order.
+func (r *Registry) Sections() []Section {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]Section, len(r.sections))
+	copy(out, r.sections)
+	return out
+}
+
+// Lookup returns the section registered under name.
+func (r *Registry) Lookup(name string) (Section, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, s := range r.sections {
+		if s.Name() == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+var defaultRegistry = NewDefaultRegistry()
+
+// DefaultRegistry returns the package-level registry used when Config.Registry is nil.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds a section to the default registry, so that every Run without an explicit
+// Config.Registry executes it.
+func Register(s Section) error {
+	return defaultRegistry.Register(s)
+}
+
+// SectionSpec describes a section contributed from outside this package. Its result is stored
+// in Report.Custom under Name; use CustomResult to read it back as SectionResult[T].
+type SectionSpec[T any] struct {
+	// Name is the unique section key (required).
+	Name string
+	// DisplayName is shown in PrettyPrint. Defaults to Name.
+	DisplayName string
+	// Layers lists the layers that include this section. A new layer name may be used; it
+	// becomes selectable through Config.Layers and Config.OnlySections.
+	Layers []string
+	// Run executes the check (required). Partial failures should set Error and keep Data.
+	Run func(ctx context.Context, cfg Config) SectionResult[T]
+	// Summary returns the PrettyPrint summary. Defaults to the truncated error.
+	Summary func(result SectionResult[T]) string
+	// LongDetails returns the PrettyPrint(true) block. Defaults to the error.
+	LongDetails func(result SectionResult[T]) string
+	// Metrics returns Prometheus exposition lines. Optional.
+	Metrics func(result SectionResult[T], ts int64) []string
+	// Counts returns the checked and unhealthy item counts. Defaults to a single check that is
+	// unhealthy when the result has an error.
+	Counts func(result SectionResult[T]) (total, unhealthy int)
+}
+
+// NewSection builds a Section from spec. It panics if Name or Run is missing.
+func NewSection[T any](spec SectionSpec[T]) Section {
+	if spec.Name == "" || spec.Run == nil {
+		panic("clusterhealth: SectionSpec requires Name and Run")
+	}
+	return &customSection[T]{spec: spec}
+}
+
+// CustomResult returns the typed result of a section created with NewSection.
+// The second return value is false when the section did not run or its data is not a T.
+func CustomResult[T any](report *Report, name string) (SectionResult[T], bool) {
+	raw, ok := report.Custom[name]
+	if !ok {
+		return SectionResult[T]{}, false
+	}
+	out := SectionResult[T]{Error: raw.Error}
+	if raw.Data == nil {
+		return out, true
+	}
+	if data, ok := raw.Data.(T); ok {
+		out.Data = data
+		return out, true
+	}
+	// Reports decoded from JSON hold generic maps; re-decode them into T.
+	b, err := json.Marshal(raw.Data)
+	if err != nil {
+		return out, false
+	}
+	if err := json.Unmarshal(b, &out.Data); err != nil {
+		return out, false
+	}
+	return out, true
+}
+
+type customSection[T any] struct {
+	spec SectionSpec[T]
+}
+
+func (s *customSection[T]) Name() string {
+	return s.spec.Name
+}
+
+func (s *customSection[T]) DisplayName() string {
+	if s.spec.DisplayName != "" {
+		return s.spec.DisplayName
+	}
+	return s.spec.Name
+}
+
+func (s *customSection[T]) Layers() []string {
+	return s.spec.Layers
+}
+
+func (s *customSection[T]) Run(ctx context.Context, cfg Config, report *Report) {
+	result := s.spec.Run(ctx, cfg)
+	if report.Custom == nil {
+		report.Custom = make(map[string]SectionResult[any])
+	}
+	report.Custom[s.spec.Name] = SectionResult[any]{Error: result.Error, Data: result.Data}
+}
+
+func (s *customSection[T]) result(report *Report) SectionResult[T] {
+	result, _ := CustomResult[T](report, s.spec.Name)
+	return result
+}
+
+func (s *customSection[T]) Error(report *Report) string {
+	return report.Custom[s.spec.Name].Error
+}
+
+func (s *customSection[T]) Summary(report *Report) string {
+	result := s.result(report)
+	if s.spec.Summary != nil {
+		return s.spec.Summary(result)
+	}
+	return truncate(result.Error, 60)
+}
+
+func (s *customSection[T]) LongDetails(report *Report) string {
+	result := s.result(report)
+	if s.spec.LongDetails != nil {
+		return s.spec.LongDetails(result)
+	}
+	if result.Error != "" {
+		return "  " + result.Error + "\n"
+	}
+	return ""
+}
+
+func (s *customSection[T]) Metrics(report *Report, ts int64) []string {
+	if s.spec.Metrics == nil {
+		return nil
+	}
+	if _, ok := report.Custom[s.spec.Name]; !ok {
+		return nil
+	}
+	return s.spec.Metrics(s.result(report), ts)
+}
+
+func (s *customSection[T]) Counts(report *Report) (int, int) {
+	result := s.result(report)
+	if s.spec.Counts != nil {
+		return s.spec.Counts(result)
+	}
+	if result.Error != "" {
+		return 1, 1
+	}
+	return 1, 0
+}
+
+// builtinSection adapts a built-in check, whose result lives in a typed Report field, to Section.
+type builtinSection struct {
+	name    string
+	display string
+	layers  []string
+	run     func(ctx context.Context, cfg Config, r *Report)
+	err     func(r *Report) string
+	summary func(r *Report) string
+	details func(r *Report) string
+	metrics func(r *Report, ts int64) []string
+	counts  func(r *Report) (int, int)
+}
+
+func (s *builtinSection) Name() string        { return s.name }
+func (s *builtinSection) DisplayName() string { return s.display }
+func (s *builtinSection) Layers() []string    { return s.layers }
+
+func (s *builtinSection) Run(ctx context.Context, cfg Config, report *Report) {
+	s.run(ctx, cfg, report)
+}
+
+func (s *builtinSection) Error(report *Report) string       { return s.err(report) }
+func (s *builtinSection) Summary(report *Report) string     { return s.summary(report) }
+func (s *builtinSection) LongDetails(report *Report) string { return s.details(report) }
+
+func (s *builtinSection) Metrics(report *Report, ts int64) []string {
+	if s.metrics == nil {
+		return nil
+	}
+	return s.metrics(report, ts)
+}
+
+func (s *builtinSection) Counts(report *Report) (int, int) { return s.counts(report) }
+
+// countItems returns the number of items and how many of them isUnhealthy reports.
+func countItems[T any](items []T, isUnhealthy func(T) bool) (int, int) {
+	unhealthy := 0
+	for _, item := range items {
+		if isUnhealthy(item) {
+			unhealthy++
+		}
+	}
+	return len(items), unhealthy
+}
+
+// flattenNamespaces returns the items of a per-namespace map as a single slice.
+func flattenNamespaces[T any](byNamespace map[string][]T) []T {
+	var out []T
+	for _, items := range byNamespace {
+		out = append(out, items...)
+	}
+	return out
+}
+
+// neverUnhealthy is used by sections whose items are informational (events, conditions).
+func neverUnhealthy[T any](T) bool { return false }
+
+func builtinSections() []Section {
+	return []Section{
+		&builtinSection{
+			name:    SectionNodes,
+			display: "Nodes",
+			layers:  []string{LayerInfrastructure},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Nodes = runNodesSection(ctx, cfg.Client, cfg.Namespaces)
+			},
+			err:     func(r *Report) string { return r.Nodes.Error },
+			summary: (*Report).summaryNodes,
+			details: (*Report).longDetailsNodes,
+			metrics: (*Report).exportNodes,
+			counts: func(r *Report) (int, int) {
+				return countItems(r.Nodes.Data.Nodes, func(n NodeInfo) bool { return n.UnhealthyReason != "" })
+			},
+		},
+		&builtinSection{
+			name:    SectionDeployments,
+			display: "Deployments",
+			layers:  []string{LayerWorkload},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Deployments = runDeploymentsSection(ctx, cfg.Client, cfg.Namespaces)
+			},
+			err:     func(r *Report) string { return r.Deployments.Error },
+			summary: (*Report).summaryDeployments,
+			details: (*Report).longDetailsDeployments,
+			metrics: (*Report).exportDeployments,
+			counts: func(r *Report) (int, int) {
+				return countItems(flattenNamespaces(r.Deployments.Data.ByNamespace), func(d DeploymentInfo) bool { return d.Ready < d.Replicas })
+			},
+		},
+		&builtinSection{
+			name:    SectionPods,
+			display: "Pods",
+			layers:  []string{LayerWorkload},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Pods = runPodsSection(ctx, cfg.Client, cfg.Namespaces)
+			},
+			err:     func(r *Report) string { return r.Pods.Error },
+			summary: (*Report).summaryPods,
+			details: (*Report).longDetailsPods,
+			metrics: (*Report).exportPods,
+			counts: func(r *Report) (int, int) {
+				return countItems(flattenNamespaces(r.Pods.Data.ByNamespace), func(p PodInfo) bool { return p.Phase != "Running" && p.Phase != "Succeeded" })
+			},
+		},
+		&builtinSection{
+			name:    SectionEvents,
+			display: "Events",
+			layers:  []string{LayerWorkload},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Events = runEventsSection(ctx, cfg.Client, cfg.Namespaces, r.CollectedAt)
+			},
+			err:     func(r *Report) string { return r.Events.Error },
+			summary: (*Report).summaryEvents,
+			details: (*Report).longDetailsEvents,
+			counts: func(r *Report) (int, int) {
+				return countItems(r.Events.Data.Events, neverUnhealthy[EventInfo])
+			},
+		},
+		&builtinSection{
+			name:    SectionQuotas,
+			display: "Quotas",
+			layers:  []string{LayerInfrastructure},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Quotas = runQuotasSection(ctx, cfg.Client, cfg.Namespaces)
+			},
+			err:     func(r *Report) string { return r.Quotas.Error },
+			summary: (*Report).summaryQuotas,
+			details: (*Report).longDetailsQuotas,
+			metrics: (*Report).exportQuotas,
+			counts: func(r *Report) (int, int) {
+				return countItems(flattenNamespaces(r.Quotas.Data.ByNamespace), func(q ResourceQuotaInfo) bool { return len(q.Exceeded) > 0 })
+			},
+		},
+		&builtinSection{
+			name:    SectionOperator,
+			display: "Operator",
+			layers:  []string{LayerWorkload, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Operator = runOperatorSection(ctx, cfg.Client, cfg.Operator)
+			},
+			err:     func(r *Report) string { return r.Operator.Error },
+			summary: (*Report).summaryOperator,
+			details: (*Report).longDetailsOperator,
+			counts: func(r *Report) (int, int) {
+				if d := r.Operator.Data.Deployment; d != nil && d.Ready < d.Replicas {
+					return 1, 1
+				}
+				return 1, 0
+			},
+		},
+		&builtinSection{
+			name:    SectionDSCI,
+			display: "DSCI",
+			layers:  []string{LayerWorkload, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.DSCI = runCRConditionsSection(ctx, cfg.Client, DSCInitializationGVK, cfg.DSCI)
+			},
+			err:     func(r *Report) string { return r.DSCI.Error },
+			summary: (*Report).summaryDSCI,
+			details: func(r *Report) string { return r.longDetailsCRConditions(r.DSCI.Data.Name, r.DSCI.Data.Conditions) },
+			counts: func(r *Report) (int, int) {
+				return countItems(r.DSCI.Data.Conditions, neverUnhealthy[ConditionSummary])
+			},
+		},
+		&builtinSection{
+			name:    SectionDSC,
+			display: "DSC",
+			layers:  []string{LayerWorkload, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.DSC = runCRConditionsSection(ctx, cfg.Client, DataScienceClusterGVK, cfg.DSC)
+			},
+			err:     func(r *Report) string { return r.DSC.Error },
+			summary: (*Report).summaryDSC,
+			details: func(r *Report) string { return r.longDetailsCRConditions(r.DSC.Data.Name, r.DSC.Data.Conditions) },
+			counts: func(r *Report) (int, int) {
+				return countItems(r.DSC.Data.Conditions, neverUnhealthy[ConditionSummary])
+			},
+		},
+		&builtinSection{
+			name:    SectionWebhooks,
+			display: "Webhooks",
+			layers:  []string{LayerWorkload, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Webhooks = runWebhooksSection(ctx, cfg.Client, cfg.webhookNamespaces())
+			},
+			err:     func(r *Report) string { return r.Webhooks.Error },
+			summary: (*Report).summaryWebhooks,
+			details: (*Report).longDetailsWebhooks,
+			metrics: (*Report).exportWebhooks,
+			counts: func(r *Report) (int, int) {
+				return countItems(r.Webhooks.Data.Webhooks, func(w WebhookInfo) bool { return w.UnhealthyReason != "" })
+			},
+		},
+		&builtinSection{
+			name:    SectionCRDs,
+			display: "CRDs",
+			layers:  []string{LayerInfrastructure, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.CRDs = runCRDsSection(ctx, cfg.Client, cfg.CRDGroupSuffixes)
+			},
+			err:     func(r *Report) string { return r.CRDs.Error },
+			summary: (*Report).summaryCRDs,
+			details: (*Report).longDetailsCRDs,
+			counts: func(r *Report) (int, int) {
+				return countItems(r.CRDs.Data.CRDs, func(c CRDInfo) bool { return c.UnhealthyReason != "" })
+			},
+		},
+		&builtinSection{
+			name:    SectionCertificates,
+			display: "Certificates",
+			layers:  []string{LayerWorkload, LayerOperator},
+			run: func(ctx context.Context, cfg Config, r *Report) {
+				r.Certificates = runCertificatesSection(ctx, cfg.Client, cfg.certificateNamespaces(), r.CollectedAt, cfg.CertificateExpiryDays)
+			},
+			err:     func(r *Report) string { return r.Certificates.Error },
+			summary: (*Report).summaryCertificates,
+			details: (*Report).longDetailsCertificates,
+			metrics: (*Report).exportCertificates,
+			counts: func(r *Report) (int, int) {
+				return countItems(r.Certificates.Data.Certificates, func(c CertificateInfo) bool { return c.UnhealthyReason != "" })
+			},
+		},
+	}
+}
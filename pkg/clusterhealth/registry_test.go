@@ -0,0 +1,223 @@
+//nolint:testpackage // White-box tests require access to unexported functions (e.g. sectionsToRun).
+package clusterhealth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type licensesSection struct {
+	Expired []string `json:"expired"`
+}
+
+func newLicensesSection(expired ...string) Section {
+	return NewSection(SectionSpec[licensesSection]{
+		Name:        "licenses",
+		DisplayName: "Licenses",
+		Layers:      []string{LayerInfrastructure, "compliance"},
+		Run: func(_ context.Context, _ Config) SectionResult[licensesSection] {
+			res := SectionResult[licensesSection]{Data: licensesSection{Expired: expired}}
+			if len(expired) > 0 {
+				res.Error = "expired licenses: " + strings.Join(expired, ", ")
+			}
+			return res
+		},
+		Summary: func(res SectionResult[licensesSection]) string {
+			return fmt.Sprintf("%d expired", len(res.Data.Expired))
+		},
+		Metrics: func(res SectionResult[licensesSection], ts int64) []string {
+			return []string{promLine("licenses_expired", nil, float64(len(res.Data.Expired)), ts)}
+		},
+	})
+}
+
+func TestRegistry_Register(t *testing.T) {
+	reg := NewDefaultRegistry()
+	if got := len(reg.Sections()); got != 11 {
+		t.Fatalf("NewDefaultRegistry: got %d sections, want 11", got)
+	}
+
+	if err := reg.Register(newLicensesSection()); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := reg.Register(newLicensesSection()); err == nil {
+		t.Error("Register duplicate: want error")
+	}
+	if err := reg.Register(nil); err == nil {
+		t.Error("Register nil: want error")
+	}
+
+	sections := reg.Sections()
+	if last := sections[len(sections)-1].Name(); last != "licenses" {
+		t.Errorf("last section = %q, want licenses", last)
+	}
+	if _, ok := reg.Lookup(SectionDSC); !ok {
+		t.Error("Lookup(dsc): want found")
+	}
+	if _, ok := DefaultRegistry().Lookup("licenses"); ok {
+		t.Error("registering in a new registry must not affect the default registry")
+	}
+}
+
+func TestConfig_SectionsToRun_CustomLayers(t *testing.T) {
+	reg := NewDefaultRegistry()
+	reg.MustRegister(newLicensesSection())
+	sections := reg.Sections()
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want []string
+	}{
+		{"all", Config{}, []string{SectionNodes, SectionDSC, "licenses"}},
+		{"builtin layer", Config{Layers: []string{LayerInfrastructure}}, []string{SectionNodes, SectionQuotas, "licenses"}},
+		{"new layer", Config{Layers: []string{"compliance"}}, []string{"licenses"}},
+		{"only sections by name", Config{OnlySections: []string{"licenses"}}, []string{"licenses"}},
+		{"only sections by layer", Config{OnlySections: []string{"compliance", SectionPods}}, []string{"licenses", SectionPods}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cfg.sectionsToRun(sections)
+			for _, name := range tt.want {
+				if !got[name] {
+					t.Errorf("sectionsToRun() missing %q (got %v)", name, got)
+				}
+			}
+		})
+	}
+
+	got := (&Config{Layers: []string{"compliance"}}).sectionsToRun(sections)
+	if len(got) != 1 {
+		t.Errorf("layer compliance: got %v, want only licenses", got)
+	}
+}
+
+func TestRun_CustomSection(t *testing.T) {
+	sch := scheme.Scheme
+	_ = corev1.AddToScheme(sch)
+
+	reg := NewDefaultRegistry()
+	reg.MustRegister(newLicensesSection("example-license"))
+
+	cfg := Config{
+		Client:       fake.NewClientBuilder().WithScheme(sch).Build(),
+		OnlySections: []string{SectionNodes, "licenses"},
+		Registry:     reg,
+	}
+	report, err := Run(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if want := []string{SectionNodes, "licenses"}; strings.Join(report.SectionsRun, ",") != strings.Join(want, ",") {
+		t.Errorf("SectionsRun = %v, want %v", report.SectionsRun, want)
+	}
+
+	res, ok := CustomResult[licensesSection](report, "licenses")
+	if !ok {
+		t.Fatal("CustomResult: want result for licenses")
+	}
+	if len(res.Data.Expired) != 1 || res.Error == "" {
+		t.Errorf("CustomResult = %+v, want one expired license and an error", res)
+	}
+	if report.Healthy() {
+		t.Error("Healthy() want false when a custom section fails")
+	}
+
+	out := report.PrettyPrint(true)
+	for _, want := range []string{"Licenses", statusFAIL, "1 expired", "expired licenses: example-license"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("PrettyPrint missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Deployments") {
+		t.Errorf("PrettyPrint should only show sections that ran:\n%s", out)
+	}
+
+	var names []string
+	for _, s := range report.Sections() {
+		names = append(names, s.Name())
+	}
+	if want := []string{SectionNodes, "licenses"}; strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("Sections() = %v, want %v", names, want)
+	}
+	licenses, _ := reg.Lookup("licenses")
+	if total, unhealthy := licenses.Counts(report); total != 1 || unhealthy != 1 {
+		t.Errorf("Counts() = %d, %d, want 1, 1", total, unhealthy)
+	}
+
+	report.CollectedAt = time.Unix(1710849600, 0)
+	lines := report.PrometheusExport()
+	assertContainsLine(t, lines, `licenses_expired 1 1710849600000`)
+	assertContainsLine(t, lines, `section_healthy{section="licenses"} 0 1710849600000`)
+	assertContainsLine(t, lines, `cluster_healthy 0 1710849600000`)
+}
+
+func TestCustomResult_FromJSON(t *testing.T) {
+	report := &Report{Custom: map[string]SectionResult[any]{
+		"licenses": {Data: licensesSection{Expired: []string{"a", "b"}}},
+	}}
+	b, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	res, ok := CustomResult[licensesSection](&decoded, "licenses")
+	if !ok {
+		t.Fatal("CustomResult: want result after JSON round trip")
+	}
+	if len(res.Data.Expired) != 2 {
+		t.Errorf("Expired = %v, want [a b]", res.Data.Expired)
+	}
+	if _, ok := CustomResult[licensesSection](&decoded, "missing"); ok {
+		t.Error("CustomResult(missing): want not found")
+	}
+}
+
+func TestBuiltinSection_Counts(t *testing.T) {
+	report := &Report{
+		Nodes: SectionResult[NodesSection]{Data: NodesSection{Nodes: []NodeInfo{
+			{Name: "worker-1"},
+			{Name: "worker-2", UnhealthyReason: "MemoryPressure"},
+		}}},
+		Deployments: SectionResult[DeploymentsSection]{Data: DeploymentsSection{ByNamespace: map[string][]DeploymentInfo{
+			"ns-a": {{Name: "a", Ready: 1, Replicas: 1}},
+			"ns-b": {{Name: "b", Ready: 0, Replicas: 1}, {Name: "c", Ready: 2, Replicas: 2}},
+		}}},
+		Operator: SectionResult[OperatorSection]{Data: OperatorSection{Deployment: &DeploymentInfo{Ready: 0, Replicas: 1}}},
+	}
+
+	tests := []struct {
+		section       string
+		wantTotal     int
+		wantUnhealthy int
+	}{
+		{SectionNodes, 2, 1},
+		{SectionDeployments, 3, 1},
+		{SectionOperator, 1, 1},
+		{SectionEvents, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			section, ok := DefaultRegistry().Lookup(tt.section)
+			if !ok {
+				t.Fatalf("Lookup(%s): want found", tt.section)
+			}
+			total, unhealthy := section.Counts(report)
+			if total != tt.wantTotal || unhealthy != tt.wantUnhealthy {
+				t.Errorf("Counts() = %d, %d, want %d, %d", total, unhealthy, tt.wantTotal, tt.wantUnhealthy)
+			}
+		})
+	}
+}
@@ -6,7 +6,8 @@ import (
 	"time"
 )
 
-// Run runs all health checks and returns a Report. Config.Client and namespace
+// Run runs the health checks of the configured Registry (the default registry when
+// Config.Registry is nil) and returns a Report. Config.Client and namespace
 // configuration must be set by the caller; no globals are used.
 //
 // Run always returns a non-nil Report when err is nil. It returns an error only
@@ -17,42 +18,23 @@ func Run(ctx context.Context, cfg Config) (*Report, error) {
 		return nil, errors.New("clusterhealth: client is required")
 	}
 
-	collectedAt := time.Now()
-	report := &Report{CollectedAt: collectedAt}
-	run := cfg.sectionsToRun()
+	reg := cfg.registry()
+	sections := reg.Sections()
+	report := &Report{CollectedAt: time.Now(), registry: reg}
+	run := cfg.sectionsToRun(sections)
 
 	// Record which sections ran so PrettyPrint can show only those.
-	sectionOrder := []string{SectionNodes, SectionDeployments, SectionPods, SectionEvents, SectionQuotas, SectionOperator, SectionDSCI, SectionDSC}
-	for _, name := range sectionOrder {
-		if run[name] {
-			report.SectionsRun = append(report.SectionsRun, name)
+	for _, s := range sections {
+		if run[s.Name()] {
+			report.SectionsRun = append(report.SectionsRun, s.Name())
 		}
 	}
 
 	// Run each section independently when selected; failures in one do not block others.
-	if run[SectionNodes] {
-		report.Nodes = runNodesSection(ctx, cfg.Client, cfg.Namespaces)
-	}
-	if run[SectionDeployments] {
-		report.Deployments = runDeploymentsSection(ctx, cfg.Client, cfg.Namespaces)
-	}
-	if run[SectionPods] {
-		report.Pods = runPodsSection(ctx, cfg.Client, cfg.Namespaces)
-	}
-	if run[SectionEvents] {
-		report.Events = runEventsSection(ctx, cfg.Client, cfg.Namespaces, collectedAt)
-	}
-	if run[SectionQuotas] {
-		report.Quotas = runQuotasSection(ctx, cfg.Client, cfg.Namespaces)
-	}
-	if run[SectionOperator] {
-		report.Operator = runOperatorSection(ctx, cfg.Client, cfg.Operator)
-	}
-	if run[SectionDSCI] {
-		report.DSCI = runCRConditionsSection(ctx, cfg.Client, DSCInitializationGVK, cfg.DSCI)
-	}
-	if run[SectionDSC] {
-		report.DSC = runCRConditionsSection(ctx, cfg.Client, DataScienceClusterGVK, cfg.DSC)
+	for _, s := range sections {
+		if run[s.Name()] {
+			s.Run(ctx, cfg, report)
+		}
 	}
 
 	return report, nil
@@ -1,43 +1,53 @@
 package clusterhealth
 
-// Section name constants for use in Config.OnlySections.
+// Section name constants for the built-in sections, for use in Config.OnlySections.
 const (
-	SectionNodes       = "nodes"
-	SectionDeployments = "deployments"
-	SectionPods        = "pods"
-	SectionEvents      = "events"
-	SectionQuotas      = "quotas"
-	SectionOperator    = "operator"
-	SectionDSCI        = "dsci"
-	SectionDSC         = "dsc"
+	SectionNodes        = "nodes"
+	SectionDeployments  = "deployments"
+	SectionPods         = "pods"
+	SectionEvents       = "events"
+	SectionQuotas       = "quotas"
+	SectionOperator     = "operator"
+	SectionDSCI         = "dsci"
+	SectionDSC          = "dsc"
+	SectionWebhooks     = "webhooks"
+	SectionCRDs         = "crds"
+	SectionCertificates = "certificates"
 )
 
-// Layer name constants. Layers group sections for common use cases.
-// Use Config.Layers to run only checks in one or more layers.
+// Layer name constants. Layers group sections for common use cases; each Section
+// declares the layers it belongs to. Use Config.Layers to run only checks in one or more layers.
 const (
-	// LayerInfrastructure is cluster-level health: nodes and resource quotas.
+	// LayerInfrastructure is cluster-level health: nodes, resource quotas and platform CRDs.
 	LayerInfrastructure = "infrastructure"
-	// LayerWorkload is on-cluster components: deployments, pods, events, operator, DSCI, DSC.
+	// LayerWorkload is on-cluster components: deployments, pods, events, operator, DSCI, DSC,
+	// webhooks and TLS certificates.
 	LayerWorkload = "workload"
-	// LayerOperator is operator and CRs: operator deployment, DSCI, DSC.
+	// LayerOperator is operator and CRs: operator deployment, DSCI, DSC, webhooks, CRDs and TLS certificates.
 	LayerOperator = "operator"
 )
 
-var layerSections = map[string][]string{
-	LayerInfrastructure: {SectionNodes, SectionQuotas},
-	LayerWorkload:       {SectionDeployments, SectionPods, SectionEvents, SectionOperator, SectionDSCI, SectionDSC},
-	LayerOperator:       {SectionOperator, SectionDSCI, SectionDSC},
+// layerSections maps each layer to the names of the sections that declare it, in registry order.
+func layerSections(sections []Section) map[string][]string {
+	layers := make(map[string][]string)
+	for _, s := range sections {
+		for _, layer := range s.Layers() {
+			layers[layer] = append(layers[layer], s.Name())
+		}
+	}
+	return layers
 }
 
-func (c *Config) sectionsToRun() map[string]bool {
+func (c *Config) sectionsToRun(sections []Section) map[string]bool {
+	layers := layerSections(sections)
 	if len(c.OnlySections) > 0 {
-		return sliceToSet(expandSectionList(c.OnlySections))
+		return sliceToSet(expandSectionList(c.OnlySections, layers))
 	}
 	if len(c.Layers) > 0 {
 		var list []string
 		seen := make(map[string]bool)
 		for _, layer := range c.Layers {
-			for _, s := range layerSections[layer] {
+			for _, s := range layers[layer] {
 				if !seen[s] {
 					seen[s] = true
 					list = append(list, s)
@@ -46,16 +56,17 @@ func (c *Config) sectionsToRun() map[string]bool {
 		}
 		return sliceToSet(list)
 	}
-	return sliceToSet([]string{
-		SectionNodes, SectionDeployments, SectionPods, SectionEvents,
-		SectionQuotas, SectionOperator, SectionDSCI, SectionDSC,
-	})
+	all := make([]string, 0, len(sections))
+	for _, s := range sections {
+		all = append(all, s.Name())
+	}
+	return sliceToSet(all)
 }
 
-func expandSectionList(list []string) []string {
+func expandSectionList(list []string, layers map[string][]string) []string {
 	var out []string
 	for _, name := range list {
-		if sections, ok := layerSections[name]; ok {
+		if sections, ok := layers[name]; ok {
 			out = append(out, sections...)
 		} else {
 			out = append(out, name)
@@ -38,14 +38,51 @@ type Report struct {
 	// Empty or nil means all sections were run. Used by PrettyPrint to show only those rows.
 	SectionsRun []string `json:"sectionsRun,omitempty"`
 
-	Nodes       SectionResult[NodesSection]        `json:"nodes"`
-	Deployments SectionResult[DeploymentsSection]  `json:"deployments"`
-	Pods        SectionResult[PodsSection]         `json:"pods"`
-	Events      SectionResult[EventsSection]       `json:"events"`
-	Quotas      SectionResult[QuotasSection]       `json:"quotas"`
-	Operator    SectionResult[OperatorSection]     `json:"operator"`
-	DSCI        SectionResult[CRConditionsSection] `json:"dsci"`
-	DSC         SectionResult[CRConditionsSection] `json:"dsc"`
+	Nodes        SectionResult[NodesSection]        `json:"nodes"`
+	Deployments  SectionResult[DeploymentsSection]  `json:"deployments"`
+	Pods         SectionResult[PodsSection]         `json:"pods"`
+	Events       SectionResult[EventsSection]       `json:"events"`
+	Quotas       SectionResult[QuotasSection]       `json:"quotas"`
+	Operator     SectionResult[OperatorSection]     `json:"operator"`
+	DSCI         SectionResult[CRConditionsSection] `json:"dsci"`
+	DSC          SectionResult[CRConditionsSection] `json:"dsc"`
+	Webhooks     SectionResult[WebhooksSection]     `json:"webhooks"`
+	CRDs         SectionResult[CRDsSection]         `json:"crds"`
+	Certificates SectionResult[CertificatesSection] `json:"certificates"`
+
+	// Custom holds the results of sections created with NewSection, keyed by section name.
+	Custom map[string]SectionResult[any] `json:"custom,omitempty"`
+
+	// registry is the registry the report was built from; nil means DefaultRegistry().
+	registry *Registry
+}
+
+// sections returns the sections used to render, export and evaluate the report.
+func (r *Report) sections() []Section {
+	if r.registry != nil {
+		return r.registry.Sections()
+	}
+	return DefaultRegistry().Sections()
+}
+
+// Sections returns the sections that ran for this report, in registration order. When
+// SectionsRun is empty, all sections of the registry the report was built from are returned.
+func (r *Report) Sections() []Section {
+	all := r.sections()
+	if len(r.SectionsRun) == 0 {
+		return all
+	}
+	ran := make(map[string]bool, len(r.SectionsRun))
+	for _, name := range r.SectionsRun {
+		ran[name] = true
+	}
+	out := make([]Section, 0, len(r.SectionsRun))
+	for _, s := range all {
+		if ran[s.Name()] {
+			out = append(out, s)
+		}
+	}
+	return out
 }
 
 type NodesSection struct {
@@ -169,15 +206,62 @@ type CRConditionsSection struct {
 	Data       *unstructured.Unstructured `json:"data,omitempty"` // raw CR for tests or fields we don't parse
 }
 
+type WebhooksSection struct {
+	Webhooks []WebhookInfo `json:"webhooks"`
+}
+
+// WebhookInfo describes one webhook of a Validating/MutatingWebhookConfiguration served from a platform namespace.
+type WebhookInfo struct {
+	Kind            string `json:"kind"` // ValidatingWebhookConfiguration or MutatingWebhookConfiguration
+	Configuration   string `json:"configuration"`
+	Name            string `json:"name"`
+	Service         string `json:"service"` // namespace/name
+	FailurePolicy   string `json:"failurePolicy"`
+	EndpointsReady  int    `json:"endpointsReady"`
+	ServingSecret   string `json:"servingSecret,omitempty"`   // namespace/name, when the Service names its serving secret
+	CABundleMatches *bool  `json:"caBundleMatches,omitempty"` // nil when the serving secret is unknown
+	UnhealthyReason string `json:"unhealthyReason,omitempty"`
+}
+
+type CRDsSection struct {
+	CRDs []CRDInfo `json:"crds"`
+}
+
+type CRDInfo struct {
+	Name            string             `json:"name"`
+	Group           string             `json:"group"`
+	Conditions      []ConditionSummary `json:"conditions"` // Established and NamesAccepted
+	ServedVersions  []string           `json:"servedVersions"`
+	StoredVersions  []string           `json:"storedVersions"`
+	UnhealthyReason string             `json:"unhealthyReason,omitempty"`
+}
+
+type CertificatesSection struct {
+	Certificates []CertificateInfo `json:"certificates"`
+}
+
+// CertificateInfo describes the leaf certificate of a kubernetes.io/tls secret.
+type CertificateInfo struct {
+	Namespace       string    `json:"namespace"`
+	Name            string    `json:"name"` // secret name
+	Subject         string    `json:"subject,omitempty"`
+	NotAfter        time.Time `json:"notAfter,omitzero"`
+	DaysToExpiry    int       `json:"daysToExpiry"` // negative once expired
+	UnhealthyReason string    `json:"unhealthyReason,omitempty"`
+}
+
 // Healthy returns true if the report has no section errors (all checks succeeded or returned partial data without a fatal error).
 // Used by CLI to decide exit code.
 func (r *Report) Healthy() bool {
-	return r.Nodes.Error == "" &&
-		r.Deployments.Error == "" &&
-		r.Pods.Error == "" &&
-		r.Events.Error == "" &&
-		r.Quotas.Error == "" &&
-		r.Operator.Error == "" &&
-		r.DSCI.Error == "" &&
-		r.DSC.Error == ""
+	for _, s := range r.sections() {
+		if s.Error(r) != "" {
+			return false
+		}
+	}
+	for _, result := range r.Custom {
+		if result.Error != "" {
+			return false
+		}
+	}
+	return true
 }
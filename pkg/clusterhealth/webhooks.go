@@ -0,0 +1,274 @@
+package clusterhealth
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// servingCertSecretAnnotations are the Service annotations (OpenShift service CA) naming the
+// secret that holds the serving certificate of a webhook Service.
+var servingCertSecretAnnotations = []string{
+	"service.beta.openshift.io/serving-cert-secret-name",
+	"service.alpha.openshift.io/serving-cert-secret-name",
+}
+
+// webhookEntry is the part of a validating or mutating webhook the section checks.
+type webhookEntry struct {
+	name          string
+	clientConfig  admissionregistrationv1.WebhookClientConfig
+	failurePolicy *admissionregistrationv1.FailurePolicyType
+}
+
+// runWebhooksSection checks the Validating/MutatingWebhookConfigurations whose webhooks are served
+// from a platform namespace: the Service must have ready endpoints when failurePolicy is Fail, and
+// the caBundle must be set and, when the serving secret is known, must verify its certificate.
+func runWebhooksSection(ctx context.Context, c client.Client, namespaces []string) SectionResult[WebhooksSection] {
+	var out SectionResult[WebhooksSection]
+	out.Data.Webhooks = []WebhookInfo{}
+
+	if len(namespaces) == 0 {
+		return out
+	}
+	platform := sliceToSet(namespaces)
+
+	var errs []string
+	checker := &webhookServiceChecker{c: c, endpoints: map[types.NamespacedName]endpointsResult{}, certs: map[types.NamespacedName]certResult{}}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := c.List(ctx, validating); err != nil {
+		errs = append(errs, fmt.Sprintf("list ValidatingWebhookConfigurations: %v", err))
+	}
+	for i := range validating.Items {
+		cfg := &validating.Items[i]
+		entries := make([]webhookEntry, 0, len(cfg.Webhooks))
+		for _, wh := range cfg.Webhooks {
+			entries = append(entries, webhookEntry{name: wh.Name, clientConfig: wh.ClientConfig, failurePolicy: wh.FailurePolicy})
+		}
+		out.Data.Webhooks = append(out.Data.Webhooks, checker.check(ctx, "ValidatingWebhookConfiguration", cfg.Name, entries, platform)...)
+	}
+
+	mutating := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := c.List(ctx, mutating); err != nil {
+		errs = append(errs, fmt.Sprintf("list MutatingWebhookConfigurations: %v", err))
+	}
+	for i := range mutating.Items {
+		cfg := &mutating.Items[i]
+		entries := make([]webhookEntry, 0, len(cfg.Webhooks))
+		for _, wh := range cfg.Webhooks {
+			entries = append(entries, webhookEntry{name: wh.Name, clientConfig: wh.ClientConfig, failurePolicy: wh.FailurePolicy})
+		}
+		out.Data.Webhooks = append(out.Data.Webhooks, checker.check(ctx, "MutatingWebhookConfiguration", cfg.Name, entries, platform)...)
+	}
+
+	var unhealthy []string
+	for _, wh := range out.Data.Webhooks {
+		if wh.UnhealthyReason != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s/%s (%s)", wh.Configuration, wh.Name, wh.UnhealthyReason))
+		}
+	}
+	if len(unhealthy) > 0 {
+		errs = append(errs, fmt.Sprintf("unhealthy webhooks: %s", strings.Join(unhealthy, ", ")))
+	}
+	if len(errs) > 0 {
+		out.Error = strings.Join(errs, "; ")
+	}
+	return out
+}
+
+type endpointsResult struct {
+	ready int
+	err   error
+}
+
+type certResult struct {
+	secret string // namespace/name of the serving secret; empty when unknown
+	cert   []byte
+	err    error
+}
+
+// webhookServiceChecker looks up endpoints and serving certificates once per Service, since
+// several webhooks usually share the same Service.
+type webhookServiceChecker struct {
+	c         client.Client
+	endpoints map[types.NamespacedName]endpointsResult
+	certs     map[types.NamespacedName]certResult
+}
+
+func (w *webhookServiceChecker) check(ctx context.Context, kind, configuration string, entries []webhookEntry, platform map[string]bool) []WebhookInfo {
+	var out []WebhookInfo
+	for _, wh := range entries {
+		svc := wh.clientConfig.Service
+		if svc == nil || !platform[svc.Namespace] {
+			continue
+		}
+		policy := admissionregistrationv1.Fail
+		if wh.failurePolicy != nil {
+			policy = *wh.failurePolicy
+		}
+		nn := types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}
+		info := WebhookInfo{
+			Kind:          kind,
+			Configuration: configuration,
+			Name:          wh.name,
+			Service:       nn.String(),
+			FailurePolicy: string(policy),
+		}
+
+		var reasons []string
+		ep := w.readyEndpoints(ctx, nn)
+		info.EndpointsReady = ep.ready
+		if ep.err != nil {
+			reasons = append(reasons, ep.err.Error())
+		} else if ep.ready == 0 && policy == admissionregistrationv1.Fail {
+			reasons = append(reasons, "no ready endpoints with failurePolicy=Fail")
+		}
+
+		if len(wh.clientConfig.CABundle) == 0 {
+			reasons = append(reasons, "empty caBundle")
+		} else if cr := w.servingCert(ctx, nn); cr.err != nil {
+			reasons = append(reasons, cr.err.Error())
+		} else if cr.secret != "" {
+			info.ServingSecret = cr.secret
+			matches := caBundleVerifiesCert(wh.clientConfig.CABundle, cr.cert) == nil
+			info.CABundleMatches = &matches
+			if !matches {
+				reasons = append(reasons, fmt.Sprintf("caBundle does not match serving secret %s", cr.secret))
+			}
+		}
+
+		info.UnhealthyReason = strings.Join(reasons, "; ")
+		out = append(out, info)
+	}
+	return out
+}
+
+func (w *webhookServiceChecker) readyEndpoints(ctx context.Context, svc types.NamespacedName) endpointsResult {
+	if res, ok := w.endpoints[svc]; ok {
+		return res
+	}
+	var res endpointsResult
+	list := &discoveryv1.EndpointSliceList{}
+	if err := w.c.List(ctx, list, client.InNamespace(svc.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: svc.Name}); err != nil {
+		res.err = fmt.Errorf("list endpoints of %s: %w", svc, err)
+	}
+	for _, slice := range list.Items {
+		for _, ep := range slice.Endpoints {
+			// A nil Ready condition means ready (see discovery.k8s.io/v1 EndpointConditions).
+			if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+				res.ready++
+			}
+		}
+	}
+	w.endpoints[svc] = res
+	return res
+}
+
+// servingCert returns the serving certificate of a Service when it names its serving secret.
+func (w *webhookServiceChecker) servingCert(ctx context.Context, svc types.NamespacedName) certResult {
+	if res, ok := w.certs[svc]; ok {
+		return res
+	}
+	res := w.fetchServingCert(ctx, svc)
+	w.certs[svc] = res
+	return res
+}
+
+func (w *webhookServiceChecker) fetchServingCert(ctx context.Context, svc types.NamespacedName) certResult {
+	service := &corev1.Service{}
+	if err := w.c.Get(ctx, svc, service); err != nil {
+		if k8serr.IsNotFound(err) {
+			return certResult{err: fmt.Errorf("service %s not found", svc)}
+		}
+		return certResult{err: fmt.Errorf("get service %s: %w", svc, err)}
+	}
+	var secretName string
+	for _, a := range servingCertSecretAnnotations {
+		if v := service.Annotations[a]; v != "" {
+			secretName = v
+			break
+		}
+	}
+	if secretName == "" {
+		return certResult{}
+	}
+	nn := types.NamespacedName{Namespace: svc.Namespace, Name: secretName}
+	secret := &corev1.Secret{}
+	if err := w.c.Get(ctx, nn, secret); err != nil {
+		if k8serr.IsNotFound(err) {
+			return certResult{err: fmt.Errorf("serving secret %s not found", nn)}
+		}
+		return certResult{err: fmt.Errorf("get serving secret %s: %w", nn, err)}
+	}
+	return certResult{secret: nn.String(), cert: secret.Data[corev1.TLSCertKey]}
+}
+
+// caBundleVerifiesCert returns nil if the leaf certificate in certPEM, possibly through the
+// intermediates that follow it, is signed by a certificate in caBundle. Only signatures are
+// checked: validity dates are reported by the certificates section, and a rotated CA may have
+// been issued after the leaf it still verifies.
+func caBundleVerifiesCert(caBundle, certPEM []byte) error {
+	roots, err := parsePEMCertificates(caBundle)
+	if err != nil {
+		return fmt.Errorf("caBundle: %w", err)
+	}
+	certs, err := parsePEMCertificates(certPEM)
+	if err != nil {
+		return err
+	}
+	cert := certs[0]
+	for range certs {
+		for _, root := range roots {
+			if bytes.Equal(cert.Raw, root.Raw) || cert.CheckSignatureFrom(root) == nil {
+				return nil
+			}
+		}
+		var parent *x509.Certificate
+		for _, intermediate := range certs[1:] {
+			if intermediate != cert && cert.CheckSignatureFrom(intermediate) == nil {
+				parent = intermediate
+				break
+			}
+		}
+		if parent == nil {
+			break
+		}
+		cert = parent
+	}
+	return errors.New("certificate is not signed by a CA in caBundle")
+}
+
+// parsePEMCertificates parses all CERTIFICATE blocks in data, leaf first.
+func parsePEMCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM certificate found")
+	}
+	return certs, nil
+}
@@ -0,0 +1,275 @@
+//nolint:testpackage // White-box tests require access to unexported functions (e.g. runWebhooksSection).
+package clusterhealth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func webhookService(namespace, name, secretName string) *corev1.Service {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	if secretName != "" {
+		svc.Annotations = map[string]string{"service.beta.openshift.io/serving-cert-secret-name": secretName}
+	}
+	return svc
+}
+
+func endpointSlice(namespace, service string, ready ...bool) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      service + "-abcde",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	for _, r := range ready {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{"10.0.0.1"},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptrTo(r)},
+		})
+	}
+	return slice
+}
+
+func validatingConfig(name, namespace, service string, caBundle []byte, policy admissionregistrationv1.FailurePolicyType) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: "v" + name + ".opendatahub.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service:  &admissionregistrationv1.ServiceReference{Namespace: namespace, Name: service},
+				CABundle: caBundle,
+			},
+			FailurePolicy:           ptrTo(policy),
+			SideEffects:             ptrTo(admissionregistrationv1.SideEffectClassNone),
+			AdmissionReviewVersions: []string{"v1"},
+		}},
+	}
+}
+
+func mutatingConfig(name, namespace, service string, caBundle []byte) *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: "m" + name + ".opendatahub.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service:  &admissionregistrationv1.ServiceReference{Namespace: namespace, Name: service},
+				CABundle: caBundle,
+			},
+			SideEffects:             ptrTo(admissionregistrationv1.SideEffectClassNone),
+			AdmissionReviewVersions: []string{"v1"},
+		}},
+	}
+}
+
+func TestRunWebhooksSection(t *testing.T) {
+	ca := newTestCA(t)
+	otherCA := newTestCA(t)
+	cert := ca.issue(t, "webhook-service.op-ns.svc", time.Now().Add(30*24*time.Hour))
+
+	healthyObjects := func() []client.Object {
+		return []client.Object{
+			webhookService("op-ns", "webhook-service", "webhook-cert"),
+			tlsSecret("op-ns", "webhook-cert", cert),
+			endpointSlice("op-ns", "webhook-service", true),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		objects       []client.Object
+		wantWebhooks  int
+		wantErrSubstr string // empty means the section must be healthy
+		check         func(t *testing.T, webhooks []WebhookInfo)
+	}{
+		{
+			name:         "no webhook configurations",
+			wantWebhooks: 0,
+		},
+		{
+			name: "healthy validating and mutating webhooks",
+			objects: append(healthyObjects(),
+				validatingConfig("dsc", "op-ns", "webhook-service", ca.pem, admissionregistrationv1.Fail),
+				mutatingConfig("dsc", "op-ns", "webhook-service", ca.pem),
+			),
+			wantWebhooks: 2,
+			check: func(t *testing.T, webhooks []WebhookInfo) {
+				t.Helper()
+				for _, wh := range webhooks {
+					if wh.CABundleMatches == nil || !*wh.CABundleMatches {
+						t.Errorf("%s CABundleMatches = %v, want true", wh.Name, wh.CABundleMatches)
+					}
+					if wh.EndpointsReady != 1 {
+						t.Errorf("%s EndpointsReady = %d, want 1", wh.Name, wh.EndpointsReady)
+					}
+					if wh.ServingSecret != "op-ns/webhook-cert" {
+						t.Errorf("%s ServingSecret = %q, want op-ns/webhook-cert", wh.Name, wh.ServingSecret)
+					}
+				}
+			},
+		},
+		{
+			name: "webhooks of other namespaces ignored",
+			objects: []client.Object{
+				validatingConfig("external", "cert-manager", "cert-manager-webhook", nil, admissionregistrationv1.Fail),
+			},
+			wantWebhooks: 0,
+		},
+		{
+			name: "no ready endpoints with failurePolicy Fail",
+			objects: []client.Object{
+				webhookService("op-ns", "webhook-service", "webhook-cert"),
+				tlsSecret("op-ns", "webhook-cert", cert),
+				endpointSlice("op-ns", "webhook-service", false),
+				validatingConfig("dsc", "op-ns", "webhook-service", ca.pem, admissionregistrationv1.Fail),
+			},
+			wantWebhooks:  1,
+			wantErrSubstr: "dsc/vdsc.opendatahub.io (no ready endpoints with failurePolicy=Fail)",
+		},
+		{
+			name: "no ready endpoints with failurePolicy Ignore",
+			objects: []client.Object{
+				webhookService("op-ns", "webhook-service", "webhook-cert"),
+				tlsSecret("op-ns", "webhook-cert", cert),
+				validatingConfig("dsc", "op-ns", "webhook-service", ca.pem, admissionregistrationv1.Ignore),
+			},
+			wantWebhooks: 1,
+			check: func(t *testing.T, webhooks []WebhookInfo) {
+				t.Helper()
+				if webhooks[0].EndpointsReady != 0 || webhooks[0].FailurePolicy != "Ignore" {
+					t.Errorf("webhook = %+v, want 0 endpoints and failurePolicy Ignore", webhooks[0])
+				}
+			},
+		},
+		{
+			name: "nil failurePolicy defaults to Fail",
+			objects: []client.Object{
+				webhookService("op-ns", "webhook-service", ""),
+				func() client.Object {
+					cfg := mutatingConfig("dsc", "op-ns", "webhook-service", ca.pem)
+					cfg.Webhooks[0].FailurePolicy = nil
+					return cfg
+				}(),
+			},
+			wantWebhooks:  1,
+			wantErrSubstr: "no ready endpoints with failurePolicy=Fail",
+		},
+		{
+			name:          "empty caBundle",
+			objects:       append(healthyObjects(), validatingConfig("dsc", "op-ns", "webhook-service", nil, admissionregistrationv1.Fail)),
+			wantWebhooks:  1,
+			wantErrSubstr: "empty caBundle",
+		},
+		{
+			name:          "caBundle from another CA",
+			objects:       append(healthyObjects(), validatingConfig("dsc", "op-ns", "webhook-service", otherCA.pem, admissionregistrationv1.Fail)),
+			wantWebhooks:  1,
+			wantErrSubstr: "caBundle does not match serving secret op-ns/webhook-cert",
+			check: func(t *testing.T, webhooks []WebhookInfo) {
+				t.Helper()
+				if webhooks[0].CABundleMatches == nil || *webhooks[0].CABundleMatches {
+					t.Errorf("CABundleMatches = %v, want false", webhooks[0].CABundleMatches)
+				}
+			},
+		},
+		{
+			name: "serving secret missing",
+			objects: []client.Object{
+				webhookService("op-ns", "webhook-service", "webhook-cert"),
+				endpointSlice("op-ns", "webhook-service", true),
+				validatingConfig("dsc", "op-ns", "webhook-service", ca.pem, admissionregistrationv1.Fail),
+			},
+			wantWebhooks:  1,
+			wantErrSubstr: "serving secret op-ns/webhook-cert not found",
+		},
+		{
+			name: "service missing",
+			objects: []client.Object{
+				validatingConfig("dsc", "op-ns", "webhook-service", ca.pem, admissionregistrationv1.Fail),
+			},
+			wantWebhooks:  1,
+			wantErrSubstr: "service op-ns/webhook-service not found",
+		},
+		{
+			name: "service without serving secret annotation skips caBundle comparison",
+			objects: []client.Object{
+				webhookService("op-ns", "webhook-service", ""),
+				endpointSlice("op-ns", "webhook-service", true, false),
+				validatingConfig("dsc", "op-ns", "webhook-service", otherCA.pem, admissionregistrationv1.Fail),
+			},
+			wantWebhooks: 1,
+			check: func(t *testing.T, webhooks []WebhookInfo) {
+				t.Helper()
+				if webhooks[0].CABundleMatches != nil {
+					t.Errorf("CABundleMatches = %v, want nil when serving secret is unknown", *webhooks[0].CABundleMatches)
+				}
+				if webhooks[0].EndpointsReady != 1 {
+					t.Errorf("EndpointsReady = %d, want 1", webhooks[0].EndpointsReady)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.objects...).Build()
+			got := runWebhooksSection(context.Background(), cl, []string{"op-ns", "apps-ns"})
+
+			if len(got.Data.Webhooks) != tt.wantWebhooks {
+				t.Fatalf("Webhooks length = %d, want %d (%+v)", len(got.Data.Webhooks), tt.wantWebhooks, got.Data.Webhooks)
+			}
+			if tt.wantErrSubstr == "" && got.Error != "" {
+				t.Errorf("Error = %q, want empty", got.Error)
+			}
+			if tt.wantErrSubstr != "" && !strings.Contains(got.Error, tt.wantErrSubstr) {
+				t.Errorf("Error = %q, want substring %q", got.Error, tt.wantErrSubstr)
+			}
+			if tt.check != nil {
+				tt.check(t, got.Data.Webhooks)
+			}
+		})
+	}
+}
+
+func TestCABundleVerifiesCert(t *testing.T) {
+	ca := newTestCA(t)
+	otherCA := newTestCA(t)
+	// Expired leaf: expiry is reported by the certificates section, not as a caBundle mismatch.
+	expired := ca.issue(t, "svc", time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name     string
+		caBundle []byte
+		cert     []byte
+		wantErr  bool
+	}{
+		{"matching CA", ca.pem, ca.issue(t, "svc", time.Now().Add(time.Hour)), false},
+		{"matching CA in bundle with other CAs", append(append([]byte{}, otherCA.pem...), ca.pem...), ca.issue(t, "svc", time.Now().Add(time.Hour)), false},
+		{"expired leaf still matches", ca.pem, expired, false},
+		{"other CA", otherCA.pem, ca.issue(t, "svc", time.Now().Add(time.Hour)), true},
+		{"garbage caBundle", []byte("garbage"), ca.issue(t, "svc", time.Now().Add(time.Hour)), true},
+		{"garbage certificate", ca.pem, []byte("garbage"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := caBundleVerifiesCert(tt.caBundle, tt.cert)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("caBundleVerifiesCert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
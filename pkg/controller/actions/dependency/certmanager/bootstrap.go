@@ -9,8 +9,20 @@
 //   - CA-backed ClusterIssuer: references the Secret that cert-manager creates for the
 //     root CA Certificate. Other components use this issuer to get their own certificates.
 //
+// The chain can be rooted elsewhere through the CertManagerConfiguration of the
+// KubernetesEngine instance:
+//
+//   - CASecret: an existing CA key pair is imported into the root CA Secret and
+//     backs the CA-backed ClusterIssuer; no self-signed issuer or root CA Certificate is created.
+//
+//   - IssuerRef: an existing issuer (e.g. ACME or Vault) is the platform issuer;
+//     no PKI resources are created.
+//
 // When the operator namespace is configured, the bootstrap
-// also creates a webhook serving Certificate issued by the CA-backed ClusterIssuer.
+// also creates a webhook serving Certificate issued by the platform issuer.
+//
+// For the SelfSigned and CASecret chains, the root CA is published in a CA bundle
+// ConfigMap and can be rotated (see rotation.go).
 
 package certmanager
 
@@ -18,30 +30,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/precondition"
 	resourcespredicates "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/env"
 )
 
-// caRootDuration is the validity period of the root CA certificate (~100 years).
-// No automatic renewal is configured; a renewal strategy is tracked as a follow-up.
+// rootCASecretPartOf is the InfrastructurePartOf label value of the root CA Secret.
+const rootCASecretPartOf = "cert-manager"
+
+// caRootDuration is the default validity period of the root CA certificate (~100 years).
+// Unless SelfSignedCAConfiguration.RenewBefore is set, cert-manager renews it after two
+// thirds of its duration.
 const caRootDuration = "876000h"
 
+// caSecretResyncInterval is how often the CA Secret imported by the CASecret chain is read again.
+// The Secret is user provided and not labelled for the cache, so it cannot be watched.
+const caSecretResyncInterval = 5 * time.Minute
+
 type monitoredCRD struct {
 	gvk          schema.GroupVersionKind
 	resourceName string
@@ -65,6 +92,7 @@ const (
 	EnvCertName        = "RHAI_CA_SECRET_NAME"
 	EnvCertManagerNS   = "RHAI_CA_SECRET_NAMESPACE"
 	EnvIstioCACertPath = "RHAI_ISTIO_CA_CERTIFICATE_PATH"
+	EnvCABundleName    = "RHAI_CA_BUNDLE_NAME"
 
 	EnvOperatorWebhookCertSecretName = "RHAI_WEBHOOK_CERT_SECRET_NAME" //nolint:gosec
 	EnvOperatorWebhookServiceName    = "RHAI_WEBHOOK_SERVICE_NAME"
@@ -107,6 +135,11 @@ type BootstrapConfig struct {
 	// issuer to get their own certificates.
 	CAIssuerName string
 
+	// CABundleName is the name of the ConfigMap, in CertManagerNamespace, that publishes the
+	// root CA, and the previous root CA during a rotation. The operator distributes its
+	// ca-bundle.crt through the odh-trusted-ca-bundle ConfigMap of every namespace.
+	CABundleName string
+
 	// OperatorCertConfig holds the configuration for the operator's webhook serving certificate.
 	// When OperatorCertConfig.Namespace is empty, no webhook Certificate is created.
 	OperatorCertConfig *OperatorCertConfig
@@ -124,7 +157,7 @@ func WithOperatorCert(namespace string) BootstrapConfigOpt {
 }
 
 // DefaultBootstrapConfig returns the standard ODH PKI bootstrap configuration.
-// Overridable fields (CAIssuerName, CertName, CertManagerNamespace) are resolved
+// Overridable fields (CAIssuerName, CertName, CertManagerNamespace, CABundleName) are resolved
 // from RHAI_* environment variables, falling back to hardcoded defaults.
 //
 // By default, Operator is nil and no webhook Certificate is created.
@@ -138,6 +171,7 @@ func DefaultBootstrapConfig(opts ...BootstrapConfigOpt) BootstrapConfig {
 		CertName:             env.GetOrDefault(EnvCertName, prefix+"-ca"),
 		CertManagerNamespace: env.GetOrDefault(EnvCertManagerNS, "cert-manager"),
 		CAIssuerName:         env.GetOrDefault(EnvCAIssuerName, prefix+"-ca-issuer"),
+		CABundleName:         env.GetOrDefault(EnvCABundleName, prefix+"-ca-bundle"),
 	}
 	for _, opt := range opts {
 		opt(&config)
@@ -145,6 +179,14 @@ func DefaultBootstrapConfig(opts ...BootstrapConfigOpt) BootstrapConfig {
 	return config
 }
 
+// PlatformIssuerRef returns the name and kind of the platform issuer that components outside of the
+// cloud manager request certificates from: the CA ClusterIssuer bootstrapped by the SelfSigned and
+// CASecret chains, or the issuer of the IssuerRef chain when RHAI_ISSUER_REF_NAME and
+// RHAI_ISSUER_REF_KIND point at it.
+func PlatformIssuerRef() (string, string) {
+	return DefaultBootstrapConfig().CAIssuerName, env.GetOrDefault(EnvIssuerRefKind, DefaultIssuerRefKind)
+}
+
 // BootstrapOperatorCertConfig returns the default operator webhook certificate configuration,
 // reading overrides from environment variables.
 func BootstrapOperatorCertConfig(namespace string) *OperatorCertConfig {
@@ -158,59 +200,293 @@ func BootstrapOperatorCertConfig(namespace string) *OperatorCertConfig {
 	}
 }
 
+// BootstrapObject identifies a PKI resource created by the bootstrap action.
+type BootstrapObject struct {
+	GVK       schema.GroupVersionKind
+	Name      string
+	Namespace string // empty for cluster-scoped resources
+}
+
+// IssuerChainConfiguration returns the issuer chain configuration of obj when it is a
+// cloud manager KubernetesEngine instance, or the default (self-signed) configuration otherwise.
+func IssuerChainConfiguration(obj any) ccmcommon.CertManagerConfiguration {
+	if ke, ok := obj.(ccmcommon.KubernetesEngineInstance); ok {
+		return ke.GetDependencies().CertManager.Configuration
+	}
+
+	return ccmcommon.CertManagerConfiguration{}
+}
+
+// ChainObjects returns the long-lived PKI resources that the bootstrap action creates for the
+// given issuer chain. The webhook serving Certificate is not part of the chain.
+func ChainObjects(config BootstrapConfig, chain ccmcommon.CertManagerConfiguration) []BootstrapObject {
+	caIssuer := BootstrapObject{GVK: gvk.CertManagerClusterIssuer, Name: config.CAIssuerName}
+	caBundle := BootstrapObject{GVK: gvk.ConfigMap, Name: config.CABundleName, Namespace: config.CertManagerNamespace}
+
+	switch chain.GetIssuerType() {
+	case ccmcommon.IssuerRefIssuerType:
+		return nil
+	case ccmcommon.CASecretIssuerType:
+		if isCASecretInPlace(config, chain.CASecret) {
+			return []BootstrapObject{caIssuer, caBundle}
+		}
+		return []BootstrapObject{
+			{GVK: gvk.Secret, Name: config.CertName, Namespace: config.CertManagerNamespace},
+			caIssuer,
+			caBundle,
+		}
+	default:
+		return []BootstrapObject{
+			{GVK: gvk.CertManagerClusterIssuer, Name: config.IssuerName},
+			{GVK: gvk.CertManagerCertificate, Name: config.CertName, Namespace: config.CertManagerNamespace},
+			caIssuer,
+			caBundle,
+		}
+	}
+}
+
+type bootstrapActionOpts struct {
+	caSecretReader client.Reader
+}
+
+// BootstrapActionOpt is a functional option for [NewBootstrapAction].
+type BootstrapActionOpt func(*bootstrapActionOpts)
+
+// WithCASecretReader sets the reader used to get the CA Secret imported by the CASecret chain.
+// It should read from the API server (e.g. the manager's APIReader), as the referenced Secret
+// is not cached. Defaults to the client of the reconciliation request.
+func WithCASecretReader(reader client.Reader) BootstrapActionOpt {
+	return func(o *bootstrapActionOpts) {
+		o.caSecretReader = reader
+	}
+}
+
 // NewBootstrapAction returns a reusable pipeline action that adds the cert-manager PKI trust
-// chain resources to the reconciliation request for deployment by the pipeline's deploy action:
+// chain resources to the reconciliation request for deployment by the pipeline's deploy action.
+// With the default SelfSigned issuer chain these are:
 //
 // - a self-signed ClusterIssuer
 // - a root CA Certificate
 // - a CA-backed ClusterIssuer
 // - (optional) a webhook serving Certificate, when Operator.Namespace is set
 //
+// The CASecret chain replaces the first two with a copy of the imported CA Secret, and the
+// IssuerRef chain only creates the webhook serving Certificate. See [ChainObjects]. The copy
+// follows the imported Secret by requeueing the reconciliation every caSecretResyncInterval.
+//
 // The action is a no-op when cert-manager CRDs (ClusterIssuer or Certificate) are absent on the cluster.
-func NewBootstrapAction(config BootstrapConfig) (actions.Fn, error) {
+func NewBootstrapAction(config BootstrapConfig, actionOpts ...BootstrapActionOpt) (actions.Fn, error) {
 	if config.OperatorCertConfig != nil && config.OperatorCertConfig.Namespace == "" {
 		return nil, errors.New("operator namespace must not be empty when operator cert config generation is set")
 	}
 
+	opts := bootstrapActionOpts{}
+	for _, opt := range actionOpts {
+		opt(&opts)
+	}
+
 	return func(ctx context.Context, rr *types.ReconciliationRequest) error {
-		hasClusterIssuer, err := cluster.HasCRD(ctx, rr.Client, gvk.CertManagerClusterIssuer)
+		hasCRDs, err := hasCertManagerCRDs(ctx, rr.Client)
 		if err != nil {
-			return fmt.Errorf("failed to check cert-manager ClusterIssuer CRD presence: %w", err)
+			return err
+		}
+		if !hasCRDs {
+			return nil
+		}
+
+		chain := IssuerChainConfiguration(rr.Instance)
+
+		reader := opts.caSecretReader
+		if reader == nil {
+			reader = rr.Client
 		}
-		hasCertificate, err := cluster.HasCRD(ctx, rr.Client, gvk.CertManagerCertificate)
+
+		resources, err := createChainResources(ctx, reader, config, chain)
 		if err != nil {
-			return fmt.Errorf("failed to check cert-manager Certificate CRD presence: %w", err)
+			return err
 		}
-		if !hasClusterIssuer || !hasCertificate {
-			return nil
+
+		if config.OperatorCertConfig != nil {
+			webhookCert, err := createWebhookCertificate(config, platformIssuerRef(config, chain))
+			if err != nil {
+				return err
+			}
+			resources = append(resources, webhookCert)
+		}
+
+		if err := rr.AddResources(resources...); err != nil {
+			return err
+		}
+
+		if chain.GetIssuerType() == ccmcommon.CASecretIssuerType && chain.CASecret != nil && !isCASecretInPlace(config, chain.CASecret) {
+			return odherrors.NewRequeueAfterError(caSecretResyncInterval)
+		}
+
+		return nil
+	}, nil
+}
+
+// hasCertManagerCRDs reports whether the cert-manager ClusterIssuer and Certificate CRDs are installed.
+func hasCertManagerCRDs(ctx context.Context, cli client.Client) (bool, error) {
+	hasClusterIssuer, err := cluster.HasCRD(ctx, cli, gvk.CertManagerClusterIssuer)
+	if err != nil {
+		return false, fmt.Errorf("failed to check cert-manager ClusterIssuer CRD presence: %w", err)
+	}
+	hasCertificate, err := cluster.HasCRD(ctx, cli, gvk.CertManagerCertificate)
+	if err != nil {
+		return false, fmt.Errorf("failed to check cert-manager Certificate CRD presence: %w", err)
+	}
+
+	return hasClusterIssuer && hasCertificate, nil
+}
+
+// createChainResources returns the PKI resources of the configured issuer chain.
+func createChainResources(
+	ctx context.Context,
+	cli client.Reader,
+	config BootstrapConfig,
+	chain ccmcommon.CertManagerConfiguration,
+) ([]client.Object, error) {
+	switch chain.GetIssuerType() {
+	case ccmcommon.IssuerRefIssuerType:
+		if chain.IssuerRef == nil {
+			return nil, errors.New("issuerRef must be set when issuerType is IssuerRef")
 		}
+		return nil, nil
 
+	case ccmcommon.CASecretIssuerType:
+		if chain.CASecret == nil {
+			return nil, errors.New("caSecret must be set when issuerType is CASecret")
+		}
+
+		resources := make([]client.Object, 0, 2)
+		if !isCASecretInPlace(config, chain.CASecret) {
+			caSecret, err := importCASecret(ctx, cli, config, chain.CASecret)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, caSecret)
+		}
+
+		caIssuer, err := createCABackedIssuer(config)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(resources, caIssuer), nil
+
+	default:
 		issuer, err := createSelfSignedIssuer(config)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		caCert, err := createRootCACertificate(config)
+		caCert, err := createRootCACertificate(config, chain.SelfSigned)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		caIssuer, err := createCABackedIssuer(config)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		resources := []client.Object{issuer, caCert, caIssuer}
+		return []client.Object{issuer, caCert, caIssuer}, nil
+	}
+}
 
-		if config.OperatorCertConfig != nil {
-			webhookCert, err := createWebhookCertificate(config)
-			if err != nil {
-				return err
-			}
-			resources = append(resources, webhookCert)
+// issuerRef identifies the issuer of a cert-manager Certificate.
+type issuerRef struct {
+	name  string
+	kind  string
+	group string
+}
+
+// platformIssuerRef returns the issuer that signs the certificates requested by the bootstrap:
+// the referenced issuer for the IssuerRef chain, the CA-backed ClusterIssuer otherwise.
+func platformIssuerRef(config BootstrapConfig, chain ccmcommon.CertManagerConfiguration) issuerRef {
+	if chain.GetIssuerType() == ccmcommon.IssuerRefIssuerType && chain.IssuerRef != nil {
+		ref := issuerRef{
+			name:  chain.IssuerRef.Name,
+			kind:  chain.IssuerRef.Kind,
+			group: chain.IssuerRef.Group,
+		}
+		if ref.kind == "" {
+			ref.kind = gvk.CertManagerClusterIssuer.Kind
 		}
+		if ref.group == "" {
+			ref.group = gvk.CertManagerClusterIssuer.Group
+		}
+		return ref
+	}
+
+	return issuerRef{
+		name:  config.CAIssuerName,
+		kind:  gvk.CertManagerClusterIssuer.Kind,
+		group: gvk.CertManagerClusterIssuer.Group,
+	}
+}
 
-		return rr.AddResources(resources...)
+// isCASecretInPlace reports whether the referenced CA Secret already is the root CA Secret
+// of the chain, in which case it is used as is instead of being imported.
+func isCASecretInPlace(config BootstrapConfig, ref *ccmcommon.CASecretReference) bool {
+	if ref == nil {
+		return false
+	}
+
+	return ref.Name == config.CertName && caSecretNamespace(config, ref) == config.CertManagerNamespace
+}
+
+func caSecretNamespace(config BootstrapConfig, ref *ccmcommon.CASecretReference) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+
+	return config.CertManagerNamespace
+}
+
+// importCASecret returns a copy of the referenced CA Secret named after the root CA, in the
+// cert-manager namespace where the CA-backed ClusterIssuer reads it. Downstream components
+// that trust the platform CA keep reading the same Secret regardless of the issuer chain.
+//
+// The referenced Secret is user provided and not labelled for the cache, so cli must read
+// from the API server.
+func importCASecret(
+	ctx context.Context,
+	cli client.Reader,
+	config BootstrapConfig,
+	ref *ccmcommon.CASecretReference,
+) (*corev1.Secret, error) {
+	source := &corev1.Secret{}
+	key := client.ObjectKey{Name: ref.Name, Namespace: caSecretNamespace(config, ref)}
+
+	if err := cli.Get(ctx, key, source); err != nil {
+		return nil, fmt.Errorf("failed to get CA Secret %s: %w", key, err)
+	}
+
+	if len(source.Data[corev1.TLSCertKey]) == 0 || len(source.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, fmt.Errorf("CA Secret %s must contain %s and %s", key, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	data := map[string][]byte{
+		corev1.TLSCertKey:       source.Data[corev1.TLSCertKey],
+		corev1.TLSPrivateKeyKey: source.Data[corev1.TLSPrivateKeyKey],
+	}
+	if ca, ok := source.Data[corev1.ServiceAccountRootCAKey]; ok {
+		data[corev1.ServiceAccountRootCAKey] = ca
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       gvk.Secret.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.CertName,
+			Namespace: config.CertManagerNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: data,
 	}, nil
 }
 
@@ -228,12 +504,14 @@ func createSelfSignedIssuer(config BootstrapConfig) (*unstructured.Unstructured,
 }
 
 // createRootCACertificate returns the root CA Certificate issued by the self-signed ClusterIssuer.
-func createRootCACertificate(config BootstrapConfig) (*unstructured.Unstructured, error) {
+// The duration and renewBefore of the certificate come from selfSigned when set.
+func createRootCACertificate(config BootstrapConfig, selfSigned *ccmcommon.SelfSignedCAConfiguration) (*unstructured.Unstructured, error) {
 	u := &unstructured.Unstructured{}
 	u.SetGroupVersionKind(gvk.CertManagerCertificate)
 	u.SetName(config.CertName)
 	u.SetNamespace(config.CertManagerNamespace)
-	if err := unstructured.SetNestedMap(u.Object, map[string]any{
+
+	spec := map[string]any{
 		"isCA":       true,
 		"commonName": config.CertName,
 		"secretName": config.CertName,
@@ -246,15 +524,30 @@ func createRootCACertificate(config BootstrapConfig) (*unstructured.Unstructured
 			"kind":  gvk.CertManagerClusterIssuer.Kind,
 			"group": gvk.CertManagerClusterIssuer.Group,
 		},
-	}, "spec"); err != nil {
+		// The cloud manager cache only holds labelled objects; the label makes the
+		// root CA Secret visible to the root CA rotation.
+		"secretTemplate": map[string]any{
+			"labels": map[string]any{
+				labels.InfrastructurePartOf: rootCASecretPartOf,
+			},
+		},
+	}
+	if selfSigned != nil && selfSigned.Duration != nil {
+		spec["duration"] = selfSigned.Duration.Duration.String()
+	}
+	if selfSigned != nil && selfSigned.RenewBefore != nil {
+		spec["renewBefore"] = selfSigned.RenewBefore.Duration.String()
+	}
+
+	if err := unstructured.SetNestedMap(u.Object, spec, "spec"); err != nil {
 		return nil, fmt.Errorf("failed to set spec on root CA Certificate: %w", err)
 	}
 	return u, nil
 }
 
 // createWebhookCertificate returns a cert-manager Certificate for the operator's webhook
-// serving TLS. It is issued by the CA-backed ClusterIssuer from the bootstrap chain.
-func createWebhookCertificate(config BootstrapConfig) (*unstructured.Unstructured, error) {
+// serving TLS. It is issued by the platform issuer of the bootstrap chain.
+func createWebhookCertificate(config BootstrapConfig, issuer issuerRef) (*unstructured.Unstructured, error) {
 	u := &unstructured.Unstructured{}
 	u.SetGroupVersionKind(gvk.CertManagerCertificate)
 	u.SetName(config.OperatorCertConfig.WebhookCertName)
@@ -266,9 +559,9 @@ func createWebhookCertificate(config BootstrapConfig) (*unstructured.Unstructure
 			fmt.Sprintf("%s.%s.svc.cluster.local", config.OperatorCertConfig.WebhookServiceName, config.OperatorCertConfig.Namespace),
 		},
 		"issuerRef": map[string]any{
-			"name":  config.CAIssuerName,
-			"kind":  gvk.CertManagerClusterIssuer.Kind,
-			"group": gvk.CertManagerClusterIssuer.Group,
+			"name":  issuer.name,
+			"kind":  issuer.kind,
+			"group": issuer.group,
 		},
 	}, "spec"); err != nil {
 		return nil, fmt.Errorf("failed to set spec on webhook Certificate: %w", err)
@@ -335,17 +628,22 @@ func crdPredicate() predicate.Predicate {
 //     so the controller reconciles when they are modified or deleted,
 //   - pre-conditions that monitor the three core cert-manager CRDs,
 //   - a bootstrap action to deploy the PKI trust chain,
-//   - a condition to set the DependenciesAvailable status.
+//   - a root CA rotation action to publish the CA bundle and rotate the root CA,
+//   - conditions to set the DependenciesAvailable and RootCAReady status.
 //
 // instanceName is the controller's singleton instance name, used to route CRD watch events
 // to the correct reconciler queue via handlers.ToNamed.
 //
 // [BootstrapConfig] is the configuration for the cert-manager PKI trust chain.
 //
+// The CA Secret imported by the CASecret chain is read through the manager's APIReader, as
+// only Secrets labelled with InfrastructurePartOf are cached. It is not watched: the bootstrap
+// action requeues the reconciliation to pick up changes.
+//
 // Use with [reconciler.ComposeWith]:
 //
-//	b.ComposeWith(certmanager.Bootstrap[*MyControllerType](instanceName, certmanager.DefaultBootstrapConfig()))
-func Bootstrap[T common.PlatformObject](instanceName string, config BootstrapConfig) func(*reconciler.ReconcilerBuilder[T]) {
+//	b.ComposeWith(certmanager.Bootstrap[*MyControllerType](mgr, instanceName, certmanager.DefaultBootstrapConfig()))
+func Bootstrap[T common.PlatformObject](mgr ctrl.Manager, instanceName string, config BootstrapConfig) func(*reconciler.ReconcilerBuilder[T]) {
 	return func(b *reconciler.ReconcilerBuilder[T]) {
 		certPredicates := []predicate.Predicate{
 			resourcespredicates.CreatedOrUpdatedOrDeletedNamedInNamespace(config.CertName, config.CertManagerNamespace),
@@ -388,7 +686,8 @@ func Bootstrap[T common.PlatformObject](instanceName string, config BootstrapCon
 					Filter:      certManagerConditionFilter,
 				}),
 			})).
-			WithActionE(NewBootstrapAction(config)).
-			WithConditions(status.ConditionDependenciesAvailable)
+			WithActionE(NewBootstrapAction(config, WithCASecretReader(mgr.GetAPIReader()))).
+			WithAction(NewRootCARotationAction(config)).
+			WithConditions(status.ConditionDependenciesAvailable, status.ConditionRootCAReady)
 	}
 }
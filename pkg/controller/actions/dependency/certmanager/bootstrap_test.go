@@ -2,8 +2,10 @@ package certmanager_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/mock"
@@ -13,11 +15,16 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	ccmcoreweavev1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/coreweave/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	certmanager "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
@@ -52,6 +59,12 @@ func TestDefaultBootstrapConfigEnvOverrides(t *testing.T) {
 			getField: func(c certmanager.BootstrapConfig) string { return c.CertManagerNamespace },
 			expected: "cert-manager",
 		},
+		{
+			name:     "defaults CABundleName when env var is unset",
+			envVar:   "",
+			getField: func(c certmanager.BootstrapConfig) string { return c.CABundleName },
+			expected: "opendatahub-ca-bundle",
+		},
 		{
 			name:     "overrides CAIssuerName from RHAI_ISSUER_REF_NAME",
 			envVar:   certmanager.EnvCAIssuerName,
@@ -73,6 +86,13 @@ func TestDefaultBootstrapConfigEnvOverrides(t *testing.T) {
 			getField: func(c certmanager.BootstrapConfig) string { return c.CertManagerNamespace },
 			expected: "custom-cert-ns",
 		},
+		{
+			name:     "overrides CABundleName from RHAI_CA_BUNDLE_NAME",
+			envVar:   certmanager.EnvCABundleName,
+			envValue: "custom-ca-bundle",
+			getField: func(c certmanager.BootstrapConfig) string { return c.CABundleName },
+			expected: "custom-ca-bundle",
+		},
 	}
 
 	for _, tc := range cases {
@@ -85,6 +105,7 @@ func TestDefaultBootstrapConfigEnvOverrides(t *testing.T) {
 			t.Setenv(certmanager.EnvCAIssuerName, "")
 			t.Setenv(certmanager.EnvCertName, "")
 			t.Setenv(certmanager.EnvCertManagerNS, "")
+			t.Setenv(certmanager.EnvCABundleName, "")
 
 			if tc.envVar != "" {
 				t.Setenv(tc.envVar, tc.envValue)
@@ -264,6 +285,9 @@ func TestBootstrapCertManagerPKI(t *testing.T) {
 		duration, _, err := unstructured.NestedString(cert.Object, "spec", "duration")
 		g.Expect(err).NotTo(HaveOccurred())
 		g.Expect(duration).To(Equal("876000h"), "spec.duration should match the configured CA validity period")
+		partOf, _, err := unstructured.NestedString(cert.Object, "spec", "secretTemplate", "labels", labels.InfrastructurePartOf)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(partOf).NotTo(BeEmpty(), "the root CA Secret should be labelled to be visible to the cloud manager cache")
 
 		// Assert CA-backed ClusterIssuer was created with the correct secret reference.
 		caIssuer, err := getClusterIssuer(ctx, cli, config.CAIssuerName)
@@ -351,6 +375,192 @@ func TestBootstrapCertManagerPKI(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 		g.Expect(rr.Resources).To(HaveLen(3), "only the 3 PKI resources should be queued")
 	})
+
+	t.Run("applies the configured root CA duration and renewBefore", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: newKubernetesEngine(ccmcommon.CertManagerConfiguration{
+			SelfSigned: &ccmcommon.SelfSignedCAConfiguration{
+				Duration:    &metav1.Duration{Duration: 87600 * time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 720 * time.Hour},
+			},
+		})}
+		action, err := certmanager.NewBootstrapAction(config)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(action(ctx, rr)).To(Succeed())
+
+		g.Expect(rr.Resources).To(HaveLen(3))
+		g.Expect(rr.Resources[1].GetKind()).To(Equal(gvk.CertManagerCertificate.Kind))
+		duration, _, err := unstructured.NestedString(rr.Resources[1].Object, "spec", "duration")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(duration).To(Equal("87600h0m0s"))
+		renewBefore, _, err := unstructured.NestedString(rr.Resources[1].Object, "spec", "renewBefore")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(renewBefore).To(Equal("720h0m0s"))
+	})
+
+	t.Run("imports the referenced CA Secret", func(t *testing.T) {
+		g := NewWithT(t)
+
+		sourceNamespace := "ca-source-" + xid.New().String()
+		createBootstrapNamespace(t, g, ctx, cli, sourceNamespace)
+		source := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "enterprise-ca", Namespace: sourceNamespace},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		}
+		g.Expect(cli.Create(ctx, source)).To(Succeed())
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: newKubernetesEngine(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.CASecretIssuerType,
+			CASecret:   &ccmcommon.CASecretReference{Name: source.Name, Namespace: sourceNamespace},
+		})}
+		action, err := certmanager.NewBootstrapAction(config)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		// The imported Secret is not watched, so the action requeues to follow its changes.
+		var requeueErr odherrors.RequeueAfterError
+		g.Expect(errors.As(action(ctx, rr), &requeueErr)).To(BeTrue())
+		g.Expect(requeueErr.After).To(BeNumerically(">", 0))
+
+		g.Expect(rr.Resources).To(HaveLen(2), "the imported CA Secret and the CA-backed ClusterIssuer should be queued")
+		g.Expect(rr.Resources[0].GetKind()).To(Equal(gvk.Secret.Kind))
+		g.Expect(rr.Resources[0].GetName()).To(Equal(config.CertName))
+		g.Expect(rr.Resources[0].GetNamespace()).To(Equal(config.CertManagerNamespace))
+		key, _, err := unstructured.NestedString(rr.Resources[0].Object, "data", corev1.TLSPrivateKeyKey)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(key).NotTo(BeEmpty())
+		g.Expect(rr.Resources[1].GetName()).To(Equal(config.CAIssuerName))
+	})
+
+	t.Run("reads the referenced CA Secret through the CA Secret reader", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// The source Secret only exists in the reader, as an unlabelled Secret is not in the cache.
+		reader, err := fakeclient.New(fakeclient.WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "uncached-ca", Namespace: config.CertManagerNamespace},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: newKubernetesEngine(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.CASecretIssuerType,
+			CASecret:   &ccmcommon.CASecretReference{Name: "uncached-ca"},
+		})}
+		action, err := certmanager.NewBootstrapAction(config, certmanager.WithCASecretReader(reader))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		var requeueErr odherrors.RequeueAfterError
+		g.Expect(errors.As(action(ctx, rr), &requeueErr)).To(BeTrue())
+
+		g.Expect(rr.Resources).To(HaveLen(2))
+		g.Expect(rr.Resources[0].GetKind()).To(Equal(gvk.Secret.Kind))
+		g.Expect(rr.Resources[0].GetName()).To(Equal(config.CertName))
+	})
+
+	t.Run("fails when the referenced CA Secret has no key pair", func(t *testing.T) {
+		g := NewWithT(t)
+
+		source := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "incomplete-ca-" + xid.New().String(), Namespace: config.CertManagerNamespace},
+			Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert")},
+		}
+		g.Expect(cli.Create(ctx, source)).To(Succeed())
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: newKubernetesEngine(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.CASecretIssuerType,
+			CASecret:   &ccmcommon.CASecretReference{Name: source.Name},
+		})}
+		action, err := certmanager.NewBootstrapAction(config)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(action(ctx, rr)).To(MatchError(ContainSubstring("must contain tls.crt and tls.key")))
+	})
+
+	t.Run("creates no PKI resources with an issuer reference", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: newKubernetesEngine(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.IssuerRefIssuerType,
+			IssuerRef:  &ccmcommon.IssuerReference{Name: "letsencrypt"},
+		})}
+		action, err := certmanager.NewBootstrapAction(config)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(action(ctx, rr)).To(Succeed())
+		g.Expect(rr.Resources).To(BeEmpty())
+	})
+}
+
+// newKubernetesEngine returns a cloud manager KubernetesEngine instance with the given issuer chain.
+func newKubernetesEngine(chain ccmcommon.CertManagerConfiguration) *ccmcoreweavev1alpha1.CoreWeaveKubernetesEngine {
+	return &ccmcoreweavev1alpha1.CoreWeaveKubernetesEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: ccmcoreweavev1alpha1.CoreWeaveKubernetesEngineInstanceName},
+		Spec: ccmcoreweavev1alpha1.CoreWeaveKubernetesEngineSpec{
+			Dependencies: ccmcommon.Dependencies{
+				CertManager: ccmcommon.CertManagerDependency{Configuration: chain},
+			},
+		},
+	}
+}
+
+// TestChainObjects verifies the PKI resources reported for each issuer chain.
+func TestChainObjects(t *testing.T) {
+	config := certmanager.DefaultBootstrapConfig()
+
+	selfSignedIssuer := certmanager.BootstrapObject{GVK: gvk.CertManagerClusterIssuer, Name: config.IssuerName}
+	rootCA := certmanager.BootstrapObject{GVK: gvk.CertManagerCertificate, Name: config.CertName, Namespace: config.CertManagerNamespace}
+	rootCASecret := certmanager.BootstrapObject{GVK: gvk.Secret, Name: config.CertName, Namespace: config.CertManagerNamespace}
+	caIssuer := certmanager.BootstrapObject{GVK: gvk.CertManagerClusterIssuer, Name: config.CAIssuerName}
+	caBundle := certmanager.BootstrapObject{GVK: gvk.ConfigMap, Name: config.CABundleName, Namespace: config.CertManagerNamespace}
+
+	cases := []struct {
+		name     string
+		chain    ccmcommon.CertManagerConfiguration
+		expected []certmanager.BootstrapObject
+	}{
+		{
+			name:     "self-signed by default",
+			chain:    ccmcommon.CertManagerConfiguration{},
+			expected: []certmanager.BootstrapObject{selfSignedIssuer, rootCA, caIssuer, caBundle},
+		},
+		{
+			name: "imported CA Secret",
+			chain: ccmcommon.CertManagerConfiguration{
+				IssuerType: ccmcommon.CASecretIssuerType,
+				CASecret:   &ccmcommon.CASecretReference{Name: "enterprise-ca", Namespace: "pki"},
+			},
+			expected: []certmanager.BootstrapObject{rootCASecret, caIssuer, caBundle},
+		},
+		{
+			name: "CA Secret already in place",
+			chain: ccmcommon.CertManagerConfiguration{
+				IssuerType: ccmcommon.CASecretIssuerType,
+				CASecret:   &ccmcommon.CASecretReference{Name: config.CertName},
+			},
+			expected: []certmanager.BootstrapObject{caIssuer, caBundle},
+		},
+		{
+			name: "issuer reference",
+			chain: ccmcommon.CertManagerConfiguration{
+				IssuerType: ccmcommon.IssuerRefIssuerType,
+				IssuerRef:  &ccmcommon.IssuerReference{Name: "letsencrypt"},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(certmanager.ChainObjects(config, tc.chain)).To(Equal(tc.expected))
+		})
+	}
 }
 
 // TestBootstrapWebhookCertificate verifies that NewBootstrapAction creates the operator webhook
@@ -0,0 +1,496 @@
+// This file rotates the root CA of the bootstrapped cert-manager PKI.
+//
+// The root CA is published in a CA bundle ConfigMap in the cert-manager namespace, which the
+// operator adds to the odh-trusted-ca-bundle ConfigMap of every namespace (see the
+// certconfigmapgenerator service). When the root CA changes, either because a rotation was
+// requested or because cert-manager renewed it, the bundle publishes both the previous and the
+// new CA during an overlap window:
+//
+//  1. Issuing: the root CA Secret is deleted so that cert-manager issues a new root CA.
+//     A rotation is requested through the RotateRootCAAnnotation on the KubernetesEngine
+//     instance or once the root CA is older than SelfSignedCAConfiguration.MaxAge.
+//
+//  2. Propagation: the bundle publishes both root CAs while the leaf certificates are still
+//     issued from the previous one, so that consumers trust the new root CA before it is used.
+//
+//  3. Re-issuing leaf certificates: halfway through the overlap window, Certificates issued by
+//     the CA-backed ClusterIssuer before the new root CA existed, such as the webhook serving
+//     Certificate, are renewed.
+//
+//  4. Overlap: the previous root CA stays in the bundle until the overlap window ends, so that
+//     consumers that still hold leaf certificates issued from it keep trusting them.
+//
+// The rotation state is stored on the CA bundle ConfigMap itself, so that the action is
+// driven by cluster state only.
+
+package certmanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// CABundleKey holds the trusted root CAs: the current root CA and, during a rotation
+	// overlap window, the previous one.
+	CABundleKey = "ca-bundle.crt"
+
+	// currentCAKey holds the current root CA of the CA bundle ConfigMap.
+	currentCAKey = "ca.crt"
+
+	// previousCAKey holds the previous root CA during a rotation overlap window.
+	previousCAKey = "previous-ca.crt"
+
+	// rotationTriggerAnnotation records the last RotateRootCAAnnotation value that was handled.
+	rotationTriggerAnnotation = labels.ODHInfrastructurePrefix + "/root-ca-rotation-trigger"
+
+	// reissueAfterAnnotation records when the leaf certificates are re-issued from the new root CA (RFC3339).
+	reissueAfterAnnotation = labels.ODHInfrastructurePrefix + "/root-ca-reissue-after"
+
+	// retireAfterAnnotation records when the previous root CA is retired from the bundle (RFC3339).
+	retireAfterAnnotation = labels.ODHInfrastructurePrefix + "/root-ca-retire-after"
+
+	// defaultRotationOverlap is how long the previous root CA stays in the bundle by default.
+	defaultRotationOverlap = 7 * 24 * time.Hour
+
+	// leafReissueCheckInterval is how often the re-issue of leaf certificates is checked.
+	leafReissueCheckInterval = 30 * time.Second
+)
+
+// rootCAState is the rotation state of the root CA, stored on the CA bundle ConfigMap.
+type rootCAState struct {
+	current      string
+	previous     string
+	reissueAfter time.Time
+	retireAfter  time.Time
+	trigger      string
+}
+
+// NewRootCARotationAction returns a pipeline action that publishes the root CA in the CA bundle
+// ConfigMap and rotates the root CA of the SelfSigned issuer chain. For the CASecret chain, the
+// bundle follows the imported CA and replacing it goes through the same overlap window. The
+// IssuerRef chain has no root CA managed by the operator.
+//
+// Progress is reported in the RootCAReady condition.
+func NewRootCARotationAction(config BootstrapConfig) actions.Fn {
+	return func(ctx context.Context, rr *types.ReconciliationRequest) error {
+		hasCRDs, err := hasCertManagerCRDs(ctx, rr.Client)
+		if err != nil {
+			return err
+		}
+		if !hasCRDs {
+			rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+				conditions.WithReason(status.MissingOperatorReason),
+				conditions.WithMessage("cert-manager CRDs are not installed"),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+			return nil
+		}
+
+		chain := IssuerChainConfiguration(rr.Instance)
+		if chain.GetIssuerType() == ccmcommon.IssuerRefIssuerType {
+			rr.Conditions.MarkTrue(status.ConditionRootCAReady,
+				conditions.WithMessage("the root CA is managed by the referenced issuer"),
+			)
+			return nil
+		}
+
+		return rotateRootCA(ctx, rr, config, chain, time.Now())
+	}
+}
+
+// rotateRootCA advances the root CA rotation and adds the CA bundle ConfigMap to the
+// reconciliation request.
+func rotateRootCA(
+	ctx context.Context,
+	rr *types.ReconciliationRequest,
+	config BootstrapConfig,
+	chain ccmcommon.CertManagerConfiguration,
+	now time.Time,
+) error {
+	l := logf.FromContext(ctx).WithName("rootCARotation")
+
+	bundle, found, err := getCABundle(ctx, rr.Client, config)
+	if err != nil {
+		return err
+	}
+
+	state := newRootCAState(bundle)
+	trigger := rr.Instance.GetAnnotations()[ccmcommon.RotateRootCAAnnotation]
+
+	caCert, caPEM, err := getRootCA(ctx, rr.Client, config)
+	if err != nil {
+		return err
+	}
+
+	if caCert == nil {
+		// The root CA is being issued: keep publishing the known CAs until it is available.
+		if !found {
+			state.trigger = trigger
+		}
+		rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+			conditions.WithReason(status.RootCAIssuingReason),
+			conditions.WithMessage("waiting for root CA Secret %s/%s", config.CertManagerNamespace, config.CertName),
+			conditions.WithSeverity(common.ConditionSeverityInfo),
+		)
+		return addCABundle(rr, config, state)
+	}
+
+	if !found {
+		// A root CA that was issued before the bundle existed is not rotated on an old request.
+		state.trigger = trigger
+	}
+
+	if state.current != "" && state.current != caPEM {
+		l.Info("root CA changed, keeping the previous root CA in the CA bundle", "name", config.CertName)
+		overlap := rotationOverlap(chain)
+		state.previous = state.current
+		state.reissueAfter = now.Add(overlap / 2)
+		state.retireAfter = now.Add(overlap)
+	}
+	state.current = caPEM
+
+	if state.previous == "" && chain.GetIssuerType() == ccmcommon.SelfSignedIssuerType {
+		if reason := rotationReason(chain, caCert, state.trigger, trigger, now); reason != "" {
+			l.Info("rotating root CA", "name", config.CertName, "reason", reason)
+
+			if err := deleteRootCASecret(ctx, rr.Client, config); err != nil {
+				return err
+			}
+
+			state.trigger = trigger
+			rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+				conditions.WithReason(status.RootCAIssuingReason),
+				conditions.WithMessage("issuing a new root CA: %s", reason),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+			return addCABundle(rr, config, state)
+		}
+	}
+
+	var requeueAfter time.Duration
+
+	if state.previous != "" {
+		pending := 0
+		if !now.Before(state.reissueAfter) {
+			if pending, err = reissueLeafCertificates(ctx, rr.Client, config, caCert.NotBefore); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case now.Before(state.reissueAfter):
+			rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+				conditions.WithReason(status.RootCARotationOverlapReason),
+				conditions.WithMessage("leaf certificates are re-issued from the new root CA at %s", state.reissueAfter.Format(time.RFC3339)),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+			requeueAfter = state.reissueAfter.Sub(now)
+		case pending > 0:
+			rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+				conditions.WithReason(status.RootCAReissuingLeafCertificatesReason),
+				conditions.WithMessage("%d leaf certificates pending re-issue from the new root CA", pending),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+			requeueAfter = leafReissueCheckInterval
+		case now.Before(state.retireAfter):
+			rr.Conditions.MarkFalse(status.ConditionRootCAReady,
+				conditions.WithReason(status.RootCARotationOverlapReason),
+				conditions.WithMessage("the previous root CA is retired at %s", state.retireAfter.Format(time.RFC3339)),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+			requeueAfter = state.retireAfter.Sub(now)
+		default:
+			l.Info("retiring the previous root CA from the CA bundle", "name", config.CertName)
+			state.previous = ""
+			state.reissueAfter = time.Time{}
+			state.retireAfter = time.Time{}
+		}
+	}
+
+	if state.previous == "" {
+		rr.Conditions.MarkTrue(status.ConditionRootCAReady,
+			conditions.WithMessage("root CA issued at %s", caCert.NotBefore.Format(time.RFC3339)),
+		)
+
+		if maxAge := rootCAMaxAge(chain); maxAge > 0 && chain.GetIssuerType() == ccmcommon.SelfSignedIssuerType {
+			requeueAfter = caCert.NotBefore.Add(maxAge).Sub(now)
+		}
+	}
+
+	if err := addCABundle(rr, config, state); err != nil {
+		return err
+	}
+
+	if requeueAfter > 0 {
+		return odherrors.NewRequeueAfterError(requeueAfter)
+	}
+
+	return nil
+}
+
+// rotationReason returns why the root CA must be rotated, or an empty string when it must not.
+func rotationReason(
+	chain ccmcommon.CertManagerConfiguration,
+	caCert *x509.Certificate,
+	handledTrigger string,
+	trigger string,
+	now time.Time,
+) string {
+	if trigger != "" && trigger != handledTrigger {
+		return fmt.Sprintf("requested through the %s annotation", ccmcommon.RotateRootCAAnnotation)
+	}
+
+	if maxAge := rootCAMaxAge(chain); maxAge > 0 && !now.Before(caCert.NotBefore.Add(maxAge)) {
+		return fmt.Sprintf("the root CA is older than %s", maxAge)
+	}
+
+	return ""
+}
+
+func rootCAMaxAge(chain ccmcommon.CertManagerConfiguration) time.Duration {
+	if chain.SelfSigned == nil || chain.SelfSigned.MaxAge == nil {
+		return 0
+	}
+
+	return chain.SelfSigned.MaxAge.Duration
+}
+
+func rotationOverlap(chain ccmcommon.CertManagerConfiguration) time.Duration {
+	if chain.SelfSigned == nil || chain.SelfSigned.RotationOverlap == nil {
+		return defaultRotationOverlap
+	}
+
+	return chain.SelfSigned.RotationOverlap.Duration
+}
+
+// getCABundle returns the CA bundle ConfigMap and whether it exists.
+func getCABundle(ctx context.Context, cli client.Client, config BootstrapConfig) (*corev1.ConfigMap, bool, error) {
+	bundle := &corev1.ConfigMap{}
+	key := client.ObjectKey{Name: config.CABundleName, Namespace: config.CertManagerNamespace}
+
+	err := cli.Get(ctx, key, bundle)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("failed to get CA bundle ConfigMap %s: %w", key, err)
+	}
+
+	return bundle, true, nil
+}
+
+func newRootCAState(bundle *corev1.ConfigMap) rootCAState {
+	if bundle == nil {
+		return rootCAState{}
+	}
+
+	state := rootCAState{
+		current:  bundle.Data[currentCAKey],
+		previous: bundle.Data[previousCAKey],
+		trigger:  bundle.Annotations[rotationTriggerAnnotation],
+	}
+	if t, err := time.Parse(time.RFC3339, bundle.Annotations[reissueAfterAnnotation]); err == nil {
+		state.reissueAfter = t
+	}
+	if t, err := time.Parse(time.RFC3339, bundle.Annotations[retireAfterAnnotation]); err == nil {
+		state.retireAfter = t
+	}
+
+	return state
+}
+
+// getRootCA returns the root CA certificate of the root CA Secret and the PEM published as
+// trusted root CA, or nil when the Secret does not exist yet. The CA of the chain (ca.crt) is
+// published when present, so that an imported intermediate CA is trusted through its root.
+func getRootCA(ctx context.Context, cli client.Client, config BootstrapConfig) (*x509.Certificate, string, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Name: config.CertName, Namespace: config.CertManagerNamespace}
+
+	if err := cli.Get(ctx, key, secret); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("failed to get root CA Secret %s: %w", key, err)
+	}
+
+	if len(secret.Data[corev1.TLSCertKey]) == 0 {
+		return nil, "", nil
+	}
+
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return nil, "", fmt.Errorf("root CA Secret %s does not contain a PEM certificate in %s", key, corev1.TLSCertKey)
+	}
+
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse root CA certificate of Secret %s: %w", key, err)
+	}
+
+	caPEM := secret.Data[corev1.ServiceAccountRootCAKey]
+	if len(bytes.TrimSpace(caPEM)) == 0 {
+		caPEM = secret.Data[corev1.TLSCertKey]
+	}
+
+	return caCert, string(bytes.TrimSpace(caPEM)) + "\n", nil
+}
+
+// deleteRootCASecret deletes the root CA Secret so that cert-manager issues a new root CA
+// with a new private key.
+func deleteRootCASecret(ctx context.Context, cli client.Client, config BootstrapConfig) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.CertName,
+			Namespace: config.CertManagerNamespace,
+		},
+	}
+
+	if err := cli.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to delete root CA Secret %s/%s: %w", config.CertManagerNamespace, config.CertName, err)
+	}
+
+	return nil
+}
+
+// reissueLeafCertificates renews the Certificates issued by the CA-backed ClusterIssuer before
+// the current root CA was issued. It returns the number of Certificates not yet issued from the
+// current root CA.
+//
+// Only the Certificates visible to the operator are considered, i.e. the ones deployed by the
+// cloud manager such as the webhook serving Certificate.
+func reissueLeafCertificates(ctx context.Context, cli client.Client, config BootstrapConfig, caNotBefore time.Time) (int, error) {
+	certs := &unstructured.UnstructuredList{}
+	certs.SetGroupVersionKind(gvk.CertManagerCertificate.GroupVersion().WithKind(gvk.CertManagerCertificate.Kind + "List"))
+
+	if err := cli.List(ctx, certs); err != nil {
+		return 0, fmt.Errorf("failed to list cert-manager Certificates: %w", err)
+	}
+
+	pending := 0
+
+	for i := range certs.Items {
+		cert := &certs.Items[i]
+
+		issuerName, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+		issuerKind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+		if issuerName != config.CAIssuerName || issuerKind != gvk.CertManagerClusterIssuer.Kind {
+			continue
+		}
+
+		if isIssuedSince(cert, caNotBefore) {
+			continue
+		}
+
+		pending++
+
+		if err := renewCertificate(ctx, cli, cert); err != nil {
+			return 0, err
+		}
+	}
+
+	return pending, nil
+}
+
+// isIssuedSince reports whether the certificate of a Certificate was issued at or after t.
+func isIssuedSince(cert *unstructured.Unstructured, t time.Time) bool {
+	notBefore, _, _ := unstructured.NestedString(cert.Object, "status", "notBefore")
+
+	issuedAt, err := time.Parse(time.RFC3339, notBefore)
+	if err != nil {
+		return false
+	}
+
+	return !issuedAt.Before(t)
+}
+
+// renewCertificate triggers the re-issue of a Certificate the way `cmctl renew` does, by setting
+// its Issuing condition. Certificates already being issued are left untouched.
+func renewCertificate(ctx context.Context, cli client.Client, cert *unstructured.Unstructured) error {
+	conds, _, err := unstructured.NestedSlice(cert.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("failed to read conditions of Certificate %s/%s: %w", cert.GetNamespace(), cert.GetName(), err)
+	}
+
+	for _, c := range conds {
+		if m, ok := c.(map[string]any); ok && m["type"] == "Issuing" && m["status"] == string(metav1.ConditionTrue) {
+			return nil
+		}
+	}
+
+	conds = append(conds, map[string]any{
+		"type":               "Issuing",
+		"status":             string(metav1.ConditionTrue),
+		"reason":             "ManuallyTriggered",
+		"message":            "Certificate re-issuance triggered by the root CA rotation",
+		"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+		"observedGeneration": cert.GetGeneration(),
+	})
+
+	if err := unstructured.SetNestedSlice(cert.Object, conds, "status", "conditions"); err != nil {
+		return fmt.Errorf("failed to set conditions of Certificate %s/%s: %w", cert.GetNamespace(), cert.GetName(), err)
+	}
+
+	if err := cli.Status().Update(ctx, cert); err != nil {
+		return fmt.Errorf("failed to renew Certificate %s/%s: %w", cert.GetNamespace(), cert.GetName(), err)
+	}
+
+	return nil
+}
+
+// addCABundle adds the CA bundle ConfigMap holding the given rotation state to the reconciliation request.
+func addCABundle(rr *types.ReconciliationRequest, config BootstrapConfig, state rootCAState) error {
+	if state.current == "" {
+		return nil
+	}
+
+	annotations := map[string]string{
+		rotationTriggerAnnotation: state.trigger,
+	}
+
+	data := map[string]string{
+		currentCAKey: state.current,
+		CABundleKey:  state.current,
+	}
+
+	if state.previous != "" {
+		data[previousCAKey] = state.previous
+		data[CABundleKey] = state.current + state.previous
+		annotations[reissueAfterAnnotation] = state.reissueAfter.UTC().Format(time.RFC3339)
+		annotations[retireAfterAnnotation] = state.retireAfter.UTC().Format(time.RFC3339)
+	}
+
+	return rr.AddResources(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       gvk.ConfigMap.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        config.CABundleName,
+			Namespace:   config.CertManagerNamespace,
+			Annotations: annotations,
+		},
+		Data: data,
+	})
+}
@@ -0,0 +1,316 @@
+//nolint:testpackage
+package certmanager
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	ccmcoreweavev1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/coreweave/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	cond "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
+
+	. "github.com/onsi/gomega"
+)
+
+// TestRotateRootCA walks the root CA of the SelfSigned chain through a full rotation.
+// cert-manager does not run in envtest, so the test issues the root CA Secrets itself.
+func TestRotateRootCA(t *testing.T) {
+	g := NewWithT(t)
+
+	envTest, err := envt.New()
+	g.Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { _ = envTest.Stop() })
+
+	ctx := context.Background()
+	cli := envTest.Client()
+
+	_, err = envTest.RegisterCertManagerCRDs(ctx, envt.WithPermissiveSchema())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cert-manager"}})).To(Succeed())
+
+	config := DefaultBootstrapConfig()
+	now := time.Now().Truncate(time.Second)
+
+	instance := &ccmcoreweavev1alpha1.CoreWeaveKubernetesEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: ccmcoreweavev1alpha1.CoreWeaveKubernetesEngineInstanceName},
+	}
+
+	// reconcile runs the rotation at the given time and persists the CA bundle like the deploy action.
+	reconcile := func(g *WithT, at time.Time) (*types.ReconciliationRequest, error) {
+		rr := &types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   instance,
+			Conditions: cond.NewManager(instance, status.ConditionTypeReady, status.ConditionRootCAReady),
+		}
+
+		rotationErr := rotateRootCA(ctx, rr, config, IssuerChainConfiguration(instance), at)
+		for i := range rr.Resources {
+			applyResource(g, ctx, cli, &rr.Resources[i])
+		}
+
+		return rr, rotationErr
+	}
+
+	oldCA := newTestCA(g, now.Add(-time.Hour))
+	createRootCASecret(g, ctx, cli, config, oldCA)
+
+	t.Run("publishes the root CA in the CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr, err := reconcile(g, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionRootCAReady)).NotTo(BeNil())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionRootCAReady).Status).To(Equal(metav1.ConditionTrue))
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, oldCA))
+	})
+
+	t.Run("issues a new root CA when requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		instance.SetAnnotations(map[string]string{ccmcommon.RotateRootCAAnnotation: "2026-10-18"})
+
+		rr, err := reconcile(g, now)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		c := rr.Conditions.GetCondition(status.ConditionRootCAReady)
+		g.Expect(c).NotTo(BeNil())
+		g.Expect(c.Reason).To(Equal(status.RootCAIssuingReason))
+
+		err = cli.Get(ctx, client.ObjectKey{Name: config.CertName, Namespace: config.CertManagerNamespace}, &corev1.Secret{})
+		g.Expect(k8serr.IsNotFound(err)).To(BeTrue(), "the root CA Secret should be deleted for cert-manager to issue a new one")
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, oldCA))
+	})
+
+	newCA := newTestCA(g, now)
+	leaf := createLeafCertificate(g, ctx, cli, config, now.Add(-time.Hour))
+
+	reissueAt := now.Add(defaultRotationOverlap / 2)
+
+	t.Run("publishes both root CAs before re-issuing leaf certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		createRootCASecret(g, ctx, cli, config, newCA)
+
+		rr, err := reconcile(g, now)
+
+		var requeueErr odherrors.RequeueAfterError
+		g.Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		g.Expect(requeueErr.After).To(Equal(defaultRotationOverlap / 2))
+
+		c := rr.Conditions.GetCondition(status.ConditionRootCAReady)
+		g.Expect(c).NotTo(BeNil())
+		g.Expect(c.Reason).To(Equal(status.RootCARotationOverlapReason))
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, newCA+oldCA))
+
+		g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(leaf), leaf)).To(Succeed())
+		conds, _, err := unstructured.NestedSlice(leaf.Object, "status", "conditions")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(conds).NotTo(ContainElement(HaveKeyWithValue("type", "Issuing")),
+			"leaf certificates must not be re-issued before consumers trust the new root CA")
+	})
+
+	t.Run("re-issues leaf certificates halfway through the overlap window", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr, err := reconcile(g, reissueAt)
+
+		var requeueErr odherrors.RequeueAfterError
+		g.Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		g.Expect(requeueErr.After).To(Equal(leafReissueCheckInterval))
+
+		c := rr.Conditions.GetCondition(status.ConditionRootCAReady)
+		g.Expect(c).NotTo(BeNil())
+		g.Expect(c.Reason).To(Equal(status.RootCAReissuingLeafCertificatesReason))
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, newCA+oldCA))
+
+		g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(leaf), leaf)).To(Succeed())
+		conds, _, err := unstructured.NestedSlice(leaf.Object, "status", "conditions")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(conds).To(ContainElement(HaveKeyWithValue("type", "Issuing")))
+	})
+
+	t.Run("keeps the previous root CA during the overlap window", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(unstructured.SetNestedField(leaf.Object, now.Format(time.RFC3339), "status", "notBefore")).To(Succeed())
+		g.Expect(cli.Status().Update(ctx, leaf)).To(Succeed())
+
+		rr, err := reconcile(g, reissueAt.Add(time.Hour))
+
+		var requeueErr odherrors.RequeueAfterError
+		g.Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		g.Expect(requeueErr.After).To(Equal(defaultRotationOverlap/2 - time.Hour))
+
+		c := rr.Conditions.GetCondition(status.ConditionRootCAReady)
+		g.Expect(c).NotTo(BeNil())
+		g.Expect(c.Reason).To(Equal(status.RootCARotationOverlapReason))
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, newCA+oldCA))
+	})
+
+	t.Run("retires the previous root CA after the overlap window", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr, err := reconcile(g, now.Add(defaultRotationOverlap))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionRootCAReady)).NotTo(BeNil())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionRootCAReady).Status).To(Equal(metav1.ConditionTrue))
+
+		bundle := getTestCABundle(g, ctx, cli, config)
+		g.Expect(bundle.Data).To(HaveKeyWithValue(CABundleKey, newCA))
+		g.Expect(bundle.Data).NotTo(HaveKey(previousCAKey))
+	})
+
+	t.Run("does not rotate again for a handled request", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := reconcile(g, now.Add(defaultRotationOverlap))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(cli.Get(ctx, client.ObjectKey{Name: config.CertName, Namespace: config.CertManagerNamespace}, &corev1.Secret{})).To(Succeed())
+	})
+}
+
+// TestRotationReason verifies when a rotation of the root CA is due.
+func TestRotationReason(t *testing.T) {
+	now := time.Now()
+	caCert := &x509.Certificate{NotBefore: now.Add(-48 * time.Hour)}
+
+	maxAge := func(d time.Duration) ccmcommon.CertManagerConfiguration {
+		return ccmcommon.CertManagerConfiguration{
+			SelfSigned: &ccmcommon.SelfSignedCAConfiguration{MaxAge: &metav1.Duration{Duration: d}},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		chain   ccmcommon.CertManagerConfiguration
+		handled string
+		trigger string
+		due     bool
+	}{
+		{name: "no trigger and no max age", chain: ccmcommon.CertManagerConfiguration{}},
+		{name: "new trigger", trigger: "1", due: true},
+		{name: "handled trigger", handled: "1", trigger: "1"},
+		{name: "older than max age", chain: maxAge(24 * time.Hour), due: true},
+		{name: "younger than max age", chain: maxAge(72 * time.Hour)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			reason := rotationReason(tc.chain, caCert, tc.handled, tc.trigger, now)
+			g.Expect(reason != "").To(Equal(tc.due))
+		})
+	}
+}
+
+// newTestCA returns the PEM of a self-signed CA certificate valid from notBefore.
+func newTestCA(g *WithT, notBefore time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "opendatahub-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(24 * 365 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// createRootCASecret creates the root CA Secret the way cert-manager does for a self-signed root CA.
+func createRootCASecret(g *WithT, ctx context.Context, cli client.Client, config BootstrapConfig, caPEM string) {
+	g.Expect(cli.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: config.CertName, Namespace: config.CertManagerNamespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:              []byte(caPEM),
+			corev1.TLSPrivateKeyKey:        []byte("key"),
+			corev1.ServiceAccountRootCAKey: []byte(caPEM),
+		},
+	})).To(Succeed())
+}
+
+// createLeafCertificate creates a Certificate issued by the CA-backed ClusterIssuer at notBefore.
+func createLeafCertificate(g *WithT, ctx context.Context, cli client.Client, config BootstrapConfig, notBefore time.Time) *unstructured.Unstructured {
+	leaf := &unstructured.Unstructured{}
+	leaf.SetGroupVersionKind(gvk.CertManagerCertificate)
+	leaf.SetName("webhook-cert")
+	leaf.SetNamespace(config.CertManagerNamespace)
+	g.Expect(unstructured.SetNestedMap(leaf.Object, map[string]any{
+		"secretName": "webhook-cert",
+		"issuerRef": map[string]any{
+			"name": config.CAIssuerName,
+			"kind": gvk.CertManagerClusterIssuer.Kind,
+		},
+	}, "spec")).To(Succeed())
+	g.Expect(cli.Create(ctx, leaf)).To(Succeed())
+
+	g.Expect(unstructured.SetNestedField(leaf.Object, notBefore.Format(time.RFC3339), "status", "notBefore")).To(Succeed())
+	g.Expect(cli.Status().Update(ctx, leaf)).To(Succeed())
+
+	return leaf
+}
+
+// applyResource creates or replaces obj in the cluster.
+func applyResource(g *WithT, ctx context.Context, cli client.Client, obj *unstructured.Unstructured) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+
+	err := cli.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if k8serr.IsNotFound(err) {
+		g.Expect(cli.Create(ctx, obj)).To(Succeed())
+		return
+	}
+	g.Expect(err).NotTo(HaveOccurred())
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	g.Expect(cli.Update(ctx, obj)).To(Succeed())
+}
+
+func getTestCABundle(g *WithT, ctx context.Context, cli client.Client, config BootstrapConfig) *corev1.ConfigMap {
+	bundle := &corev1.ConfigMap{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: config.CABundleName, Namespace: config.CertManagerNamespace}, bundle)).To(Succeed())
+
+	return bundle
+}
@@ -31,6 +31,17 @@ type ProtectedObject struct {
 	Namespace string // empty for cluster-scoped resources
 }
 
+// ProtectedObjectsFn returns the resources that GC must never delete for a reconciliation.
+// It is evaluated against the current instance, so the protected set follows its spec.
+type ProtectedObjectsFn func(rr *odhTypes.ReconciliationRequest) []ProtectedObject
+
+// StaticProtectedObjects returns a ProtectedObjectsFn that always protects objs.
+func StaticProtectedObjects(objs ...ProtectedObject) ProtectedObjectsFn {
+	return func(_ *odhTypes.ReconciliationRequest) []ProtectedObject {
+		return objs
+	}
+}
+
 // isStaleOrOrphaned reports whether obj should be deleted based on its CCM
 // instance annotations:
 //
@@ -38,6 +49,9 @@ type ProtectedObject struct {
 //   - UID differs from the current CR: orphaned from a different instance → true.
 //   - Generation differs from the current CR: stale from a previous spec version → true.
 //
+// Deletions are logged with the dependency the resource was recorded for in the
+// previous inventory ConfigMap, if any.
+//
 // Reads infrastructure.opendatahub.io annotations first, falling back to the
 // legacy platform.opendatahub.io prefix so resources deployed before the
 // annotation migration are still subject to GC.
@@ -62,7 +76,8 @@ func isStaleOrOrphaned(rr *odhTypes.ReconciliationRequest, obj unstructured.Unst
 	}
 
 	if iUID != string(rr.Instance.GetUID()) {
-		log.V(3).Info("GC: deleting orphaned resource (UID mismatch)", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		log.V(3).Info("GC: deleting orphaned resource (UID mismatch)", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace(),
+			"dependency", previousRelease(rr, obj))
 		return true, nil
 	}
 
@@ -76,8 +91,11 @@ func isStaleOrOrphaned(rr *odhTypes.ReconciliationRequest, obj unstructured.Unst
 
 	shouldDelete := rr.Instance.GetGeneration() != iGenerationInt
 	if shouldDelete {
+		// A stale resource was not re-applied by this reconciliation: it is no longer
+		// rendered by the dependency it was recorded for in the previous inventory.
 		log.V(3).Info("GC: deleting stale resource (generation mismatch)", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace(),
-			"resourceGeneration", iGenerationInt, "crGeneration", rr.Instance.GetGeneration())
+			"resourceGeneration", iGenerationInt, "crGeneration", rr.Instance.GetGeneration(),
+			"dependency", previousRelease(rr, obj))
 	}
 
 	return shouldDelete, nil
@@ -85,20 +103,36 @@ func isStaleOrOrphaned(rr *odhTypes.ReconciliationRequest, obj unstructured.Unst
 
 // newGCPredicate returns the ObjectPredicateFn used by NewGCAction. It first
 // skips any resource matching a ProtectedObject entry (version-agnostic
-// Group+Kind+Name+Namespace), then delegates to isStaleOrOrphaned.
-func newGCPredicate(protectedObjects []ProtectedObject) gc.ObjectPredicateFn {
+// Group+Kind+Name+Namespace) or belonging to a chart whose upgrade is held,
+// then delegates to isStaleOrOrphaned.
+//
+// The protected objects are resolved for each evaluated resource, as the set
+// depends on the instance of the reconciliation request.
+func newGCPredicate(protectedObjects ProtectedObjectsFn) gc.ObjectPredicateFn {
 	log := logf.Log.WithName("ccm-gc")
-	protected := make(map[ProtectedObject]struct{}, len(protectedObjects))
-	for _, obj := range protectedObjects {
-		protected[obj] = struct{}{}
-	}
 
 	return func(rr *odhTypes.ReconciliationRequest, obj unstructured.Unstructured) (bool, error) {
 		objGVK := obj.GroupVersionKind()
 		key := ProtectedObject{Group: objGVK.Group, Kind: objGVK.Kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
-		if _, ok := protected[key]; ok {
-			log.V(3).Info("GC: keeping protected resource", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace())
-			return false, nil
+
+		if protectedObjects != nil {
+			for i, po := range protectedObjects(rr) {
+				if po.Kind == "" || po.Name == "" {
+					return false, fmt.Errorf("protectedObjects[%d] requires both Kind and Name", i)
+				}
+				if po == key {
+					log.V(3).Info("GC: keeping protected resource", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace())
+					return false, nil
+				}
+			}
+		}
+
+		for _, po := range heldObjects(rr) {
+			// Cluster-scoped resources may be rendered with a namespace, which the API server ignores.
+			if po.Group == key.Group && po.Kind == key.Kind && po.Name == key.Name && (key.Namespace == "" || po.Namespace == key.Namespace) {
+				log.V(3).Info("GC: keeping resource of held chart", "gvk", objGVK, "name", obj.GetName(), "namespace", obj.GetNamespace())
+				return false, nil
+			}
 		}
 
 		return isStaleOrOrphaned(rr, obj)
@@ -106,17 +140,30 @@ func newGCPredicate(protectedObjects []ProtectedObject) gc.ObjectPredicateFn {
 }
 
 // BootstrapProtectedObjects returns the ProtectedObject entries for the PKI resources
-// created by the cert-manager bootstrap action.
+// created by the cert-manager bootstrap action for the issuer chain of the instance.
 //
-// Only the long-lived PKI infrastructure is protected: the self-signed ClusterIssuer,
-// the root CA Certificate, and the CA-backed ClusterIssuer. The webhook Certificate
+// Only the long-lived PKI infrastructure is protected (see certmanager.ChainObjects):
+// for the default SelfSigned chain, the self-signed ClusterIssuer, the root CA Certificate,
+// the CA-backed ClusterIssuer and the CA bundle ConfigMap, which holds the root CA rotation
+// state. Resources of a previously configured chain are no
+// longer protected, so GC removes them once the chain changes. The webhook Certificate
 // is intentionally excluded because it is recreated on every reconcile with a fresh
 // generation annotation, so GC will never see a stale version.
-func BootstrapProtectedObjects(config certmanager.BootstrapConfig) []ProtectedObject {
-	return []ProtectedObject{
-		{Group: gvk.CertManagerClusterIssuer.Group, Kind: gvk.CertManagerClusterIssuer.Kind, Name: config.IssuerName},
-		{Group: gvk.CertManagerCertificate.Group, Kind: gvk.CertManagerCertificate.Kind, Name: config.CertName, Namespace: config.CertManagerNamespace},
-		{Group: gvk.CertManagerClusterIssuer.Group, Kind: gvk.CertManagerClusterIssuer.Kind, Name: config.CAIssuerName},
+func BootstrapProtectedObjects(config certmanager.BootstrapConfig) ProtectedObjectsFn {
+	return func(rr *odhTypes.ReconciliationRequest) []ProtectedObject {
+		chainObjects := certmanager.ChainObjects(config, certmanager.IssuerChainConfiguration(rr.Instance))
+
+		protected := make([]ProtectedObject, 0, len(chainObjects))
+		for _, obj := range chainObjects {
+			protected = append(protected, ProtectedObject{
+				Group:     obj.GVK.Group,
+				Kind:      obj.GVK.Kind,
+				Name:      obj.Name,
+				Namespace: obj.Namespace,
+			})
+		}
+
+		return protected
 	}
 }
 
@@ -137,7 +184,7 @@ func BootstrapProtectedObjects(config certmanager.BootstrapConfig) []ProtectedOb
 // NewGCAction must be the last action in the reconciliation pipeline. GC only runs
 // when rr.Generated is true (i.e., on cache miss — when something actually changed).
 // In steady state with no spec changes, GC is skipped entirely.
-func NewGCAction(resourceID string, operatorNamespace string, protectedObjects []ProtectedObject) (actions.Fn, error) {
+func NewGCAction(resourceID string, operatorNamespace string, protectedObjects ProtectedObjectsFn) (actions.Fn, error) {
 	resourceID = labels.NormalizePartOfValue(resourceID)
 	if resourceID == "" {
 		return nil, errors.New("NewGCAction: resourceID is required")
@@ -147,12 +194,6 @@ func NewGCAction(resourceID string, operatorNamespace string, protectedObjects [
 		return nil, errors.New("NewGCAction: operatorNamespace is required")
 	}
 
-	for i, po := range protectedObjects {
-		if po.Kind == "" || po.Name == "" {
-			return nil, fmt.Errorf("NewGCAction: protectedObjects[%d] requires both Kind and Name", i)
-		}
-	}
-
 	return gc.NewAction(
 		gc.InNamespace(operatorNamespace),
 		gc.WithLabel(labels.InfrastructurePartOf, resourceID),
@@ -10,6 +10,9 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency/certmanager"
 	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhAnnotations "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
@@ -193,7 +196,7 @@ func TestNewGCPredicate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			pred := newGCPredicate(testProtectedObjects)
+			pred := newGCPredicate(StaticProtectedObjects(testProtectedObjects...))
 			got, err := pred(rr, tc.obj)
 			g.Expect(err).NotTo(HaveOccurred())
 			g.Expect(got).To(Equal(tc.wantDelete))
@@ -215,15 +218,107 @@ func TestNewGCPredicate_NoProtectedObjects(t *testing.T) {
 	g.Expect(got).To(BeTrue())
 }
 
-func TestNewGCAction(t *testing.T) {
+func TestNewGCPredicate_InvalidProtectedObjects(t *testing.T) {
 	t.Parallel()
 
 	cases := []struct {
 		name             string
-		resourceID       string
-		operatorNS       string
 		protectedObjects []ProtectedObject
-		wantErrContains  string
+	}{
+		{
+			name:             "protected object with empty Kind returns error",
+			protectedObjects: []ProtectedObject{{Group: "cert-manager.io", Name: "test"}},
+		},
+		{
+			name:             "protected object with empty Name returns error",
+			protectedObjects: []ProtectedObject{{Group: "cert-manager.io", Kind: "Certificate"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			pred := newGCPredicate(StaticProtectedObjects(tc.protectedObjects...))
+			_, err := pred(newTestRR(nil), simpleObj(ccmAnns(string(testUID), "3")))
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring("requires both Kind and Name"))
+		})
+	}
+}
+
+func TestBootstrapProtectedObjects(t *testing.T) {
+	t.Parallel()
+
+	config := certmanager.DefaultBootstrapConfig()
+
+	newRR := func(chain ccmcommon.CertManagerConfiguration) *odhTypes.ReconciliationRequest {
+		rr := newTestRR(nil)
+		engine, _ := rr.Instance.(*ccmv1alpha1.AzureKubernetesEngine)
+		engine.Spec.Dependencies.CertManager.Configuration = chain
+		return rr
+	}
+
+	t.Run("protects the self-signed chain by default", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		g.Expect(BootstrapProtectedObjects(config)(newRR(ccmcommon.CertManagerConfiguration{}))).To(ConsistOf(
+			ProtectedObject{Group: gvk.CertManagerClusterIssuer.Group, Kind: gvk.CertManagerClusterIssuer.Kind, Name: config.IssuerName},
+			ProtectedObject{Group: gvk.CertManagerCertificate.Group, Kind: gvk.CertManagerCertificate.Kind, Name: config.CertName, Namespace: config.CertManagerNamespace},
+			ProtectedObject{Group: gvk.CertManagerClusterIssuer.Group, Kind: gvk.CertManagerClusterIssuer.Kind, Name: config.CAIssuerName},
+			ProtectedObject{Kind: gvk.ConfigMap.Kind, Name: config.CABundleName, Namespace: config.CertManagerNamespace},
+		))
+	})
+
+	t.Run("protects the imported CA Secret", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		rr := newRR(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.CASecretIssuerType,
+			CASecret:   &ccmcommon.CASecretReference{Name: "corp-ca", Namespace: "corp-pki"},
+		})
+
+		g.Expect(BootstrapProtectedObjects(config)(rr)).To(ConsistOf(
+			ProtectedObject{Kind: gvk.Secret.Kind, Name: config.CertName, Namespace: config.CertManagerNamespace},
+			ProtectedObject{Group: gvk.CertManagerClusterIssuer.Group, Kind: gvk.CertManagerClusterIssuer.Kind, Name: config.CAIssuerName},
+			ProtectedObject{Kind: gvk.ConfigMap.Kind, Name: config.CABundleName, Namespace: config.CertManagerNamespace},
+		))
+	})
+
+	t.Run("no longer protects the self-signed chain with an issuer ref", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		rr := newRR(ccmcommon.CertManagerConfiguration{
+			IssuerType: ccmcommon.IssuerRefIssuerType,
+			IssuerRef:  &ccmcommon.IssuerReference{Name: "corp-issuer"},
+		})
+
+		g.Expect(BootstrapProtectedObjects(config)(rr)).To(BeEmpty())
+
+		pred := newGCPredicate(BootstrapProtectedObjects(config))
+		got, err := pred(rr, newObj(
+			schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "ClusterIssuer"},
+			config.IssuerName, "",
+			ccmAnns(string(testUID), "3"),
+		))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(got).To(BeTrue())
+	})
+}
+
+func TestNewGCAction(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name                     string
+		resourceID               string
+		operatorNS               string
+		protectedObjects         ProtectedObjectsFn
+		wantErrContains          string
+		wantPredicateErrContains string
 	}{
 		{
 			name:            "empty resourceID returns error",
@@ -238,30 +333,31 @@ func TestNewGCAction(t *testing.T) {
 			wantErrContains: "operatorNamespace is required",
 		},
 		{
-			name:       "protected object with empty Kind returns error",
+			// The protected set depends on the instance, so it is validated when GC evaluates it.
+			name:       "protected object with empty Kind returns error on evaluation",
 			resourceID: "test-resource",
 			operatorNS: "test-ns",
-			protectedObjects: []ProtectedObject{
-				{Group: "cert-manager.io", Name: "test"},
-			},
-			wantErrContains: "requires both Kind and Name",
+			protectedObjects: StaticProtectedObjects(
+				ProtectedObject{Group: "cert-manager.io", Name: "test"},
+			),
+			wantPredicateErrContains: "requires both Kind and Name",
 		},
 		{
-			name:       "protected object with empty Name returns error",
+			name:       "protected object with empty Name returns error on evaluation",
 			resourceID: "test-resource",
 			operatorNS: "test-ns",
-			protectedObjects: []ProtectedObject{
-				{Group: "cert-manager.io", Kind: "Certificate"},
-			},
-			wantErrContains: "requires both Kind and Name",
+			protectedObjects: StaticProtectedObjects(
+				ProtectedObject{Group: "cert-manager.io", Kind: "Certificate"},
+			),
+			wantPredicateErrContains: "requires both Kind and Name",
 		},
 		{
 			name:       "valid parameters return non-nil action",
 			resourceID: "test-resource",
 			operatorNS: "test-ns",
-			protectedObjects: []ProtectedObject{
-				{Group: "cert-manager.io", Kind: "Certificate", Name: "test-cert"},
-			},
+			protectedObjects: StaticProtectedObjects(
+				ProtectedObject{Group: "cert-manager.io", Kind: "Certificate", Name: "test-cert"},
+			),
 		},
 		{
 			name:             "nil protected objects is valid",
@@ -284,6 +380,13 @@ func TestNewGCAction(t *testing.T) {
 			}
 			g.Expect(err).NotTo(HaveOccurred())
 			g.Expect(fn).NotTo(BeNil())
+
+			_, err = newGCPredicate(tc.protectedObjects)(newTestRR(nil), simpleObj(ccmAnns(string(testUID), "3")))
+			if tc.wantPredicateErrContains != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantPredicateErrContains)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
 		})
 	}
 }
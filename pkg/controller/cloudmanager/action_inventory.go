@@ -0,0 +1,261 @@
+package cloudmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	ccmcharts "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// extKeyPreviousInventory is the ReconciliationRequest extension key holding the
+// map[ProtectedObject]string of the resources recorded in the inventory ConfigMap
+// before this reconciliation, keyed to the release name of their dependency.
+const extKeyPreviousInventory = "odh.io/cloudmanager-previous-inventory"
+
+// inventoryObject identifies a resource in the inventory ConfigMap.
+type inventoryObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// InventoryConfigMapName returns the name of the ConfigMap holding the full
+// inventory of the resources applied for resourceID.
+func InventoryConfigMapName(resourceID string) string {
+	return labels.NormalizePartOfValue(resourceID) + "-inventory"
+}
+
+// objectKey returns the version-agnostic identity of obj.
+func objectKey(obj unstructured.Unstructured) ProtectedObject {
+	objGVK := obj.GroupVersionKind()
+
+	return ProtectedObject{Group: objGVK.Group, Kind: objGVK.Kind, Name: obj.GetName(), Namespace: obj.GetNamespace()}
+}
+
+// recordInventory records the resources about to be applied for each chart:
+// a summary in the instance status and, when namespace is set, the full list
+// in the inventory ConfigMap, which is added to rr.Resources.
+//
+// owners maps the identity of the rendered resources to the release name of
+// their chart, as returned by chartRenderer.render.
+//
+// Charts whose upgrade is held keep the inventory of their deployed version,
+// read from the instance status and from previous, the inventory ConfigMap
+// returned by previousInventory. The inventory is only recorded when the
+// resources were re-rendered; otherwise the instance status and the ConfigMap
+// already hold it.
+func recordInventory(
+	rr *types.ReconciliationRequest,
+	resourceID string,
+	namespace string,
+	previous *corev1.ConfigMap,
+	owners map[ProtectedObject]string,
+	charts []types.HelmChartInfo,
+	held []heldChart,
+) error {
+	ke, ok := rr.Instance.(ccmcommon.KubernetesEngineInstance)
+	if !ok || !rr.Generated {
+		return nil
+	}
+
+	objects := make(map[string][]unstructured.Unstructured, len(charts))
+	for _, res := range rr.Resources {
+		if release, ok := owners[objectKey(res)]; ok {
+			objects[release] = append(objects[release], res)
+		}
+	}
+
+	inventory := make([]ccmcommon.DependencyInventory, 0, len(charts)+len(held))
+	data := make(map[string]string, len(charts)+len(held))
+
+	for _, chart := range charts {
+		entry, content, err := newDependencyInventory(chart, objects[chart.ReleaseName])
+		if err != nil {
+			return err
+		}
+
+		inventory = append(inventory, entry)
+		data[chart.ReleaseName] = content
+	}
+
+	recorded := ke.GetInventory()
+	for _, h := range held {
+		if i := slices.IndexFunc(recorded, func(e ccmcommon.DependencyInventory) bool {
+			return e.Name == h.chart.ReleaseName
+		}); i >= 0 {
+			inventory = append(inventory, recorded[i])
+		}
+
+		if content, ok := previous.Data[h.chart.ReleaseName]; ok {
+			data[h.chart.ReleaseName] = content
+		}
+	}
+
+	ke.SetInventory(inventory)
+
+	if namespace == "" {
+		return nil
+	}
+
+	return rr.AddResources(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       gvk.ConfigMap.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InventoryConfigMapName(resourceID),
+			Namespace: namespace,
+		},
+		Data: data,
+	})
+}
+
+// newDependencyInventory returns the status summary of the resources of chart,
+// and their list in the format of the inventory ConfigMap.
+func newDependencyInventory(chart types.HelmChartInfo, objects []unstructured.Unstructured) (ccmcommon.DependencyInventory, string, error) {
+	// Charts without a readable Chart.yaml are reported without a version.
+	version, _ := ccmcharts.ChartVersion(chart.Chart)
+
+	entry := ccmcommon.DependencyInventory{
+		Name:         chart.ReleaseName,
+		ChartVersion: version,
+	}
+
+	// Sort by identity so that the hash does not depend on the render order.
+	objects = slices.Clone(objects)
+	slices.SortFunc(objects, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(inventoryKeyString(a), inventoryKeyString(b))
+	})
+
+	counts := map[string]int32{}
+	listed := make([]inventoryObject, 0, len(objects))
+	hash := sha256.New()
+
+	for _, obj := range objects {
+		counts[obj.GroupVersionKind().GroupKind().String()]++
+		listed = append(listed, inventoryObject{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+
+		content, err := json.Marshal(obj.Object)
+		if err != nil {
+			return ccmcommon.DependencyInventory{}, "", fmt.Errorf("failed to hash %s: %w", inventoryKeyString(obj), err)
+		}
+		hash.Write(content)
+	}
+
+	for kind, count := range counts {
+		entry.Objects = append(entry.Objects, ccmcommon.ObjectKindCount{Kind: kind, Count: count})
+	}
+	slices.SortFunc(entry.Objects, func(a, b ccmcommon.ObjectKindCount) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+
+	entry.Hash = hex.EncodeToString(hash.Sum(nil))
+
+	content, err := yaml.Marshal(listed)
+	if err != nil {
+		return ccmcommon.DependencyInventory{}, "", fmt.Errorf("failed to marshal inventory of chart %s: %w", chart.ReleaseName, err)
+	}
+
+	return entry, string(content), nil
+}
+
+// inventoryKeyString returns a sortable identity of obj.
+func inventoryKeyString(obj unstructured.Unstructured) string {
+	return obj.GroupVersionKind().GroupKind().String() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// previousInventory fetches the inventory ConfigMap recorded by the previous reconciliation
+// and stores the release of each listed resource in the reconciliation request, so that
+// GC can report which dependency a collected resource belonged to.
+// It returns an empty ConfigMap when none was recorded or namespace is empty.
+func previousInventory(ctx context.Context, rr *types.ReconciliationRequest, resourceID string, namespace string) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if namespace == "" {
+		return cm, nil
+	}
+
+	err := rr.Client.Get(ctx, client.ObjectKey{Name: InventoryConfigMapName(resourceID), Namespace: namespace}, cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return cm, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get inventory ConfigMap: %w", err)
+	}
+
+	releases := map[ProtectedObject]string{}
+	for release, content := range cm.Data {
+		objects, err := inventoryObjects(content)
+		if err != nil {
+			// A malformed entry only loses the GC explanation for its resources.
+			continue
+		}
+
+		for _, obj := range objects {
+			releases[obj] = release
+		}
+	}
+
+	if rr.Extensions == nil {
+		rr.Extensions = make(map[string]any)
+	}
+	rr.Extensions[extKeyPreviousInventory] = releases
+
+	return cm, nil
+}
+
+// inventoryObjects returns the identity of the resources listed in an entry
+// of the inventory ConfigMap.
+func inventoryObjects(content string) ([]ProtectedObject, error) {
+	var listed []inventoryObject
+	if err := yaml.Unmarshal([]byte(content), &listed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inventory: %w", err)
+	}
+
+	objects := make([]ProtectedObject, 0, len(listed))
+	for _, obj := range listed {
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apiVersion of %s %s: %w", obj.Kind, obj.Name, err)
+		}
+
+		objects = append(objects, ProtectedObject{Group: gv.Group, Kind: obj.Kind, Name: obj.Name, Namespace: obj.Namespace})
+	}
+
+	return objects, nil
+}
+
+// previousRelease returns the release name of the dependency obj was applied for,
+// as recorded in the inventory ConfigMap before this reconciliation, or an empty
+// string if it is unknown.
+func previousRelease(rr *types.ReconciliationRequest, obj unstructured.Unstructured) string {
+	if rr.Extensions == nil {
+		return ""
+	}
+
+	releases, _ := rr.Extensions[extKeyPreviousInventory].(map[ProtectedObject]string)
+
+	return releases[objectKey(obj)]
+}
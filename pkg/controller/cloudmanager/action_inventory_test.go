@@ -0,0 +1,119 @@
+//nolint:testpackage // white-box tests for unexported inventory recording
+package cloudmanager
+
+import (
+	"path/filepath"
+	"testing"
+
+	helmRenderer "github.com/k8s-manifest-kit/renderer-helm/pkg"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func testChart(release string, namespace string) odhTypes.HelmChartInfo {
+	return odhTypes.HelmChartInfo{
+		Source: helmRenderer.Source{
+			Chart:       filepath.Join("testdata", "test-chart"),
+			ReleaseName: release,
+			Values:      helmRenderer.Values(map[string]any{"namespace": namespace}),
+		},
+	}
+}
+
+func TestRecordInventory(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	const operatorNamespace = "operator-ns"
+
+	charts := []odhTypes.HelmChartInfo{testChart("one", "ns-one"), testChart("two", "ns-two")}
+	held := []heldChart{{chart: testChart("held", "ns-held")}}
+
+	heldInventory := ccmcommon.DependencyInventory{Name: "held", ChartVersion: "0.0.9", Hash: "previous"}
+
+	previous := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: InventoryConfigMapName(testResourceID), Namespace: operatorNamespace},
+		Data: map[string]string{
+			"held": "- apiVersion: v1\n  kind: ConfigMap\n  namespace: ns-held\n  name: held-config\n",
+			"one":  "- apiVersion: v1\n  kind: ConfigMap\n  namespace: ns-one\n  name: removed-config\n",
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(previous))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	instance := &ccmv1alpha1.AzureKubernetesEngine{
+		Status: ccmv1alpha1.AzureKubernetesEngineStatus{
+			Inventory: []ccmcommon.DependencyInventory{heldInventory},
+		},
+	}
+	rr := &odhTypes.ReconciliationRequest{Client: cl, Instance: instance, Generated: true}
+
+	// Resources not rendered from a chart, e.g. the cert-manager bootstrap ones, are not inventoried.
+	rr.Resources = append(rr.Resources, newObj(gvk.ConfigMap, "bootstrap", operatorNamespace, nil))
+	owners := map[ProtectedObject]string{
+		{Kind: gvk.ConfigMap.Kind, Name: "one-config", Namespace: "ns-one"}: "one",
+		{Kind: gvk.ConfigMap.Kind, Name: "two-config", Namespace: "ns-two"}: "two",
+	}
+	for obj := range owners {
+		rr.Resources = append(rr.Resources, newObj(gvk.ConfigMap, obj.Name, obj.Namespace, nil))
+	}
+
+	recorded, err := previousInventory(ctx, rr, testResourceID, operatorNamespace)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(recordInventory(rr, testResourceID, operatorNamespace, recorded, owners, charts, held)).To(Succeed())
+
+	g.Expect(instance.Status.Inventory).To(HaveLen(3))
+	g.Expect(instance.Status.Inventory[0].Name).To(Equal("one"))
+	g.Expect(instance.Status.Inventory[0].ChartVersion).To(Equal(testChartVersion))
+	g.Expect(instance.Status.Inventory[0].Objects).To(ConsistOf(ccmcommon.ObjectKindCount{Kind: "ConfigMap", Count: 1}))
+	g.Expect(instance.Status.Inventory[0].Hash).NotTo(BeEmpty())
+	g.Expect(instance.Status.Inventory[1].Name).To(Equal("two"))
+	g.Expect(instance.Status.Inventory[1].Hash).NotTo(Equal(instance.Status.Inventory[0].Hash))
+	g.Expect(instance.Status.Inventory[2]).To(Equal(heldInventory))
+
+	cm := rr.Resources[len(rr.Resources)-1]
+	g.Expect(cm.GetKind()).To(Equal(gvk.ConfigMap.Kind))
+	g.Expect(cm.GetName()).To(Equal(InventoryConfigMapName(testResourceID)))
+	g.Expect(cm.GetNamespace()).To(Equal(operatorNamespace))
+	g.Expect(cm.Object["data"]).To(HaveKeyWithValue("one", "- apiVersion: v1\n  kind: ConfigMap\n  name: one-config\n  namespace: ns-one\n"))
+	g.Expect(cm.Object["data"]).To(HaveKeyWithValue("held", previous.Data["held"]))
+	g.Expect(cm.Object["data"]).To(HaveKey("two"))
+
+	// GC explanations come from the previous inventory.
+	g.Expect(previousRelease(rr, newObj(gvk.ConfigMap, "removed-config", "ns-one", nil))).To(Equal("one"))
+	g.Expect(previousRelease(rr, newObj(gvk.ConfigMap, "bootstrap", operatorNamespace, nil))).To(BeEmpty())
+}
+
+func TestPreviousInventoryWithoutNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	rr := &odhTypes.ReconciliationRequest{Instance: &ccmv1alpha1.AzureKubernetesEngine{}}
+
+	cm, err := previousInventory(t.Context(), rr, testResourceID, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cm.Data).To(BeEmpty())
+}
+
+func TestRecordInventorySkipsCachedRender(t *testing.T) {
+	g := NewWithT(t)
+
+	recorded := []ccmcommon.DependencyInventory{{Name: "one", Hash: "previous"}}
+	instance := &ccmv1alpha1.AzureKubernetesEngine{
+		Status: ccmv1alpha1.AzureKubernetesEngineStatus{Inventory: recorded},
+	}
+	rr := &odhTypes.ReconciliationRequest{Instance: instance}
+
+	g.Expect(recordInventory(rr, testResourceID, "operator-ns", &corev1.ConfigMap{}, nil, []odhTypes.HelmChartInfo{testChart("one", "ns-one")}, nil)).To(Succeed())
+	g.Expect(instance.Status.Inventory).To(Equal(recorded))
+	g.Expect(rr.Resources).To(BeEmpty())
+}
@@ -69,16 +69,19 @@ func TestMonitorDependencies(t *testing.T) {
 				GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+				KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 			},
 			expectedStatus: map[string]metav1.ConditionStatus{
 				status.ConditionGatewayAPIReady:   metav1.ConditionTrue,
 				status.ConditionLWSReady:          metav1.ConditionTrue,
 				status.ConditionSailOperatorReady: metav1.ConditionTrue,
+				status.ConditionKEDAReady:         metav1.ConditionTrue,
 			},
 			expectedReasons: map[string]string{
 				status.ConditionGatewayAPIReady:   status.UnmanagedReason,
 				status.ConditionLWSReady:          status.UnmanagedReason,
 				status.ConditionSailOperatorReady: status.UnmanagedReason,
+				status.ConditionKEDAReady:         status.UnmanagedReason,
 			},
 		},
 		{
@@ -87,6 +90,7 @@ func TestMonitorDependencies(t *testing.T) {
 				GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Managed},
 				LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+				KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 			},
 			expectedStatus: map[string]metav1.ConditionStatus{
 				status.ConditionGatewayAPIReady: metav1.ConditionTrue,
@@ -274,6 +278,7 @@ func TestSummarizeDependencyStatus(t *testing.T) {
 				GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+				KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 			},
 			expectedReadyStatus: metav1.ConditionTrue,
 		},
@@ -283,6 +288,7 @@ func TestSummarizeDependencyStatus(t *testing.T) {
 				GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Managed},
 				SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+				KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 			},
 			objects: func(_ string) []client.Object {
 				return []client.Object{
@@ -299,6 +305,7 @@ func TestSummarizeDependencyStatus(t *testing.T) {
 				GatewayAPI:   ccmcommon.GatewayAPIDependency{ManagementPolicy: ccmcommon.Unmanaged},
 				LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Managed},
 				SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Managed},
+				KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
 			},
 			objects: func(_ string) []client.Object {
 				return []client.Object{
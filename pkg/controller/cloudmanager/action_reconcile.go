@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -27,6 +29,7 @@ type reconcileAction struct {
 	helmOpts      []helm.ActionOpts
 	deployOpts    []deploy.ActionOpts
 	resourceID    string
+	inventoryNS   string
 	buildChartsFn func(context.Context, *types.ReconciliationRequest) (ccmcharts.BuildResult, error)
 }
 
@@ -47,6 +50,14 @@ func WithDeployOptions(opts ...deploy.ActionOpts) ReconcileActionOpts {
 	}
 }
 
+// WithInventoryNamespace sets the namespace of the ConfigMap holding the full
+// inventory of the applied resources. The ConfigMap is not created when unset.
+func WithInventoryNamespace(namespace string) ReconcileActionOpts {
+	return func(a *reconcileAction) {
+		a.inventoryNS = namespace
+	}
+}
+
 // WithBuildChartsFn replaces the default buildCharts implementation.
 // Useful for testing or for callers that need custom chart discovery logic.
 func WithBuildChartsFn(fn func(context.Context, *types.ReconciliationRequest) (ccmcharts.BuildResult, error)) ReconcileActionOpts {
@@ -68,6 +79,81 @@ func buildCharts(ctx context.Context, rr *types.ReconciliationRequest) (ccmchart
 	return ccmcharts.BuildHelmCharts(ctx, rr.Client, dp.GetDependencies(), rr.ChartsBasePath)
 }
 
+// chartRenderer renders each chart with its own Helm render action, so that
+// the owning release of every rendered resource is known without rendering
+// the charts again, and each chart keeps its own render cache.
+type chartRenderer struct {
+	opts []helm.ActionOpts
+
+	mu      sync.Mutex
+	actions map[string]actions.Fn
+}
+
+func newChartRenderer(opts ...helm.ActionOpts) *chartRenderer {
+	return &chartRenderer{
+		opts:    opts,
+		actions: map[string]actions.Fn{},
+	}
+}
+
+// action returns the Helm render action of the chart with the given release name.
+func (c *chartRenderer) action(release string) actions.Fn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fn, ok := c.actions[release]
+	if !ok {
+		fn = helm.NewAction(c.opts...)
+		c.actions[release] = fn
+	}
+
+	return fn
+}
+
+// render renders rr.HelmCharts into rr.Resources and returns the release name
+// of each rendered resource. rr.Generated is set if any chart was re-rendered.
+func (c *chartRenderer) render(ctx context.Context, rr *types.ReconciliationRequest) (map[ProtectedObject]string, error) {
+	charts := rr.HelmCharts
+	defer func() { rr.HelmCharts = charts }()
+
+	owners := map[ProtectedObject]string{}
+	generated := false
+
+	for _, chart := range charts {
+		rr.HelmCharts = []types.HelmChartInfo{chart}
+		rendered := len(rr.Resources)
+
+		if err := c.action(chart.ReleaseName)(ctx, rr); err != nil {
+			return nil, fmt.Errorf("chart %s: %w", chart.ReleaseName, err)
+		}
+
+		generated = generated || rr.Generated
+
+		for _, res := range rr.Resources[rendered:] {
+			owners[objectKey(res)] = chart.ReleaseName
+		}
+	}
+
+	rr.Generated = generated
+
+	return owners, nil
+}
+
+// mirrorImages rewrites the images of the rendered workloads to the image mirrors
+// of the instance, if any. It runs once for all charts, after their PreApply hooks.
+func mirrorImages(ctx context.Context, rr *types.ReconciliationRequest) error {
+	ke, ok := rr.Instance.(ccmcommon.KubernetesEngineInstance)
+	if !ok || ke.GetImageMirrors() == nil {
+		return nil
+	}
+
+	if err := ccmcharts.MirrorImagesHook(*ke.GetImageMirrors())(ctx, rr); err != nil {
+		return fmt.Errorf("image mirroring failed: %w", err)
+	}
+
+	return nil
+}
+
 func filterCRs(resources []unstructured.Unstructured, crs []types.OperatorCR) []unstructured.Unstructured {
 	if len(crs) == 0 {
 		return resources
@@ -115,11 +201,15 @@ func filterCRs(resources []unstructured.Unstructured, crs []types.OperatorCR) []
 
 // NewReconcileAction creates a combined action that:
 // - Builds the chart list (with two-phase cleanup for Unmanaged dependencies)
+// - Holds back charts whose bundled version is not allowed by their version policy
 // - Renders Helm charts
 // - Filters operator CRs from Phase 1 cleanup charts
 // - Runs PreApply hooks from HelmCharts
+// - Rewrites the images of the rendered workloads to the configured image mirrors
+// - Records the inventory of the resources to apply in status and in the inventory ConfigMap
 // - Deploys resources via SSA
 // - Runs PostApply hooks from HelmCharts
+// - Records the deployed dependency versions and held upgrades in status
 // - Checks per-dependency health (deployment readiness + operator CR health).
 //
 // Per-dependency monitoring is enabled automatically when rr.Instance implements
@@ -139,7 +229,7 @@ func NewReconcileAction(resourceID string, opts ...ReconcileActionOpts) (actions
 		opt(&action)
 	}
 
-	helmRender := helm.NewAction(action.helmOpts...)
+	renderer := newChartRenderer(action.helmOpts...)
 	deployAction := deploy.NewAction(append(action.deployOpts,
 		deploy.WithApplyOrder(),
 		deploy.WithContinueOnError(),
@@ -153,14 +243,32 @@ func NewReconcileAction(resourceID string, opts ...ReconcileActionOpts) (actions
 			return err
 		}
 
-		rr.HelmCharts = append(rr.HelmCharts, result.Charts...)
+		plan, err := planUpgrades(rr, result.Charts, result.VersionPolicies)
+		if err != nil {
+			return err
+		}
+
+		rr.HelmCharts = append(rr.HelmCharts, plan.charts...)
 
-		if err := helmRender(ctx, rr); err != nil {
+		owners, err := renderer.render(ctx, rr)
+		if err != nil {
 			return fmt.Errorf("helm render failed: %w", err)
 		}
 
 		rr.Resources = filterCRs(rr.Resources, result.FilterCRs)
 
+		previous := &corev1.ConfigMap{}
+		if rr.Generated {
+			previous, err = previousInventory(ctx, rr, action.resourceID, action.inventoryNS)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := protectHeldCharts(rr, previous, plan.held); err != nil {
+			return err
+		}
+
 		// Execute PreApply hooks
 		err = runHooks(ctx, rr, func(c *types.HelmChartInfo) []types.HookFn {
 			return c.PreApply
@@ -169,6 +277,14 @@ func NewReconcileAction(resourceID string, opts ...ReconcileActionOpts) (actions
 			return err
 		}
 
+		if err := mirrorImages(ctx, rr); err != nil {
+			return err
+		}
+
+		if err := recordInventory(rr, action.resourceID, action.inventoryNS, previous, owners, plan.charts, plan.held); err != nil {
+			return err
+		}
+
 		// Deploy resources via SSA
 		if err := deployAction(ctx, rr); err != nil {
 			return fmt.Errorf("deploy failed: %w", err)
@@ -182,6 +298,8 @@ func NewReconcileAction(resourceID string, opts ...ReconcileActionOpts) (actions
 			return err
 		}
 
+		updateDependencyVersions(rr, plan)
+
 		if err := cleanupExcludedCharts(ctx, rr, result.CleanupCharts); err != nil {
 			return err
 		}
@@ -1,4 +1,4 @@
-//nolint:testpackage // white-box tests for unexported filterCRs
+//nolint:testpackage // white-box tests for unexported filterCRs and chartRenderer
 package cloudmanager
 
 import (
@@ -6,7 +6,9 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/helm"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 
 	. "github.com/onsi/gomega"
@@ -196,3 +198,26 @@ func TestFilterCRs(t *testing.T) {
 		g.Expect(result).To(HaveLen(1))
 	})
 }
+
+func TestChartRenderer(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	renderer := newChartRenderer(helm.WithCache(false))
+	charts := []types.HelmChartInfo{testChart("one", "ns-one"), testChart("two", "ns-two")}
+
+	rr := &types.ReconciliationRequest{
+		Instance:   &ccmv1alpha1.AzureKubernetesEngine{},
+		HelmCharts: charts,
+	}
+
+	owners, err := renderer.render(ctx, rr)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rr.Generated).To(BeTrue())
+	g.Expect(rr.HelmCharts).To(Equal(charts))
+	g.Expect(rr.Resources).To(HaveLen(2))
+	g.Expect(owners).To(Equal(map[ProtectedObject]string{
+		{Kind: gvk.ConfigMap.Kind, Name: "one-config", Namespace: "ns-one"}: "one",
+		{Kind: gvk.ConfigMap.Kind, Name: "two-config", Namespace: "ns-two"}: "two",
+	}))
+}
@@ -0,0 +1,230 @@
+package cloudmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	ccmcharts "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// extKeyHeldObjects is the ReconciliationRequest extension key holding the
+// []ProtectedObject of charts whose upgrade is held, so that GC keeps them.
+const extKeyHeldObjects = "odh.io/cloudmanager-held-objects"
+
+// heldChart is a managed chart whose bundled version is held back by its version policy.
+type heldChart struct {
+	chart            types.HelmChartInfo
+	deployedVersion  string
+	availableVersion string
+	reason           string
+}
+
+// upgradePlan is the outcome of evaluating the version policies of the managed charts.
+type upgradePlan struct {
+	// charts are the charts to render and deploy.
+	charts []types.HelmChartInfo
+	// held are the charts whose bundled version must not be deployed.
+	held []heldChart
+	// versions is the dependency version status to record once the charts are deployed.
+	versions []ccmcommon.DependencyVersionStatus
+}
+
+// planUpgrades splits charts into the charts to deploy and the charts held back
+// by their version policy. Charts without a version policy are always deployed
+// and are not reported in the dependency version status. Every chart of a
+// dependency has a policy; cert-manager has none as it is not deployed from a chart.
+//
+// The deployed version of a dependency is read from the instance status. A dependency
+// without a recorded version is treated as a first installation, which the Manual
+// upgrade policy never holds back.
+func planUpgrades(
+	rr *types.ReconciliationRequest,
+	charts []types.HelmChartInfo,
+	policies map[string]ccmcommon.DependencyVersionPolicy,
+) (upgradePlan, error) {
+	var plan upgradePlan
+
+	deployed := map[string]string{}
+	if dp, ok := rr.Instance.(ccmcommon.KubernetesEngineInstance); ok {
+		for _, v := range dp.GetDependencyVersions() {
+			deployed[v.Name] = v.DeployedVersion
+		}
+	}
+
+	for _, chart := range charts {
+		policy, ok := policies[chart.ReleaseName]
+		if !ok {
+			plan.charts = append(plan.charts, chart)
+
+			continue
+		}
+
+		bundled, err := ccmcharts.ChartVersion(chart.Chart)
+		if err != nil {
+			return upgradePlan{}, fmt.Errorf("failed to get version of chart %s: %w", chart.ReleaseName, err)
+		}
+
+		reason, err := holdReason(policy, deployed[chart.ReleaseName], bundled)
+		if err != nil {
+			return upgradePlan{}, fmt.Errorf("invalid version policy for %s: %w", chart.ReleaseName, err)
+		}
+
+		if reason == "" {
+			plan.charts = append(plan.charts, chart)
+			plan.versions = append(plan.versions, ccmcommon.DependencyVersionStatus{
+				Name:            chart.ReleaseName,
+				DeployedVersion: bundled,
+			})
+
+			continue
+		}
+
+		plan.held = append(plan.held, heldChart{
+			chart:            chart,
+			deployedVersion:  deployed[chart.ReleaseName],
+			availableVersion: bundled,
+			reason:           reason,
+		})
+		plan.versions = append(plan.versions, ccmcommon.DependencyVersionStatus{
+			Name:             chart.ReleaseName,
+			DeployedVersion:  deployed[chart.ReleaseName],
+			AvailableVersion: bundled,
+		})
+	}
+
+	return plan, nil
+}
+
+// holdReason returns the reason the bundled chart version must be held back,
+// or an empty string if it can be deployed.
+func holdReason(policy ccmcommon.DependencyVersionPolicy, deployed string, bundled string) (string, error) {
+	if deployed == bundled {
+		return "", nil
+	}
+
+	if policy.Version != "" {
+		versionRange, err := semver.ParseRange(policy.Version)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse version %q: %w", policy.Version, err)
+		}
+
+		v, err := semver.ParseTolerant(bundled)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse chart version %q: %w", bundled, err)
+		}
+
+		if !versionRange(v) {
+			return status.VersionConstraintNotSatisfiedReason, nil
+		}
+	}
+
+	if policy.GetUpgradePolicy() == ccmcommon.Manual && deployed != "" && policy.ApprovedVersion != bundled {
+		return status.UpgradePendingApprovalReason, nil
+	}
+
+	return "", nil
+}
+
+// protectHeldCharts stores in the reconciliation request the identity of the
+// resources recorded for the held charts in previous, the inventory ConfigMap
+// returned by previousInventory, so that GC keeps the resources deployed from
+// their previous chart version.
+//
+// A held chart that was deployed but has no recorded inventory is an error, as
+// GC would otherwise delete the resources of its deployed version.
+func protectHeldCharts(rr *types.ReconciliationRequest, previous *corev1.ConfigMap, held []heldChart) error {
+	if len(held) == 0 || !rr.Generated {
+		return nil
+	}
+
+	var objects []ProtectedObject
+
+	for _, h := range held {
+		content, ok := previous.Data[h.chart.ReleaseName]
+		if !ok {
+			if h.deployedVersion == "" {
+				// Never deployed, nothing to protect.
+				continue
+			}
+
+			return fmt.Errorf("no inventory recorded for held chart %s, its deployed resources cannot be protected from GC", h.chart.ReleaseName)
+		}
+
+		recorded, err := inventoryObjects(content)
+		if err != nil {
+			return fmt.Errorf("invalid inventory of held chart %s: %w", h.chart.ReleaseName, err)
+		}
+
+		objects = append(objects, recorded...)
+	}
+
+	if rr.Extensions == nil {
+		rr.Extensions = make(map[string]any)
+	}
+	rr.Extensions[extKeyHeldObjects] = objects
+
+	return nil
+}
+
+// heldObjects returns the resources of held charts recorded by protectHeldCharts.
+func heldObjects(rr *types.ReconciliationRequest) []ProtectedObject {
+	if rr.Extensions == nil {
+		return nil
+	}
+
+	objects, _ := rr.Extensions[extKeyHeldObjects].([]ProtectedObject)
+
+	return objects
+}
+
+// updateDependencyVersions records the dependency versions of plan in the instance status
+// and reports the held upgrades through the UpgradeAvailable condition.
+func updateDependencyVersions(rr *types.ReconciliationRequest, plan upgradePlan) {
+	if len(plan.versions) == 0 {
+		return
+	}
+
+	if dp, ok := rr.Instance.(ccmcommon.KubernetesEngineInstance); ok {
+		dp.SetDependencyVersions(plan.versions)
+	}
+
+	if len(plan.held) == 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionUpgradeAvailable,
+			conditions.WithReason(status.UpToDateReason),
+			conditions.WithSeverity(common.ConditionSeverityInfo),
+		)
+
+		return
+	}
+
+	reason := status.VersionConstraintNotSatisfiedReason
+	upgrades := make([]string, 0, len(plan.held))
+
+	for _, h := range plan.held {
+		if h.reason == status.UpgradePendingApprovalReason {
+			reason = status.UpgradePendingApprovalReason
+		}
+
+		deployedVersion := h.deployedVersion
+		if deployedVersion == "" {
+			deployedVersion = "none"
+		}
+
+		upgrades = append(upgrades, fmt.Sprintf("%s %s -> %s (%s)", h.chart.ReleaseName, deployedVersion, h.availableVersion, h.reason))
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionUpgradeAvailable,
+		conditions.WithReason(reason),
+		conditions.WithMessage("Upgrades held: %s", strings.Join(upgrades, ", ")),
+	)
+}
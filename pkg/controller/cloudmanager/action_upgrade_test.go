@@ -0,0 +1,255 @@
+//nolint:testpackage // white-box tests for unexported upgrade planning
+package cloudmanager
+
+import (
+	"path/filepath"
+	"testing"
+
+	helmRenderer "github.com/k8s-manifest-kit/renderer-helm/pkg"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+// testChartVersion is the version declared in testdata/test-chart/Chart.yaml.
+const testChartVersion = "0.1.0"
+
+func TestHoldReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   ccmcommon.DependencyVersionPolicy
+		deployed string
+		expected string
+	}{
+		{
+			name:     "automatic upgrade is deployed",
+			deployed: "0.0.9",
+		},
+		{
+			name:     "manual first installation is deployed",
+			policy:   ccmcommon.DependencyVersionPolicy{UpgradePolicy: ccmcommon.Manual},
+			deployed: "",
+		},
+		{
+			name:     "manual upgrade without approval is held",
+			policy:   ccmcommon.DependencyVersionPolicy{UpgradePolicy: ccmcommon.Manual},
+			deployed: "0.0.9",
+			expected: status.UpgradePendingApprovalReason,
+		},
+		{
+			name:     "manual upgrade approved for another version is held",
+			policy:   ccmcommon.DependencyVersionPolicy{UpgradePolicy: ccmcommon.Manual, ApprovedVersion: "0.2.0"},
+			deployed: "0.0.9",
+			expected: status.UpgradePendingApprovalReason,
+		},
+		{
+			name:     "manual upgrade approved for the bundled version is deployed",
+			policy:   ccmcommon.DependencyVersionPolicy{UpgradePolicy: ccmcommon.Manual, ApprovedVersion: testChartVersion},
+			deployed: "0.0.9",
+		},
+		{
+			name:     "manual policy with unchanged version is deployed",
+			policy:   ccmcommon.DependencyVersionPolicy{UpgradePolicy: ccmcommon.Manual},
+			deployed: testChartVersion,
+		},
+		{
+			name:     "version in range is deployed",
+			policy:   ccmcommon.DependencyVersionPolicy{Version: ">=0.1.0 <0.2.0"},
+			deployed: "0.0.9",
+		},
+		{
+			name:     "version out of range is held",
+			policy:   ccmcommon.DependencyVersionPolicy{Version: "<0.1.0"},
+			deployed: "0.0.9",
+			expected: status.VersionConstraintNotSatisfiedReason,
+		},
+		{
+			name:     "version out of range is held on first installation",
+			policy:   ccmcommon.DependencyVersionPolicy{Version: ">=1.0.0"},
+			expected: status.VersionConstraintNotSatisfiedReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			reason, err := holdReason(tt.policy, tt.deployed, testChartVersion)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(reason).To(Equal(tt.expected))
+		})
+	}
+
+	t.Run("invalid version range is an error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := holdReason(ccmcommon.DependencyVersionPolicy{Version: "not-a-range"}, "0.0.9", testChartVersion)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestPlanUpgrades(t *testing.T) {
+	chart := odhTypes.HelmChartInfo{
+		Source: helmRenderer.Source{
+			Chart:       filepath.Join("testdata", "test-chart"),
+			ReleaseName: "test",
+		},
+	}
+	unversioned := odhTypes.HelmChartInfo{
+		Source: helmRenderer.Source{
+			Chart:       filepath.Join("testdata", "unknown"),
+			ReleaseName: "unversioned",
+		},
+	}
+
+	newRR := func(deployed string) *odhTypes.ReconciliationRequest {
+		instance := &ccmv1alpha1.AzureKubernetesEngine{
+			Status: ccmv1alpha1.AzureKubernetesEngineStatus{
+				DependencyVersions: []ccmcommon.DependencyVersionStatus{{Name: "test", DeployedVersion: deployed}},
+			},
+		}
+		rr := &odhTypes.ReconciliationRequest{Instance: instance}
+		rr.Conditions = conditions.NewManager(instance, status.ConditionTypeReady)
+
+		return rr
+	}
+
+	t.Run("holds a manual upgrade and reports it", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR("0.0.9")
+
+		plan, err := planUpgrades(rr, []odhTypes.HelmChartInfo{chart, unversioned}, map[string]ccmcommon.DependencyVersionPolicy{
+			"test": {UpgradePolicy: ccmcommon.Manual},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(plan.charts).To(HaveLen(1))
+		g.Expect(plan.charts[0].ReleaseName).To(Equal("unversioned"))
+		g.Expect(plan.held).To(HaveLen(1))
+
+		updateDependencyVersions(rr, plan)
+
+		engine, ok := rr.Instance.(*ccmv1alpha1.AzureKubernetesEngine)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(engine.Status.DependencyVersions).To(ConsistOf(ccmcommon.DependencyVersionStatus{
+			Name:             "test",
+			DeployedVersion:  "0.0.9",
+			AvailableVersion: testChartVersion,
+		}))
+
+		cond := rr.Conditions.GetCondition(status.ConditionUpgradeAvailable)
+		g.Expect(cond).NotTo(BeNil())
+		g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(cond.Reason).To(Equal(status.UpgradePendingApprovalReason))
+		g.Expect(cond.Message).To(ContainSubstring("test 0.0.9 -> " + testChartVersion))
+	})
+
+	t.Run("deploys an approved upgrade and records the version", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR("0.0.9")
+
+		plan, err := planUpgrades(rr, []odhTypes.HelmChartInfo{chart}, map[string]ccmcommon.DependencyVersionPolicy{
+			"test": {UpgradePolicy: ccmcommon.Manual, ApprovedVersion: testChartVersion},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(plan.charts).To(HaveLen(1))
+		g.Expect(plan.held).To(BeEmpty())
+
+		updateDependencyVersions(rr, plan)
+
+		engine, ok := rr.Instance.(*ccmv1alpha1.AzureKubernetesEngine)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(engine.Status.DependencyVersions).To(ConsistOf(ccmcommon.DependencyVersionStatus{
+			Name:            "test",
+			DeployedVersion: testChartVersion,
+		}))
+
+		cond := rr.Conditions.GetCondition(status.ConditionUpgradeAvailable)
+		g.Expect(cond).NotTo(BeNil())
+		g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(cond.Reason).To(Equal(status.UpToDateReason))
+	})
+
+	t.Run("fails when the chart version cannot be read", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR("")
+
+		_, err := planUpgrades(rr, []odhTypes.HelmChartInfo{unversioned}, map[string]ccmcommon.DependencyVersionPolicy{
+			"unversioned": {},
+		})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestProtectHeldCharts(t *testing.T) {
+	chart := odhTypes.HelmChartInfo{
+		Source: helmRenderer.Source{
+			Chart:       filepath.Join("testdata", "test-chart"),
+			ReleaseName: "test",
+			Values:      helmRenderer.Values(map[string]any{"namespace": "held-ns"}),
+		},
+	}
+
+	// The deployed version recorded a resource the bundled chart no longer renders.
+	previous := &corev1.ConfigMap{
+		Data: map[string]string{
+			"test": "- apiVersion: v1\n  kind: ConfigMap\n  namespace: held-ns\n  name: removed-config\n",
+		},
+	}
+
+	t.Run("keeps the recorded resources of a held chart", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newTestRR(nil)
+		rr.Generated = true
+
+		held := []heldChart{{chart: chart, deployedVersion: "0.0.9"}}
+
+		g.Expect(protectHeldCharts(rr, previous, held)).To(Succeed())
+		g.Expect(heldObjects(rr)).To(ConsistOf(ProtectedObject{
+			Kind:      gvk.ConfigMap.Kind,
+			Name:      "removed-config",
+			Namespace: "held-ns",
+		}))
+
+		// A stale resource of the held chart is kept by GC.
+		pred := newGCPredicate(nil)
+		staleAnns := ccmAnns(string(testUID), "1")
+
+		deleteHeld, err := pred(rr, newObj(gvk.ConfigMap, "removed-config", "held-ns", staleAnns))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(deleteHeld).To(BeFalse())
+
+		deleteOther, err := pred(rr, newObj(gvk.ConfigMap, "test-config", "held-ns", staleAnns))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(deleteOther).To(BeTrue())
+	})
+
+	t.Run("ignores a held chart that was never deployed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newTestRR(nil)
+		rr.Generated = true
+
+		g.Expect(protectHeldCharts(rr, &corev1.ConfigMap{}, []heldChart{{chart: chart}})).To(Succeed())
+		g.Expect(heldObjects(rr)).To(BeEmpty())
+	})
+
+	t.Run("fails when a deployed held chart has no recorded inventory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newTestRR(nil)
+		rr.Generated = true
+
+		held := []heldChart{{chart: chart, deployedVersion: "0.0.9"}}
+
+		g.Expect(protectHeldCharts(rr, &corev1.ConfigMap{}, held)).To(MatchError(ContainSubstring("no inventory recorded for held chart test")))
+	})
+}
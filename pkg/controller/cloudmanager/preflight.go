@@ -0,0 +1,34 @@
+package cloudmanager
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/cloudmanager/preflight"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+)
+
+// Preflight registers the pre-flight action, running the default checks followed by checks,
+// and its PreflightSucceeded condition. It must be composed before any action that deploys
+// resources, so that a cluster failing a required check is reported before anything is applied.
+func Preflight[T common.PlatformObject](mgr ctrl.Manager, checks ...preflight.Check) func(*reconciler.ReconcilerBuilder[T]) {
+	return func(b *reconciler.ReconcilerBuilder[T]) {
+		b.WithActionE(newPreflightAction(mgr.GetConfig(), checks)).
+			WithConditions(status.ConditionPreflightSucceeded)
+	}
+}
+
+func newPreflightAction(cfg *rest.Config, checks []preflight.Check) (actions.Fn, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	return preflight.NewAction(append(preflight.DefaultChecks(dc), checks...)...), nil
+}
@@ -0,0 +1,210 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// MinKubernetesVersion is the oldest Kubernetes version the cloud manager dependencies support.
+	MinKubernetesVersion = "1.30.0"
+
+	// defaultStorageClassAnnotation marks the cluster default StorageClass.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+	// podSecurityEnforceLabel sets the Pod Security admission level enforced in a namespace.
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+)
+
+var (
+	// RequiredAPIGroups are the API groups the cloud manager deploys its dependencies with.
+	RequiredAPIGroups = []string{
+		"apps",
+		"rbac.authorization.k8s.io",
+		"admissionregistration.k8s.io",
+		"apiextensions.k8s.io",
+	}
+
+	// SupportedArchitectures are the node architectures the dependency images are built for.
+	SupportedArchitectures = []string{"amd64", "arm64"}
+)
+
+// KubernetesVersion checks that the API server runs at least minVersion.
+// Pre-release and build metadata of the server version (e.g. "-gke.100" or "+k3s1") are ignored.
+func KubernetesVersion(dc discovery.DiscoveryInterface, minVersion string) Check {
+	return Check{
+		Name:     "KubernetesVersion",
+		Required: true,
+		Run: func(_ context.Context, _ *types.ReconciliationRequest) error {
+			floor, err := semver.ParseTolerant(minVersion)
+			if err != nil {
+				return fmt.Errorf("invalid minimum version %q: %w", minVersion, err)
+			}
+
+			info, err := dc.ServerVersion()
+			if err != nil {
+				return fmt.Errorf("failed to get server version: %w", err)
+			}
+
+			v, err := semver.ParseTolerant(info.GitVersion)
+			if err != nil {
+				return fmt.Errorf("failed to parse server version %q: %w", info.GitVersion, err)
+			}
+
+			if (semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}).LT(floor) {
+				return fmt.Errorf("server version %s is older than %s", info.GitVersion, minVersion)
+			}
+
+			return nil
+		},
+	}
+}
+
+// APIGroups checks that the API server serves all groups.
+func APIGroups(dc discovery.DiscoveryInterface, groups ...string) Check {
+	return Check{
+		Name:     "APIGroups",
+		Required: true,
+		Run: func(_ context.Context, _ *types.ReconciliationRequest) error {
+			served, err := dc.ServerGroups()
+			if err != nil {
+				return fmt.Errorf("failed to get server groups: %w", err)
+			}
+
+			names := make(map[string]struct{}, len(served.Groups))
+			for _, g := range served.Groups {
+				names[g.Name] = struct{}{}
+			}
+
+			var missing []string
+			for _, g := range groups {
+				if _, ok := names[g]; !ok {
+					missing = append(missing, g)
+				}
+			}
+
+			if len(missing) > 0 {
+				return fmt.Errorf("missing API groups: %s", strings.Join(missing, ", "))
+			}
+
+			return nil
+		},
+	}
+}
+
+// NodeArchitectures checks that all nodes run one of the supported architectures,
+// so that dependency pods can be scheduled on any node.
+func NodeArchitectures(supported ...string) Check {
+	return Check{
+		Name: "NodeArchitectures",
+		Run: func(ctx context.Context, rr *types.ReconciliationRequest) error {
+			nodes := &corev1.NodeList{}
+			if err := rr.Client.List(ctx, nodes); err != nil {
+				return fmt.Errorf("failed to list nodes: %w", err)
+			}
+
+			var unsupported []string
+			for _, node := range nodes.Items {
+				arch := node.Status.NodeInfo.Architecture
+				if !slices.Contains(supported, arch) && !slices.Contains(unsupported, arch) {
+					unsupported = append(unsupported, arch)
+				}
+			}
+
+			if len(unsupported) > 0 {
+				slices.Sort(unsupported)
+				return fmt.Errorf("nodes with unsupported architectures: %s", strings.Join(unsupported, ", "))
+			}
+
+			return nil
+		},
+	}
+}
+
+// DefaultStorageClass checks that the cluster has a default StorageClass.
+func DefaultStorageClass() Check {
+	return Check{
+		Name: "DefaultStorageClass",
+		Run: func(ctx context.Context, rr *types.ReconciliationRequest) error {
+			classes := &storagev1.StorageClassList{}
+			if err := rr.Client.List(ctx, classes); err != nil {
+				return fmt.Errorf("failed to list StorageClasses: %w", err)
+			}
+
+			for _, sc := range classes.Items {
+				if sc.Annotations[defaultStorageClassAnnotation] == "true" {
+					return nil
+				}
+			}
+
+			return errors.New("no default StorageClass")
+		},
+	}
+}
+
+// PodSecurity checks that the namespaces of the managed dependencies do not enforce
+// the restricted Pod Security level, which the dependency operators do not comply with.
+// Namespaces that do not exist yet are created by the dependency charts and pass the check.
+func PodSecurity() Check {
+	return Check{
+		Name: "PodSecurity",
+		Run: func(ctx context.Context, rr *types.ReconciliationRequest) error {
+			ke, ok := rr.Instance.(ccmcommon.KubernetesEngineInstance)
+			if !ok {
+				return nil
+			}
+
+			var restricted []string
+			for _, name := range dependencyNamespaces(ke.GetDependencies()) {
+				ns := &corev1.Namespace{}
+				if err := rr.Client.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+					if k8serr.IsNotFound(err) {
+						continue
+					}
+
+					return fmt.Errorf("failed to get namespace %s: %w", name, err)
+				}
+
+				if ns.Labels[podSecurityEnforceLabel] == "restricted" {
+					restricted = append(restricted, name)
+				}
+			}
+
+			if len(restricted) > 0 {
+				return fmt.Errorf("namespaces enforcing the restricted Pod Security level: %s", strings.Join(restricted, ", "))
+			}
+
+			return nil
+		},
+	}
+}
+
+// dependencyNamespaces returns the namespaces of the managed dependencies.
+func dependencyNamespaces(deps ccmcommon.Dependencies) []string {
+	var namespaces []string
+
+	if deps.LWS.ManagementPolicy != ccmcommon.Unmanaged {
+		namespaces = append(namespaces, deps.LWS.GetNamespace())
+	}
+	if deps.SailOperator.ManagementPolicy != ccmcommon.Unmanaged {
+		namespaces = append(namespaces, deps.SailOperator.GetNamespace())
+	}
+	if deps.KEDA.ManagementPolicy != ccmcommon.Unmanaged {
+		namespaces = append(namespaces, deps.KEDA.GetNamespace())
+	}
+
+	return namespaces
+}
@@ -0,0 +1,92 @@
+// Package preflight provides the cluster capability checks that the cloud manager
+// runs before deploying its dependencies.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/discovery"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// Check is a cluster capability check.
+type Check struct {
+	// Name identifies the check in the PreflightSucceeded condition message.
+	Name string
+	// Required checks stop the reconciliation when they fail. The failure of other
+	// checks is only reported in the PreflightSucceeded condition.
+	Required bool
+	// Run returns an error describing why the cluster does not pass the check.
+	Run func(ctx context.Context, rr *types.ReconciliationRequest) error
+}
+
+// DefaultChecks returns the checks run for every cloud manager provider.
+func DefaultChecks(dc discovery.DiscoveryInterface) []Check {
+	return []Check{
+		KubernetesVersion(dc, MinKubernetesVersion),
+		APIGroups(dc, RequiredAPIGroups...),
+		NodeArchitectures(SupportedArchitectures...),
+		DefaultStorageClass(),
+		PodSecurity(),
+	}
+}
+
+// NewAction returns an action that runs checks and reports their results in the
+// PreflightSucceeded condition. When a required check fails, the reconciliation
+// is stopped before anything is deployed.
+//
+// Errors returned by a check, including API errors, are reported as failures of
+// the check and are retried on the next reconciliation.
+func NewAction(checks ...Check) actions.Fn {
+	return func(ctx context.Context, rr *types.ReconciliationRequest) error {
+		results := make([]string, 0, len(checks))
+		var failed, requiredFailed bool
+
+		for _, check := range checks {
+			if err := check.Run(ctx, rr); err != nil {
+				failed = true
+				requiredFailed = requiredFailed || check.Required
+				results = append(results, fmt.Sprintf("%s: %v", check.Name, err))
+
+				continue
+			}
+
+			results = append(results, check.Name+": passed")
+		}
+
+		message := strings.Join(results, "; ")
+
+		switch {
+		case requiredFailed:
+			rr.Conditions.MarkFalse(
+				status.ConditionPreflightSucceeded,
+				conditions.WithReason(status.PreflightFailedReason),
+				conditions.WithMessage("%s", message),
+			)
+
+			return odherrors.NewStopError("pre-flight checks failed: %s", message)
+		case failed:
+			rr.Conditions.MarkFalse(
+				status.ConditionPreflightSucceeded,
+				conditions.WithReason(status.PreflightWarningReason),
+				conditions.WithMessage("%s", message),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+		default:
+			rr.Conditions.MarkTrue(
+				status.ConditionPreflightSucceeded,
+				conditions.WithMessage("%s", message),
+			)
+		}
+
+		return nil
+	}
+}
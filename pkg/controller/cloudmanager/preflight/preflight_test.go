@@ -0,0 +1,239 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ccmv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
+	ccmcommon "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/cloudmanager/preflight"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRR(t *testing.T, deps ccmcommon.Dependencies, objects ...client.Object) *types.ReconciliationRequest {
+	t.Helper()
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(objects...))
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+
+	instance := &ccmv1alpha1.AzureKubernetesEngine{
+		Spec: ccmv1alpha1.AzureKubernetesEngineSpec{Dependencies: deps},
+	}
+	rr := &types.ReconciliationRequest{Client: cl, Instance: instance}
+	rr.Conditions = conditions.NewManager(instance, status.ConditionTypeReady, status.ConditionPreflightSucceeded)
+
+	return rr
+}
+
+func newDiscovery(gitVersion string, groupVersions ...string) *fakediscovery.FakeDiscovery {
+	dc := &fakediscovery.FakeDiscovery{
+		Fake:               &clienttesting.Fake{},
+		FakedServerVersion: &version.Info{GitVersion: gitVersion},
+	}
+	for _, gv := range groupVersions {
+		dc.Resources = append(dc.Resources, &metav1.APIResourceList{GroupVersion: gv})
+	}
+
+	return dc
+}
+
+func check(name string, required bool, err error) preflight.Check {
+	return preflight.Check{
+		Name:     name,
+		Required: required,
+		Run: func(context.Context, *types.ReconciliationRequest) error {
+			return err
+		},
+	}
+}
+
+func TestNewAction(t *testing.T) {
+	tests := []struct {
+		name            string
+		checks          []preflight.Check
+		expectErr       bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "all checks pass",
+			checks:          []preflight.Check{check("A", true, nil), check("B", false, nil)},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedMessage: "A: passed; B: passed",
+		},
+		{
+			name:            "advisory check failure is a warning",
+			checks:          []preflight.Check{check("A", true, nil), check("B", false, errors.New("boom"))},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  status.PreflightWarningReason,
+			expectedMessage: "A: passed; B: boom",
+		},
+		{
+			name:            "required check failure stops the reconciliation",
+			checks:          []preflight.Check{check("A", true, errors.New("boom")), check("B", false, nil)},
+			expectErr:       true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  status.PreflightFailedReason,
+			expectedMessage: "A: boom; B: passed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			rr := newRR(t, ccmcommon.Dependencies{})
+
+			err := preflight.NewAction(tt.checks...)(t.Context(), rr)
+			if tt.expectErr {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedMessage)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			cond := rr.Conditions.GetCondition(status.ConditionPreflightSucceeded)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(tt.expectedStatus))
+			g.Expect(cond.Message).To(Equal(tt.expectedMessage))
+			if tt.expectedReason != "" {
+				g.Expect(cond.Reason).To(Equal(tt.expectedReason))
+			}
+		})
+	}
+}
+
+func TestKubernetesVersion(t *testing.T) {
+	tests := []struct {
+		gitVersion string
+		expectErr  bool
+	}{
+		{gitVersion: "v1.30.0"},
+		{gitVersion: "v1.31.2-gke.100"},
+		{gitVersion: "v1.30.0+k3s1"},
+		{gitVersion: "v1.29.9", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.gitVersion, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := preflight.KubernetesVersion(newDiscovery(tt.gitVersion), preflight.MinKubernetesVersion)
+			err := c.Run(t.Context(), nil)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestAPIGroups(t *testing.T) {
+	g := NewWithT(t)
+	dc := newDiscovery("v1.30.0", "v1", "apps/v1", "rbac.authorization.k8s.io/v1")
+
+	g.Expect(preflight.APIGroups(dc, "apps", "rbac.authorization.k8s.io").Run(t.Context(), nil)).To(Succeed())
+	g.Expect(preflight.APIGroups(dc, preflight.RequiredAPIGroups...).Run(t.Context(), nil)).To(
+		MatchError("missing API groups: admissionregistration.k8s.io, apiextensions.k8s.io"),
+	)
+}
+
+func TestNodeArchitectures(t *testing.T) {
+	node := func(name string, arch string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{Architecture: arch}},
+		}
+	}
+
+	t.Run("supported architectures pass", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{}, node("a", "amd64"), node("b", "arm64"))
+
+		g.Expect(preflight.NodeArchitectures(preflight.SupportedArchitectures...).Run(t.Context(), rr)).To(Succeed())
+	})
+
+	t.Run("unsupported architectures are reported once", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{}, node("a", "s390x"), node("b", "ppc64le"), node("c", "s390x"))
+
+		g.Expect(preflight.NodeArchitectures(preflight.SupportedArchitectures...).Run(t.Context(), rr)).To(
+			MatchError("nodes with unsupported architectures: ppc64le, s390x"),
+		)
+	})
+}
+
+func TestDefaultStorageClass(t *testing.T) {
+	sc := func(name string, isDefault string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": isDefault},
+			},
+			Provisioner: "example.com/provisioner",
+		}
+	}
+
+	t.Run("default StorageClass passes", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{}, sc("standard", "false"), sc("premium", "true"))
+
+		g.Expect(preflight.DefaultStorageClass().Run(t.Context(), rr)).To(Succeed())
+	})
+
+	t.Run("no default StorageClass fails", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{}, sc("standard", "false"))
+
+		g.Expect(preflight.DefaultStorageClass().Run(t.Context(), rr)).To(HaveOccurred())
+	})
+}
+
+func TestPodSecurity(t *testing.T) {
+	restricted := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ccmcommon.DefaultNamespaceKEDAOperator,
+			Labels: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+		},
+	}
+
+	t.Run("managed dependency in a restricted namespace fails", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{
+			LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
+			SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Managed},
+			KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Managed},
+		}, restricted)
+
+		g.Expect(preflight.PodSecurity().Run(t.Context(), rr)).To(
+			MatchError(ContainSubstring(ccmcommon.DefaultNamespaceKEDAOperator)),
+		)
+	})
+
+	t.Run("unmanaged dependency in a restricted namespace passes", func(t *testing.T) {
+		g := NewWithT(t)
+		rr := newRR(t, ccmcommon.Dependencies{
+			LWS:          ccmcommon.LWSDependency{ManagementPolicy: ccmcommon.Unmanaged},
+			SailOperator: ccmcommon.SailOperatorDependency{ManagementPolicy: ccmcommon.Unmanaged},
+			KEDA:         ccmcommon.KEDADependency{ManagementPolicy: ccmcommon.Unmanaged},
+		}, restricted)
+
+		g.Expect(preflight.PodSecurity().Run(t.Context(), rr)).To(Succeed())
+	})
+}
@@ -48,6 +48,11 @@ func GatewayStatusChanged() predicate.Predicate {
 	return fwres.StatusChanged()
 }
 
+// CertificateStatusChanged returns a predicate that watches for cert-manager Certificate status changes.
+func CertificateStatusChanged() predicate.Predicate {
+	return fwres.StatusChanged()
+}
+
 // FIXME: is this function correct? By default CreateFunc and UpdateFunc are true.
 var DSCDeletionPredicate = predicate.Funcs{
 	DeleteFunc: func(e event.DeleteEvent) bool {
@@ -107,6 +112,12 @@ var DSCComponentUpdatePredicate = predicate.Funcs{
 
 // HTTPRouteReferencesGateway returns a predicate that filters HTTPRoutes referencing the specified gateway.
 func HTTPRouteReferencesGateway(gatewayName, gatewayNamespace string) predicate.Predicate {
+	return HTTPRouteReferencesGateways(func(name string) bool { return name == gatewayName }, gatewayNamespace)
+}
+
+// HTTPRouteReferencesGateways returns a predicate that filters HTTPRoutes referencing a gateway of
+// gatewayNamespace whose name is accepted by matchesGateway.
+func HTTPRouteReferencesGateways(matchesGateway func(name string) bool, gatewayNamespace string) predicate.Predicate {
 	getHTTPRoute := func(obj client.Object) (*gwapiv1.HTTPRoute, bool) {
 		httpRoute, ok := obj.(*gwapiv1.HTTPRoute)
 		if ok {
@@ -134,7 +145,7 @@ func HTTPRouteReferencesGateway(gatewayName, gatewayNamespace string) predicate.
 			if ref.Namespace != nil {
 				refNamespace = string(*ref.Namespace)
 			}
-			if string(ref.Name) == gatewayName && refNamespace == gatewayNamespace {
+			if refNamespace == gatewayNamespace && matchesGateway(string(ref.Name)) {
 				return true
 			}
 		}
@@ -1260,6 +1260,30 @@ func TestHTTPRouteReferencesGateway_DefaultNamespace(t *testing.T) {
 	g.Expect(predicate.Create(event.CreateEvent{Object: routeWithoutNamespace})).To(BeTrue())
 }
 
+func TestHTTPRouteReferencesGateways(t *testing.T) {
+	t.Parallel()
+
+	g := NewWithT(t)
+
+	predicate := resources.HTTPRouteReferencesGateways(func(name string) bool {
+		return name == "my-gateway" || name == "my-gateway-shard"
+	}, "my-namespace")
+
+	newRoute := func(gatewayName string) *gwapiv1.HTTPRoute {
+		return &gwapiv1.HTTPRoute{
+			Spec: gwapiv1.HTTPRouteSpec{
+				CommonRouteSpec: gwapiv1.CommonRouteSpec{
+					ParentRefs: []gwapiv1.ParentReference{{Name: gwapiv1.ObjectName(gatewayName)}},
+				},
+			},
+		}
+	}
+
+	g.Expect(predicate.Create(event.CreateEvent{Object: newRoute("my-gateway")})).To(BeTrue())
+	g.Expect(predicate.Update(event.UpdateEvent{ObjectOld: newRoute("my-gateway-shard"), ObjectNew: newRoute("my-gateway-shard")})).To(BeTrue())
+	g.Expect(predicate.Delete(event.DeleteEvent{Object: newRoute("other-gateway")})).To(BeFalse())
+}
+
 func TestAPIServerTLSSecurityProfileChanged(t *testing.T) {
 	t.Parallel()
 
@@ -68,6 +68,11 @@ func Classify(report *clusterhealth.Report) FailureClassification {
 		return *fc
 	}
 
+	// Sections without a dedicated classifier, including sections registered outside clusterhealth.
+	if fc := classifyFromSections(report); fc != nil {
+		return *fc
+	}
+
 	// All sections collected successfully but no infrastructure pattern matched.
 	// The root cause is unknown — callers should not assume the test is at fault.
 	if report.Healthy() {
@@ -395,6 +400,44 @@ func classifyFromCRConditions(name string, section clusterhealth.SectionResult[c
 	}
 }
 
+// dedicatedSections are the sections inspected by the classifiers above; classifyFromSections skips them.
+var dedicatedSections = map[string]bool{
+	clusterhealth.SectionPods:        true,
+	clusterhealth.SectionEvents:      true,
+	clusterhealth.SectionQuotas:      true,
+	clusterhealth.SectionNodes:       true,
+	clusterhealth.SectionDeployments: true,
+	clusterhealth.SectionOperator:    true,
+	clusterhealth.SectionDSCI:        true,
+	clusterhealth.SectionDSC:         true,
+}
+
+// classifyFromSections checks the remaining sections of the report's registry in registration order.
+// A section error alone may be a collection failure (e.g. RBAC), so a section is only classified
+// when it also reports unhealthy items.
+func classifyFromSections(report *clusterhealth.Report) *FailureClassification {
+	for _, s := range report.Sections() {
+		if dedicatedSections[s.Name()] {
+			continue
+		}
+		errMsg := s.Error(report)
+		if errMsg == "" {
+			continue
+		}
+		if _, unhealthy := s.Counts(report); unhealthy == 0 {
+			continue
+		}
+		return &FailureClassification{
+			Category:    CategoryInfrastructure,
+			Subcategory: s.Name() + "-unhealthy",
+			ErrorCode:   CodeInfraUnknown,
+			Evidence:    []string{fmt.Sprintf("%s unhealthy: %s", s.DisplayName(), errMsg)},
+			Confidence:  ConfidenceLow,
+		}
+	}
+	return nil
+}
+
 // isSuccessfulTermination returns true if the terminated string indicates
 // a container that completed successfully (exit code 0). The terminated
 // string format from clusterhealth is "{Reason} (exit {Code})[: {Message}]".
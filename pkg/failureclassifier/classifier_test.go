@@ -2,9 +2,12 @@
 package failureclassifier
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth"
 )
 
@@ -1088,6 +1091,81 @@ func TestClassify_DSC(t *testing.T) {
 	assertClassification(t, got, CategoryInfrastructure, "dsc-unhealthy", CodeDSC, ConfidenceMedium)
 }
 
+func TestClassify_Sections(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *clusterhealth.Report
+		want   string
+	}{
+		{
+			name: "unhealthy webhook classifies as webhooks-unhealthy",
+			report: &clusterhealth.Report{
+				Webhooks: clusterhealth.SectionResult[clusterhealth.WebhooksSection]{
+					Error: "1 webhook(s) unhealthy",
+					Data: clusterhealth.WebhooksSection{Webhooks: []clusterhealth.WebhookInfo{
+						{Name: "validating.example.io", UnhealthyReason: "no ready endpoints"},
+					}},
+				},
+			},
+			want: "webhooks-unhealthy",
+		},
+		{
+			name: "expiring certificate classifies as certificates-unhealthy",
+			report: &clusterhealth.Report{
+				Certificates: clusterhealth.SectionResult[clusterhealth.CertificatesSection]{
+					Error: "1 certificate(s) unhealthy",
+					Data: clusterhealth.CertificatesSection{Certificates: []clusterhealth.CertificateInfo{
+						{Name: "webhook-cert", UnhealthyReason: "expires in 2 days"},
+					}},
+				},
+			},
+			want: "certificates-unhealthy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Classify(tt.report)
+			assertClassification(t, got, CategoryInfrastructure, tt.want, CodeInfraUnknown, ConfidenceLow)
+		})
+	}
+
+	t.Run("collection error without unhealthy items is not classified", func(t *testing.T) {
+		report := &clusterhealth.Report{
+			CRDs: clusterhealth.SectionResult[clusterhealth.CRDsSection]{Error: "forbidden"},
+		}
+		got := Classify(report)
+		assertClassification(t, got, CategoryUnknown, "unclassifiable", CodeUnclassifiable, ConfidenceLow)
+	})
+}
+
+// stubClient satisfies client.Client for sections that never call the API.
+type stubClient struct {
+	client.Client
+}
+
+func TestClassify_RegisteredSection(t *testing.T) {
+	reg := clusterhealth.NewRegistry()
+	reg.MustRegister(clusterhealth.NewSection(clusterhealth.SectionSpec[[]string]{
+		Name:        "licenses",
+		DisplayName: "Licenses",
+		Run: func(context.Context, clusterhealth.Config) clusterhealth.SectionResult[[]string] {
+			return clusterhealth.SectionResult[[]string]{Error: "expired licenses: a", Data: []string{"a"}}
+		},
+	}))
+
+	report, err := clusterhealth.Run(context.Background(), clusterhealth.Config{Client: stubClient{}, Registry: reg})
+	if err != nil {
+		t.Fatalf("clusterhealth.Run error: %v", err)
+	}
+
+	got := Classify(report)
+	assertClassification(t, got, CategoryInfrastructure, "licenses-unhealthy", CodeInfraUnknown, ConfidenceLow)
+	if got.Evidence[0] != "Licenses unhealthy: expired licenses: a" {
+		t.Errorf("Evidence = %v, want the section error", got.Evidence)
+	}
+}
+
 func TestClassify_SuccessfulTerminationNotFlagged(t *testing.T) {
 	report := &clusterhealth.Report{
 		Pods: clusterhealth.SectionResult[clusterhealth.PodsSection]{
@@ -2,7 +2,10 @@ module github.com/opendatahub-io/opendatahub-operator/pkg/failureclassifier
 
 go 1.25.7
 
-require github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth v0.0.0
+require (
+	github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth v0.0.0
+	sigs.k8s.io/controller-runtime v0.22.4
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -42,7 +45,6 @@ require (
 	k8s.io/klog/v2 v2.130.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20250910181357-589584f1c912 // indirect
 	k8s.io/utils v0.0.0-20251002143259-bc988d571ff4 // indirect
-	sigs.k8s.io/controller-runtime v0.22.4 // indirect
 	sigs.k8s.io/json v0.0.0-20250730193827-2d320260d730 // indirect
 	sigs.k8s.io/randfill v1.0.0 // indirect
 	sigs.k8s.io/structured-merge-diff/v6 v6.3.0 // indirect
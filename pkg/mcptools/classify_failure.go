@@ -20,8 +20,7 @@ func registerClassifyFailure(s *server.MCPServer, kubeClient client.Client) {
 			"deterministically. Returns a FailureClassification with category, "+
 			"subcategory, error code, evidence, and confidence."),
 		mcp.WithString("sections",
-			mcp.Description("Comma-separated sections: nodes,deployments,pods,"+
-				"events,quotas,operator,dsci,dsc. Omit for all.")),
+			mcp.Description("Comma-separated sections: "+sectionNames()+". Omit for all.")),
 		mcp.WithString("layer",
 			mcp.Description("Comma-separated layers: infrastructure,workload,"+
 				"operator. Ignored if sections is set. Omit for all.")),
@@ -139,3 +139,13 @@ func splitTrimmed(s string) []string {
 	}
 	return out
 }
+
+// sectionNames returns the comma-separated names of the sections in the default clusterhealth registry.
+func sectionNames() string {
+	sections := clusterhealth.DefaultRegistry().Sections()
+	names := make([]string, 0, len(sections))
+	for _, s := range sections {
+		names = append(names, s.Name())
+	}
+	return strings.Join(names, ",")
+}
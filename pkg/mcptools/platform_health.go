@@ -20,8 +20,7 @@ func registerPlatformHealth(s *server.MCPServer, kubeClient client.Client) {
 			"cluster and return the full report as JSON. Checks nodes, deployments, "+
 			"pods, events, quotas, operator status, DSCI, and DSC."),
 		mcp.WithString("sections",
-			mcp.Description("Comma-separated sections: nodes,deployments,pods,"+
-				"events,quotas,operator,dsci,dsc. Omit for all.")),
+			mcp.Description("Comma-separated sections: "+sectionNames()+". Omit for all.")),
 		mcp.WithString("layer",
 			mcp.Description("Comma-separated layers: infrastructure,workload,"+
 				"operator. Ignored if sections is set. Omit for all.")),
@@ -101,59 +100,18 @@ type SectionSummary struct {
 	Issues int    `json:"issues"`
 }
 
-func section[T any](err string, items []T, isUnhealthy func(T) bool) SectionSummary {
-	issues := 0
-	for _, item := range items {
-		if isUnhealthy(item) {
-			issues++
-		}
-	}
-	status := "ok"
-	if err != "" {
-		status = "error"
-	}
-	return SectionSummary{Status: status, Error: err, Count: len(items), Issues: issues}
-}
-
-func flattenMap[T any](m map[string][]T) []T {
-	var out []T
-	for _, v := range m {
-		out = append(out, v...)
-	}
-	return out
-}
-
+// summarizeReport builds a compact summary with one entry per section that ran, including
+// sections registered in the clusterhealth registry outside the built-in set.
 func summarizeReport(report *clusterhealth.Report) *HealthSummary {
-	ran := make(map[string]bool, len(report.SectionsRun))
-	for _, name := range report.SectionsRun {
-		ran[name] = true
-	}
-
-	all := map[string]SectionSummary{
-		"nodes":       section(report.Nodes.Error, report.Nodes.Data.Nodes, func(n clusterhealth.NodeInfo) bool { return n.UnhealthyReason != "" }),
-		"deployments": section(report.Deployments.Error, flattenMap(report.Deployments.Data.ByNamespace), func(d clusterhealth.DeploymentInfo) bool { return d.Ready < d.Replicas }),
-		"pods":        section(report.Pods.Error, flattenMap(report.Pods.Data.ByNamespace), func(p clusterhealth.PodInfo) bool { return p.Phase != "Running" && p.Phase != "Succeeded" }),
-		"events":      section(report.Events.Error, report.Events.Data.Events, func(clusterhealth.EventInfo) bool { return false }),
-		"quotas":      section(report.Quotas.Error, flattenMap(report.Quotas.Data.ByNamespace), func(q clusterhealth.ResourceQuotaInfo) bool { return len(q.Exceeded) > 0 }),
-		"dsci":        section(report.DSCI.Error, report.DSCI.Data.Conditions, func(clusterhealth.ConditionSummary) bool { return false }),
-		"dsc":         section(report.DSC.Error, report.DSC.Data.Conditions, func(clusterhealth.ConditionSummary) bool { return false }),
-	}
-
-	opStatus := "ok"
-	if report.Operator.Error != "" {
-		opStatus = "error"
-	}
-	opIssues := 0
-	if d := report.Operator.Data.Deployment; d != nil && d.Ready < d.Replicas {
-		opIssues = 1
-	}
-	all["operator"] = SectionSummary{Status: opStatus, Error: report.Operator.Error, Count: 1, Issues: opIssues}
-
-	sections := make(map[string]SectionSummary, len(ran))
-	for name, summary := range all {
-		if ran[name] {
-			sections[name] = summary
+	sections := make(map[string]SectionSummary)
+	for _, s := range report.Sections() {
+		errMsg := s.Error(report)
+		status := "ok"
+		if errMsg != "" {
+			status = "error"
 		}
+		count, issues := s.Counts(report)
+		sections[s.Name()] = SectionSummary{Status: status, Error: errMsg, Count: count, Issues: issues}
 	}
 
 	return &HealthSummary{
@@ -63,7 +63,7 @@ func TestPlatformHealth(t *testing.T) {
 	}{
 		{"default args", nil, nil},
 		{"sections filter", map[string]interface{}{"sections": "nodes,pods"}, []string{"nodes", "pods"}},
-		{"layer filter", map[string]interface{}{"layer": "infrastructure"}, []string{"nodes", "quotas"}},
+		{"layer filter", map[string]interface{}{"layer": "infrastructure"}, []string{"nodes", "quotas", "crds"}},
 		{"sections precedence", map[string]interface{}{"sections": "nodes", "layer": "operator"}, []string{"nodes"}},
 		{"custom namespace", map[string]interface{}{"operator_namespace": "custom-ns", "sections": "nodes"}, []string{"nodes"}},
 	}
@@ -130,3 +130,37 @@ func TestSummarizeReport(t *testing.T) {
 		})
 	}
 }
+
+func TestSummarizeReport_RegisteredSection(t *testing.T) {
+	reg := clusterhealth.NewDefaultRegistry()
+	reg.MustRegister(clusterhealth.NewSection(clusterhealth.SectionSpec[[]string]{
+		Name: "licenses",
+		Run: func(context.Context, clusterhealth.Config) clusterhealth.SectionResult[[]string] {
+			return clusterhealth.SectionResult[[]string]{Error: "expired licenses: a", Data: []string{"a", "b"}}
+		},
+		Counts: func(res clusterhealth.SectionResult[[]string]) (int, int) {
+			return len(res.Data), 1
+		},
+	}))
+
+	report, err := clusterhealth.Run(context.Background(), clusterhealth.Config{
+		Client:       newFakeClient(),
+		OnlySections: []string{"nodes", "licenses"},
+		Registry:     reg,
+	})
+	if err != nil {
+		t.Fatalf("clusterhealth.Run error: %v", err)
+	}
+
+	summary := summarizeReport(report)
+	if summary.Healthy {
+		t.Error("Healthy = true, want false when a registered section fails")
+	}
+	if len(summary.Sections) != 2 {
+		t.Errorf("Sections = %v, want nodes and licenses", summary.Sections)
+	}
+	want := SectionSummary{Status: "error", Error: "expired licenses: a", Count: 2, Issues: 1}
+	if got := summary.Sections["licenses"]; got != want {
+		t.Errorf("licenses = %+v, want %+v", got, want)
+	}
+}
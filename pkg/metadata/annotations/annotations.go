@@ -25,6 +25,9 @@ const (
 	SecretOauthClientAnnotation = "secret-generator.opendatahub.io/oauth-client-route"
 )
 
+// SecretRotatedAt records when the values of an operator generated secret were last rotated (RFC3339).
+const SecretRotatedAt = "opendatahub.io/secret-rotated-at"
+
 // ManagementStateAnnotation set on Component CR only, to show which ManagementState value if defined in DSC for the component.
 const ManagementStateAnnotation = "component.opendatahub.io/management-state"
 
@@ -52,6 +55,21 @@ const PSAElevatedBy = "opendatahub.io/psa-elevated-by"
 // Gateway-to-Authorino TLS when Authorino uses a TLS listener.
 const AuthorinoTLSBootstrap = "security.opendatahub.io/authorino-tls-bootstrap"
 
+// Hardware profile referenced by a workload. The namespace defaults to the namespace of the workload.
+const (
+	HardwareProfileName      = "opendatahub.io/hardware-profile-name"
+	HardwareProfileNamespace = "opendatahub.io/hardware-profile-namespace"
+)
+
+// Dashboard metadata of a HardwareProfile.
+const (
+	HardwareProfileDisplayName = "opendatahub.io/display-name"
+	HardwareProfileDescription = "opendatahub.io/description"
+	HardwareProfileDisabled    = "opendatahub.io/disabled"
+	// DashboardFeatureVisibility lists, as a JSON array, the dashboard areas the resource is offered in.
+	DashboardFeatureVisibility = "opendatahub.io/dashboard-feature-visibility"
+)
+
 // Connection annotation for referencing secrets containing connection information.
 const Connection = "opendatahub.io/connections"
 
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/spf13/viper"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/cloudmanager/preflight"
 )
 
 const (
@@ -29,6 +31,10 @@ type CloudManagerConfig struct {
 
 	// DefaultChartsPath is the base directory for locally-bundled Helm charts.
 	DefaultChartsPath string `mapstructure:"default-charts-path"`
+
+	// PreflightChecks are the provider-specific pre-flight checks, run after the default ones.
+	// They are set from the provider and cannot be configured.
+	PreflightChecks []preflight.Check `mapstructure:"-"`
 }
 
 // BuildCloudManagerConfig builds the cloud manager configuration from viper values.
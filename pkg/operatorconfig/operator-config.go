@@ -45,6 +45,9 @@ type OperatorSettings struct {
 	ManifestsBasePath string `mapstructure:"default-manifests-path"`
 	ChartsBasePath    string `mapstructure:"default-charts-path"`
 	PlatformType      string `mapstructure:"platform-type"`
+
+	// DashboardHardwareProfileMode is the deprecation mode of the dashboard HardwareProfile webhook.
+	DashboardHardwareProfileMode string `mapstructure:"dashboard-hardwareprofile-mode"`
 }
 
 // IsDSCICreationDisabled returns true if automatic DSCI creation is disabled.
@@ -45,11 +45,7 @@ const (
 	hardwareProfileNameAnnotation         = "opendatahub.io/hardware-profile-name"
 	hardwareProfileNamespaceAnnotation    = "opendatahub.io/hardware-profile-namespace"
 	hardwareProfileManagedAnnotation      = "opendatahub.io/managed"
-	hardwareProfileVisibilityAnnotation   = "opendatahub.io/dashboard-feature-visibility"
 	hardwareProfileModifiedDateAnnotation = "opendatahub.io/modified-date"
-	hardwareProfileDisplayNameAnnotation  = "opendatahub.io/display-name"
-	hardwareProfileDescriptionAnnotation  = "opendatahub.io/description"
-	hardwareProfileDisabledAnnotation     = "opendatahub.io/disabled"
 	featureVisibilityModelServing         = `["model-serving"]`
 	featureVisibilityWorkbench            = `["workbench"]`
 	containerSizeHWPPrefix                = "containersize-"
@@ -28,6 +28,7 @@ import (
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhAnnotations "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 // ContainerSize represents a container size configuration from OdhDashboardConfig.
@@ -676,11 +677,11 @@ func setHardwareProfileAnnotation(ctx context.Context, cli client.Client, obj *u
 //   - map[string]string: A map of annotation keys to values
 func createHardwareProfileAnnotations(profileType, displayName, description string, disabled bool) map[string]string {
 	return map[string]string{
-		hardwareProfileVisibilityAnnotation:   getFeatureVisibility(profileType),
-		hardwareProfileModifiedDateAnnotation: time.Now().Format(time.RFC3339),
-		hardwareProfileDisplayNameAnnotation:  displayName,
-		hardwareProfileDescriptionAnnotation:  description,
-		hardwareProfileDisabledAnnotation:     strconv.FormatBool(disabled),
+		odhAnnotations.DashboardFeatureVisibility: getFeatureVisibility(profileType),
+		hardwareProfileModifiedDateAnnotation:     time.Now().Format(time.RFC3339),
+		odhAnnotations.HardwareProfileDisplayName: displayName,
+		odhAnnotations.HardwareProfileDescription: description,
+		odhAnnotations.HardwareProfileDisabled:    strconv.FormatBool(disabled),
 	}
 }
 
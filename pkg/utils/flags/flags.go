@@ -123,6 +123,12 @@ func AddOperatorFlagsAndEnvvars(envvarPrefix string) error {
 		return err
 	}
 
+	pflag.String("dashboard-hardwareprofile-mode", "Deny", "How the deprecated dashboard HardwareProfiles are handled "+
+		"(Deny or Convert into infrastructure HardwareProfiles).")
+	if err := viper.BindEnv("dashboard-hardwareprofile-mode", "DASHBOARD_HARDWAREPROFILE_MODE"); err != nil {
+		return err
+	}
+
 	if err := addResourceSuppressionFlags(); err != nil {
 		return err
 	}
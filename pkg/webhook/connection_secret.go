@@ -0,0 +1,372 @@
+package webhookutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var (
+	// s3BucketRegexp follows the S3 bucket naming rules.
+	s3BucketRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// regionRegexp accepts cloud regions such as us-east-1 and custom regions used by S3 compatible stores.
+	regionRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// azureAccountRegexp follows the Azure storage account naming rules.
+	azureAccountRegexp = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	// azureContainerRegexp follows the Azure blob container naming rules.
+	azureContainerRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9]|-[a-z0-9]){2,62}$`)
+)
+
+// connectionSecretSchema describes the data keys a connection secret of a given type must provide.
+type connectionSecretSchema struct {
+	// required keys must be present with a non-empty value.
+	required []string
+	// oneOf keys must have at least one of them present with a non-empty value.
+	oneOf []string
+	// pairs are keys that must be set together: when one of them has a value, all of them must.
+	pairs [][]string
+	// optional keys are known keys whose value is not validated.
+	optional []string
+	// formats validates the value of the keys, when set.
+	formats map[string]func(string) error
+}
+
+// connectionSecretSchemas maps each connection type to the schema of its secret data.
+var connectionSecretSchemas = map[ConnectionType]connectionSecretSchema{
+	ConnectionTypeProtocolURI: {
+		oneOf: []string{"URI", "https-host"},
+		formats: map[string]func(string) error{
+			"URI":        validateURI,
+			"https-host": validateURI,
+		},
+	},
+	// S3 credentials are optional: buckets may be public or accessed with the workload identity.
+	ConnectionTypeProtocolS3: {
+		pairs: [][]string{{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"}},
+		formats: map[string]func(string) error{
+			"AWS_ACCESS_KEY_ID":     validateCredential,
+			"AWS_SECRET_ACCESS_KEY": validateCredential,
+			"AWS_S3_ENDPOINT":       validateEndpoint,
+			"AWS_DEFAULT_REGION":    matchFormat(regionRegexp, "a region such as 'us-east-1'"),
+			"AWS_S3_BUCKET":         matchFormat(s3BucketRegexp, "a valid S3 bucket name"),
+		},
+	},
+	ConnectionTypeProtocolOCI: {
+		required: []string{corev1.DockerConfigJsonKey},
+		optional: []string{"OCI_HOST", "ACCESS_TYPE"},
+		formats: map[string]func(string) error{
+			corev1.DockerConfigJsonKey: validateDockerConfigJSON,
+		},
+	},
+	ConnectionTypeProtocolGCS: {
+		required: []string{"GCS_BUCKET"},
+		formats: map[string]func(string) error{
+			"gcloud-application-credentials.json": validateJSON,
+		},
+	},
+	ConnectionTypeProtocolAzure: {
+		required: []string{"AZURE_STORAGE_ACCOUNT", "AZURE_STORAGE_CONTAINER"},
+		optional: []string{
+			"AZURE_STORAGE_ACCESS_KEY", "AZURE_STORAGE_SAS_TOKEN", "AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_TENANT_ID",
+		},
+		formats: map[string]func(string) error{
+			"AZURE_STORAGE_ACCOUNT":   matchFormat(azureAccountRegexp, "3 to 24 lowercase letters and numbers"),
+			"AZURE_STORAGE_CONTAINER": matchFormat(azureContainerRegexp, "a valid Azure blob container name"),
+		},
+	},
+	ConnectionTypeProtocolHF: {
+		required: []string{"HF_TOKEN"},
+	},
+	ConnectionTypeProtocolPVC: {
+		required: []string{"PVC_NAME"},
+		formats: map[string]func(string) error{
+			"PVC_NAME": validateDNSSubdomain,
+		},
+	},
+}
+
+// ConnectionSecretError is returned when the data of a connection secret does not match the
+// schema of its connection type.
+type ConnectionSecretError struct {
+	SecretName     string
+	ConnectionType string
+	Problems       []string
+}
+
+func (e *ConnectionSecretError) Error() string {
+	return fmt.Sprintf("connection secret '%s' of type '%s' is invalid: %s", e.SecretName, e.ConnectionType, strings.Join(e.Problems, "; "))
+}
+
+// HasConnectionSecretSchema returns true if the data of secrets of the given connection type can be validated.
+func HasConnectionSecretSchema(connectionType string) bool {
+	_, ok := connectionSecretSchemas[schemaConnectionType(connectionType)]
+	return ok
+}
+
+// ValidateConnectionSecret validates the data of the secret against the schema of the given
+// connection type: required keys, URI syntax and endpoint/region format.
+// It returns a *ConnectionSecretError listing all the problems found, or nil when the secret is
+// valid or the connection type has no schema.
+func ValidateConnectionSecret(secret *corev1.Secret, connectionType string) error {
+	return validateConnectionSecret(secret, connectionType, true)
+}
+
+// ConnectionSecretWarnings returns a warning for each data key of the secret that is not used by
+// the given connection type, such as a misspelled key, suggesting the closest known key.
+func ConnectionSecretWarnings(secret *corev1.Secret, connectionType string) []string {
+	schema, ok := connectionSecretSchemas[schemaConnectionType(connectionType)]
+	if !ok {
+		return nil
+	}
+
+	known := schema.knownKeys()
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var warnings []string
+	for _, key := range keys {
+		if slices.Contains(known, key) {
+			continue
+		}
+
+		warning := fmt.Sprintf("connection secret '%s' of type '%s' has data key '%s' which is not used by this connection type",
+			secret.Name, connectionType, key)
+		if suggestion := closestKey(key, known); suggestion != "" {
+			warning += fmt.Sprintf(", did you mean '%s'?", suggestion)
+		}
+		warnings = append(warnings, warning)
+	}
+
+	return warnings
+}
+
+// knownKeys returns the sorted data keys used by the connection type.
+func (s connectionSecretSchema) knownKeys() []string {
+	keys := slices.Concat(s.required, s.oneOf, s.optional)
+	for _, pair := range s.pairs {
+		keys = append(keys, pair...)
+	}
+	for key := range s.formats {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return slices.Compact(keys)
+}
+
+// closestKey returns the known key key is most likely a misspelling of, or an empty string.
+func closestKey(key string, known []string) string {
+	const maxDistance = 2
+
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, candidate := range known {
+		if d := editDistance(strings.ToUpper(key), strings.ToUpper(candidate)); d < closestDistance {
+			closest, closestDistance = candidate, d
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// validateConnectionSecret validates the format of the data keys of the secret, that paired keys
+// are set together and, when requireKeys is set, the presence of the keys required by the
+// connection type.
+func validateConnectionSecret(secret *corev1.Secret, connectionType string, requireKeys bool) error {
+	schema, ok := connectionSecretSchemas[schemaConnectionType(connectionType)]
+	if !ok {
+		return nil
+	}
+
+	var problems []string
+
+	if requireKeys {
+		for _, key := range schema.required {
+			if len(secret.Data[key]) == 0 {
+				problems = append(problems, fmt.Sprintf("missing required data key '%s'", key))
+			}
+		}
+
+		if len(schema.oneOf) > 0 && !slices.ContainsFunc(schema.oneOf, func(key string) bool {
+			return len(secret.Data[key]) != 0
+		}) {
+			problems = append(problems, fmt.Sprintf("missing data key, one of '%s' is required", strings.Join(schema.oneOf, "', '")))
+		}
+	}
+
+	for _, pair := range schema.pairs {
+		set := slices.DeleteFunc(slices.Clone(pair), func(key string) bool { return len(secret.Data[key]) == 0 })
+		if len(set) > 0 && len(set) < len(pair) {
+			problems = append(problems, fmt.Sprintf("data keys '%s' must be set together, only '%s' is set",
+				strings.Join(pair, "', '"), strings.Join(set, "', '")))
+		}
+	}
+
+	keys := make([]string, 0, len(schema.formats))
+	for key := range schema.formats {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value := secret.Data[key]
+		if len(value) == 0 {
+			continue
+		}
+		if err := schema.formats[key](string(value)); err != nil {
+			problems = append(problems, fmt.Sprintf("data key '%s' %s", key, err.Error()))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &ConnectionSecretError{
+		SecretName:     secret.Name,
+		ConnectionType: connectionType,
+		Problems:       problems,
+	}
+}
+
+// ConnectionPreflight fetches the secret of the connection about to be injected and validates
+// the format of its data, so that invalid connections are rejected at admission instead of
+// surfacing later as failing predictor pods. Missing keys are reported by the injection itself.
+// Returns the warnings about unknown data keys and a nil response if the connection is valid,
+// otherwise the response to return.
+func (w *BaseServingConnectionWebhook) ConnectionPreflight(ctx context.Context, connInfo ConnectionInfo, namespace string) ([]string, *admission.Response) {
+	log := logf.FromContext(ctx)
+
+	if !HasConnectionSecretSchema(connInfo.Type) {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := w.APIReader.Get(ctx, types.NamespacedName{Name: connInfo.SecretName, Namespace: namespace}, secret); err != nil {
+		var resp admission.Response
+		if k8serr.IsNotFound(err) {
+			resp = admission.Denied(fmt.Sprintf("connection secret '%s' not found in namespace '%s'", connInfo.SecretName, namespace))
+		} else {
+			log.Error(err, "failed to get connection secret", "secretName", connInfo.SecretName, "namespace", namespace)
+			resp = admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to get secret %s: %w", connInfo.SecretName, err))
+		}
+		return nil, &resp
+	}
+
+	if err := validateConnectionSecret(secret, connInfo.Type, false); err != nil {
+		log.V(1).Info("Connection secret validation failed", "secretName", connInfo.SecretName, "error", err.Error())
+		resp := admission.Denied(err.Error())
+		return nil, &resp
+	}
+
+	return ConnectionSecretWarnings(secret, connInfo.Type), nil
+}
+
+// schemaConnectionType maps the deprecated connection-type-ref values to their protocol equivalent.
+func schemaConnectionType(connectionType string) ConnectionType {
+	switch ConnectionType(connectionType) {
+	case ConnectionTypeRefURI:
+		return ConnectionTypeProtocolURI
+	case ConnectionTypeRefOCI:
+		return ConnectionTypeProtocolOCI
+	default:
+		return ConnectionType(connectionType)
+	}
+}
+
+func matchFormat(re *regexp.Regexp, description string) func(string) error {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return fmt.Errorf("must be %s, got '%s'", description, value)
+		}
+		return nil
+	}
+}
+
+func validateURI(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("must be a URI with a scheme and a host, such as 'https://example.com/model', got '%s'", value)
+	}
+	return nil
+}
+
+func validateEndpoint(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an http or https URL with a host, such as 'https://s3.amazonaws.com', got '%s'", value)
+	}
+	return nil
+}
+
+func validateCredential(value string) error {
+	if strings.ContainsFunc(value, unicode.IsSpace) {
+		return errors.New("must not contain whitespace or line breaks")
+	}
+	return nil
+}
+
+func validateJSON(value string) error {
+	if !json.Valid([]byte(value)) {
+		return errors.New("must be valid JSON")
+	}
+	return nil
+}
+
+func validateDockerConfigJSON(value string) error {
+	config := struct {
+		Auths map[string]any `json:"auths"`
+	}{}
+	if err := json.Unmarshal([]byte(value), &config); err != nil || config.Auths == nil {
+		return errors.New("must be a docker config JSON with an 'auths' section")
+	}
+	return nil
+}
+
+func validateDNSSubdomain(value string) error {
+	if errs := validation.IsDNS1123Subdomain(value); len(errs) > 0 {
+		return fmt.Errorf("must be a valid resource name: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
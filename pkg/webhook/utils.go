@@ -78,6 +78,14 @@ const (
 	ConnectionTypeProtocolS3 ConnectionType = "s3"
 	// ConnectionTypeProtocolOCI represents oci connections.
 	ConnectionTypeProtocolOCI ConnectionType = "oci"
+	// ConnectionTypeProtocolGCS represents Google Cloud Storage connections.
+	ConnectionTypeProtocolGCS ConnectionType = "gcs"
+	// ConnectionTypeProtocolAzure represents Azure Blob Storage connections.
+	ConnectionTypeProtocolAzure ConnectionType = "azure"
+	// ConnectionTypeProtocolHF represents Hugging Face Hub connections.
+	ConnectionTypeProtocolHF ConnectionType = "hf"
+	// ConnectionTypeProtocolPVC represents PersistentVolumeClaim connections.
+	ConnectionTypeProtocolPVC ConnectionType = "pvc"
 )
 
 // ConnectionTypeRef constants are deprecated in favor of ConnectionTypeProtocol constants.
@@ -95,6 +103,28 @@ func (ct ConnectionType) String() string {
 	return string(ct)
 }
 
+// IsStorageConnectionType returns true for the connection types whose model location is
+// rendered from the secret data and the connection-path annotation by BuildStorageURI.
+func IsStorageConnectionType(connectionType string) bool {
+	switch ConnectionType(connectionType) {
+	case ConnectionTypeProtocolGCS, ConnectionTypeProtocolAzure, ConnectionTypeProtocolHF, ConnectionTypeProtocolPVC:
+		return true
+	default:
+		return false
+	}
+}
+
+// UsesServiceAccount returns true for the connection types whose credentials are handed to
+// the storage initializer through a ServiceAccount linked to the connection secret.
+func UsesServiceAccount(connectionType string) bool {
+	switch ConnectionType(connectionType) {
+	case ConnectionTypeProtocolS3, ConnectionTypeProtocolGCS, ConnectionTypeProtocolAzure, ConnectionTypeProtocolHF:
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateSA creates a ServiceAccount and links the secret.
 func CreateSA(ctx context.Context, cli client.Client, secretName, namespace string) error {
 	sa := &corev1.ServiceAccount{
@@ -354,8 +384,9 @@ func DetermineConnectionChangeAction(oldConn, newConn ConnectionInfo) Connection
 		return ConnectionActionReplace
 	}
 
-	// if connection-path changed for S3 connections => replace
-	if (newConn.Type == ConnectionTypeRefS3.String() || newConn.Type == ConnectionTypeProtocolS3.String()) && oldConn.Path != newConn.Path {
+	// if connection-path changed for S3 or storage connections => replace
+	isPathType := newConn.Type == ConnectionTypeRefS3.String() || newConn.Type == ConnectionTypeProtocolS3.String() || IsStorageConnectionType(newConn.Type)
+	if isPathType && oldConn.Path != newConn.Path {
 		return ConnectionActionReplace
 	}
 
@@ -372,19 +403,19 @@ func GetS3Path(obj *unstructured.Unstructured) string {
 func ServiceAccountCreation(ctx context.Context, cli client.Client, secretName, connectionType, namespace string, isDryRun bool) error {
 	log := logf.FromContext(ctx)
 
-	isS3Type := connectionType == ConnectionTypeRefS3.String() || connectionType == ConnectionTypeProtocolS3.String()
+	usesSA := UsesServiceAccount(connectionType)
 
 	switch {
 	// TODO: add OCI type later.
-	case isS3Type && !isDryRun:
+	case usesSA && !isDryRun:
 		if err := CreateSA(ctx, cli, secretName, namespace); err != nil {
-			log.Error(err, "Failed to create ServiceAccount for new S3 connection")
+			log.Error(err, "Failed to create ServiceAccount for new connection", "connectionType", connectionType)
 			return err
 		}
-	case isS3Type && isDryRun:
+	case usesSA && isDryRun:
 		log.V(1).Info("Skipping ServiceAccount creation in dry-run mode", "secretName", secretName)
 	default:
-		log.V(1).Info("Skipping ServiceAccount creation for non-S3 connection type", "connectionType", connectionType, "secretName", secretName)
+		log.V(1).Info("Skipping ServiceAccount creation for connection type without credentials", "connectionType", connectionType, "secretName", secretName)
 	}
 
 	return nil
@@ -687,3 +718,73 @@ func (w *BaseServingConnectionWebhook) BuildS3URI(ctx context.Context, connInfo
 	s3URI := fmt.Sprintf("s3://%s/%s", string(bucketName), connInfo.Path)
 	return s3URI, nil
 }
+
+// BuildStorageURI constructs the model storage URI for GCS, Azure Blob, Hugging Face and PVC
+// connections from the secret and the connection path annotation:
+//   - gcs: gs://<GCS_BUCKET>/$annotation.connection-path
+//   - azure: https://<AZURE_STORAGE_ACCOUNT>.blob.core.windows.net/<AZURE_STORAGE_CONTAINER>/$annotation.connection-path
+//   - hf: hf://$annotation.connection-path, the HF_TOKEN is read through the linked ServiceAccount
+//   - pvc: pvc://<PVC_NAME>/$annotation.connection-path, the path is optional
+func (w *BaseServingConnectionWebhook) BuildStorageURI(ctx context.Context, connInfo ConnectionInfo, namespace string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := w.APIReader.Get(ctx, types.NamespacedName{Name: connInfo.SecretName, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", connInfo.SecretName, err)
+	}
+
+	switch ConnectionType(connInfo.Type) {
+	case ConnectionTypeProtocolGCS:
+		bucket, err := requiredSecretValue(secret, "GCS_BUCKET")
+		if err != nil {
+			return "", err
+		}
+		if connInfo.Path == "" {
+			return "", errors.New("connection info does not have path")
+		}
+		return fmt.Sprintf("gs://%s/%s", bucket, connInfo.Path), nil
+
+	case ConnectionTypeProtocolAzure:
+		account, err := requiredSecretValue(secret, "AZURE_STORAGE_ACCOUNT")
+		if err != nil {
+			return "", err
+		}
+		container, err := requiredSecretValue(secret, "AZURE_STORAGE_CONTAINER")
+		if err != nil {
+			return "", err
+		}
+		if connInfo.Path == "" {
+			return "", errors.New("connection info does not have path")
+		}
+		return fmt.Sprintf("https://%s.blob.core.windows.net/%s/%s", account, container, connInfo.Path), nil
+
+	case ConnectionTypeProtocolHF:
+		if connInfo.Path == "" {
+			return "", errors.New("connection info does not have path, it must be set to the Hugging Face repository")
+		}
+		return "hf://" + connInfo.Path, nil
+
+	case ConnectionTypeProtocolPVC:
+		pvcName, err := requiredSecretValue(secret, "PVC_NAME")
+		if err != nil {
+			return "", err
+		}
+		if connInfo.Path == "" {
+			return "pvc://" + pvcName, nil
+		}
+		return fmt.Sprintf("pvc://%s/%s", pvcName, connInfo.Path), nil
+
+	default:
+		return "", fmt.Errorf("connection type %s does not support storage URI rendering", connInfo.Type)
+	}
+}
+
+// requiredSecretValue returns the value of a data key which must be set in the secret.
+func requiredSecretValue(secret *corev1.Secret, key string) (string, error) {
+	value, exists := secret.Data[key]
+	if !exists {
+		return "", fmt.Errorf("secret does not contain '%s' data key", key)
+	}
+	if len(value) == 0 {
+		return "", fmt.Errorf("secret '%s' data key is empty", key)
+	}
+	return string(value), nil
+}
@@ -6,6 +6,7 @@ import (
 	awsv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/aws/v1alpha1"
 	azurev1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/azure/v1alpha1"
 	coreweavev1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/coreweave/v1alpha1"
+	genericv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/cloudmanager/generic/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 )
 
@@ -31,4 +32,9 @@ var providers = map[string]ProviderConfig{
 		GVK:          gvk.AWSKubernetesEngine,
 		InstanceName: awsv1alpha1.AWSKubernetesEngineInstanceName,
 	},
+	"generic": {
+		Name:         "generic",
+		GVK:          gvk.GenericKubernetesEngine,
+		InstanceName: genericv1alpha1.GenericKubernetesEngineInstanceName,
+	},
 }